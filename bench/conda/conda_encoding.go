@@ -0,0 +1,3722 @@
+// Code generated by "gobinenc conda.go"; DO NOT EDIT.
+
+package conda
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Info) WriteTo(w io.Writer) (n int64, err error) {
+	size := 2
+	size += len(s.Subdir)
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Subdir)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Subdir))
+	}
+	buf[offset] = byte(len(s.Subdir))
+	buf[offset+1] = byte(len(s.Subdir) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Subdir)
+	offset += len(s.Subdir)
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Info) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Info) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Info) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Info", n, "Subdir")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Info", n, "Subdir")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Info", n, "Subdir")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Info", n, "Subdir")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Info", n, "Subdir")
+	}
+	n += int64(size)
+	s.Subdir = *(*string)(unsafe.Pointer(&tmp))
+	return n, nil
+}
+
+func (s *Info) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 2
+	size += len(s.Subdir)
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Subdir)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Subdir))
+	}
+	buf[offset] = byte(len(s.Subdir))
+	buf[offset+1] = byte(len(s.Subdir) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Subdir)
+	offset += len(s.Subdir)
+	return buf, nil
+}
+
+func (s *Info) EncodedSize() int {
+	size := 2
+	size += len(s.Subdir)
+	return size
+}
+
+func (s *Info) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Info) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Info) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Info", int64(offset), "Subdir")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Info", int64(offset), "Subdir")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Info", int64(offset), "Subdir")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Info", int64(offset), "Subdir")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Subdir = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	return offset, nil
+}
+
+func (s *Info) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Package) WriteTo(w io.Writer) (n int64, err error) {
+	size := 32
+	size += len(s.Build) + len(s.License) + len(s.MD5) + len(s.Name) + len(s.Sha256) + len(s.Subdir) + len(s.Version)
+	for _, v := range s.Depends {
+		size += 2
+		size += len(v)
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Build)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Build))
+	}
+	buf[offset] = byte(len(s.Build))
+	buf[offset+1] = byte(len(s.Build) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Build)
+	offset += len(s.Build)
+	buf[offset] = byte(s.BuildNumber)
+	buf[offset+1] = byte(s.BuildNumber >> 8)
+	buf[offset+2] = byte(s.BuildNumber >> 16)
+	buf[offset+3] = byte(s.BuildNumber >> 24)
+	offset += 4
+	if uint64(len(s.Depends)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Depends))
+	}
+	buf[offset] = byte(len(s.Depends))
+	buf[offset+1] = byte(len(s.Depends) >> 8)
+	offset += 2
+	for _, v := range s.Depends {
+		if uint64(len(v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	if uint64(len(s.License)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.License))
+	}
+	buf[offset] = byte(len(s.License))
+	buf[offset+1] = byte(len(s.License) >> 8)
+	offset += 2
+	copy(buf[offset:], s.License)
+	offset += len(s.License)
+	if uint64(len(s.MD5)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.MD5))
+	}
+	buf[offset] = byte(len(s.MD5))
+	buf[offset+1] = byte(len(s.MD5) >> 8)
+	offset += 2
+	copy(buf[offset:], s.MD5)
+	offset += len(s.MD5)
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if uint64(len(s.Sha256)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Sha256))
+	}
+	buf[offset] = byte(len(s.Sha256))
+	buf[offset+1] = byte(len(s.Sha256) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Sha256)
+	offset += len(s.Sha256)
+	buf[offset] = byte(s.Size)
+	buf[offset+1] = byte(s.Size >> 8)
+	buf[offset+2] = byte(s.Size >> 16)
+	buf[offset+3] = byte(s.Size >> 24)
+	offset += 4
+	if uint64(len(s.Subdir)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Subdir))
+	}
+	buf[offset] = byte(len(s.Subdir))
+	buf[offset+1] = byte(len(s.Subdir) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Subdir)
+	offset += len(s.Subdir)
+	buf[offset] = byte(s.Timestamp)
+	buf[offset+1] = byte(s.Timestamp >> 8)
+	buf[offset+2] = byte(s.Timestamp >> 16)
+	buf[offset+3] = byte(s.Timestamp >> 24)
+	buf[offset+4] = byte(s.Timestamp >> 32)
+	buf[offset+5] = byte(s.Timestamp >> 40)
+	buf[offset+6] = byte(s.Timestamp >> 48)
+	buf[offset+7] = byte(s.Timestamp >> 56)
+	offset += 8
+	if uint64(len(s.Version)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Version))
+	}
+	buf[offset] = byte(len(s.Version))
+	buf[offset+1] = byte(len(s.Version) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Version)
+	offset += len(s.Version)
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Package) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Package) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Package) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "Build")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", n, "Build")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Package", n, "Build")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Package", n, "Build")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "Build")
+	}
+	n += int64(size)
+	s.Build = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "BuildNumber")
+	}
+	n += 4
+	s.BuildNumber = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "Depends")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Package", n, "Depends")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Package", n, "Depends")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Package", n, "Depends")
+	}
+	s.Depends = make([]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Package", n, "Depends[%d]", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", n, "Depends[%d]", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Package", n, "Depends[%d]", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Package", n, "Depends[%d]", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Package", n, "Depends[%d]", i)
+		}
+		n += int64(size)
+		s.Depends[i] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "License")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", n, "License")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Package", n, "License")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Package", n, "License")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "License")
+	}
+	n += int64(size)
+	s.License = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "MD5")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", n, "MD5")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Package", n, "MD5")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Package", n, "MD5")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "MD5")
+	}
+	n += int64(size)
+	s.MD5 = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "Name")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Package", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Package", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "Sha256")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", n, "Sha256")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Package", n, "Sha256")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Package", n, "Sha256")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "Sha256")
+	}
+	n += int64(size)
+	s.Sha256 = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "Size")
+	}
+	n += 4
+	s.Size = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "Subdir")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", n, "Subdir")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Package", n, "Subdir")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Package", n, "Subdir")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "Subdir")
+	}
+	n += int64(size)
+	s.Subdir = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "Timestamp")
+	}
+	n += 8
+	s.Timestamp = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "Version")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", n, "Version")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Package", n, "Version")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Package", n, "Version")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Package", n, "Version")
+	}
+	n += int64(size)
+	s.Version = *(*string)(unsafe.Pointer(&tmp))
+	return n, nil
+}
+
+func (s *Package) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 32
+	size += len(s.Build) + len(s.License) + len(s.MD5) + len(s.Name) + len(s.Sha256) + len(s.Subdir) + len(s.Version)
+	for _, v := range s.Depends {
+		size += 2
+		size += len(v)
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Build)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Build))
+	}
+	buf[offset] = byte(len(s.Build))
+	buf[offset+1] = byte(len(s.Build) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Build)
+	offset += len(s.Build)
+	buf[offset] = byte(s.BuildNumber)
+	buf[offset+1] = byte(s.BuildNumber >> 8)
+	buf[offset+2] = byte(s.BuildNumber >> 16)
+	buf[offset+3] = byte(s.BuildNumber >> 24)
+	offset += 4
+	if uint64(len(s.Depends)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Depends))
+	}
+	buf[offset] = byte(len(s.Depends))
+	buf[offset+1] = byte(len(s.Depends) >> 8)
+	offset += 2
+	for _, v := range s.Depends {
+		if uint64(len(v)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	if uint64(len(s.License)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.License))
+	}
+	buf[offset] = byte(len(s.License))
+	buf[offset+1] = byte(len(s.License) >> 8)
+	offset += 2
+	copy(buf[offset:], s.License)
+	offset += len(s.License)
+	if uint64(len(s.MD5)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.MD5))
+	}
+	buf[offset] = byte(len(s.MD5))
+	buf[offset+1] = byte(len(s.MD5) >> 8)
+	offset += 2
+	copy(buf[offset:], s.MD5)
+	offset += len(s.MD5)
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if uint64(len(s.Sha256)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Sha256))
+	}
+	buf[offset] = byte(len(s.Sha256))
+	buf[offset+1] = byte(len(s.Sha256) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Sha256)
+	offset += len(s.Sha256)
+	buf[offset] = byte(s.Size)
+	buf[offset+1] = byte(s.Size >> 8)
+	buf[offset+2] = byte(s.Size >> 16)
+	buf[offset+3] = byte(s.Size >> 24)
+	offset += 4
+	if uint64(len(s.Subdir)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Subdir))
+	}
+	buf[offset] = byte(len(s.Subdir))
+	buf[offset+1] = byte(len(s.Subdir) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Subdir)
+	offset += len(s.Subdir)
+	buf[offset] = byte(s.Timestamp)
+	buf[offset+1] = byte(s.Timestamp >> 8)
+	buf[offset+2] = byte(s.Timestamp >> 16)
+	buf[offset+3] = byte(s.Timestamp >> 24)
+	buf[offset+4] = byte(s.Timestamp >> 32)
+	buf[offset+5] = byte(s.Timestamp >> 40)
+	buf[offset+6] = byte(s.Timestamp >> 48)
+	buf[offset+7] = byte(s.Timestamp >> 56)
+	offset += 8
+	if uint64(len(s.Version)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Version))
+	}
+	buf[offset] = byte(len(s.Version))
+	buf[offset+1] = byte(len(s.Version) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Version)
+	offset += len(s.Version)
+	return buf, nil
+}
+
+func (s *Package) EncodedSize() int {
+	size := 32
+	size += len(s.Build) + len(s.License) + len(s.MD5) + len(s.Name) + len(s.Sha256) + len(s.Subdir) + len(s.Version)
+	for _, v := range s.Depends {
+		size += 2
+		size += len(v)
+	}
+	return size
+}
+
+func (s *Package) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Package) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Package) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Build")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", int64(offset), "Build")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Build")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Build")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Build = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 6 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "BuildNumber")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.BuildNumber = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Package", int64(offset), "Depends")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Depends")
+	}
+	s.Depends = make([]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Depends[%d]", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", int64(offset), "Depends[%d]", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Depends[%d]", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Depends[%d]", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Depends[i] = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "License")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", int64(offset), "License")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "License")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "License")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.License = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "MD5")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", int64(offset), "MD5")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "MD5")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "MD5")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.MD5 = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Name")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Sha256")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", int64(offset), "Sha256")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Sha256")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Sha256")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Sha256 = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 6 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Size")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Size = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", int64(offset), "Subdir")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Subdir")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Subdir")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Subdir = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 10 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Timestamp")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	s.Timestamp = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Package", int64(offset), "Version")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Version")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Package", int64(offset), "Version")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Version = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	return offset, nil
+}
+
+func (s *Package) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *PackageConda) WriteTo(w io.Writer) (n int64, err error) {
+	size := 38
+	size += len(s.Package.Build) + len(s.Package.License) + len(s.Package.MD5) + len(s.Package.Name) + len(s.Package.Sha256) + len(s.Package.Subdir) + len(s.Package.Version) + len(s.LegacyBz2Md5) + len(s.LicenseFamily)
+	for _, v := range s.Package.Depends {
+		size += 2
+		size += len(v)
+	}
+	for _, v := range s.Constrains {
+		size += 2
+		size += len(v)
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Package.Build)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.Build))
+	}
+	buf[offset] = byte(len(s.Package.Build))
+	buf[offset+1] = byte(len(s.Package.Build) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Package.Build)
+	offset += len(s.Package.Build)
+	buf[offset] = byte(s.Package.BuildNumber)
+	buf[offset+1] = byte(s.Package.BuildNumber >> 8)
+	buf[offset+2] = byte(s.Package.BuildNumber >> 16)
+	buf[offset+3] = byte(s.Package.BuildNumber >> 24)
+	offset += 4
+	if uint64(len(s.Package.Depends)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.Depends))
+	}
+	buf[offset] = byte(len(s.Package.Depends))
+	buf[offset+1] = byte(len(s.Package.Depends) >> 8)
+	offset += 2
+	for _, v := range s.Package.Depends {
+		if uint64(len(v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	if uint64(len(s.Package.License)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.License))
+	}
+	buf[offset] = byte(len(s.Package.License))
+	buf[offset+1] = byte(len(s.Package.License) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Package.License)
+	offset += len(s.Package.License)
+	if uint64(len(s.Package.MD5)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.MD5))
+	}
+	buf[offset] = byte(len(s.Package.MD5))
+	buf[offset+1] = byte(len(s.Package.MD5) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Package.MD5)
+	offset += len(s.Package.MD5)
+	if uint64(len(s.Package.Name)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.Name))
+	}
+	buf[offset] = byte(len(s.Package.Name))
+	buf[offset+1] = byte(len(s.Package.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Package.Name)
+	offset += len(s.Package.Name)
+	if uint64(len(s.Package.Sha256)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.Sha256))
+	}
+	buf[offset] = byte(len(s.Package.Sha256))
+	buf[offset+1] = byte(len(s.Package.Sha256) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Package.Sha256)
+	offset += len(s.Package.Sha256)
+	buf[offset] = byte(s.Package.Size)
+	buf[offset+1] = byte(s.Package.Size >> 8)
+	buf[offset+2] = byte(s.Package.Size >> 16)
+	buf[offset+3] = byte(s.Package.Size >> 24)
+	offset += 4
+	if uint64(len(s.Package.Subdir)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.Subdir))
+	}
+	buf[offset] = byte(len(s.Package.Subdir))
+	buf[offset+1] = byte(len(s.Package.Subdir) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Package.Subdir)
+	offset += len(s.Package.Subdir)
+	buf[offset] = byte(s.Package.Timestamp)
+	buf[offset+1] = byte(s.Package.Timestamp >> 8)
+	buf[offset+2] = byte(s.Package.Timestamp >> 16)
+	buf[offset+3] = byte(s.Package.Timestamp >> 24)
+	buf[offset+4] = byte(s.Package.Timestamp >> 32)
+	buf[offset+5] = byte(s.Package.Timestamp >> 40)
+	buf[offset+6] = byte(s.Package.Timestamp >> 48)
+	buf[offset+7] = byte(s.Package.Timestamp >> 56)
+	offset += 8
+	if uint64(len(s.Package.Version)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.Version))
+	}
+	buf[offset] = byte(len(s.Package.Version))
+	buf[offset+1] = byte(len(s.Package.Version) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Package.Version)
+	offset += len(s.Package.Version)
+	if uint64(len(s.Constrains)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Constrains))
+	}
+	buf[offset] = byte(len(s.Constrains))
+	buf[offset+1] = byte(len(s.Constrains) >> 8)
+	offset += 2
+	for _, v := range s.Constrains {
+		if uint64(len(v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	if uint64(len(s.LegacyBz2Md5)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.LegacyBz2Md5))
+	}
+	buf[offset] = byte(len(s.LegacyBz2Md5))
+	buf[offset+1] = byte(len(s.LegacyBz2Md5) >> 8)
+	offset += 2
+	copy(buf[offset:], s.LegacyBz2Md5)
+	offset += len(s.LegacyBz2Md5)
+	if uint64(len(s.LicenseFamily)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.LicenseFamily))
+	}
+	buf[offset] = byte(len(s.LicenseFamily))
+	buf[offset+1] = byte(len(s.LicenseFamily) >> 8)
+	offset += 2
+	copy(buf[offset:], s.LicenseFamily)
+	offset += len(s.LicenseFamily)
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *PackageConda) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *PackageConda) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *PackageConda) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.Build")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", n, "Package.Build")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "PackageConda", n, "Package.Build")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "PackageConda", n, "Package.Build")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.Build")
+	}
+	n += int64(size)
+	s.Package.Build = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.BuildNumber")
+	}
+	n += 4
+	s.Package.BuildNumber = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.Depends")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "PackageConda", n, "Package.Depends")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "PackageConda", n, "Package.Depends")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "PackageConda", n, "Package.Depends")
+	}
+	s.Package.Depends = make([]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.Depends[%d]", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", n, "Package.Depends[%d]", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "PackageConda", n, "Package.Depends[%d]", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "PackageConda", n, "Package.Depends[%d]", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.Depends[%d]", i)
+		}
+		n += int64(size)
+		s.Package.Depends[i] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.License")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", n, "Package.License")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "PackageConda", n, "Package.License")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "PackageConda", n, "Package.License")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.License")
+	}
+	n += int64(size)
+	s.Package.License = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.MD5")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", n, "Package.MD5")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "PackageConda", n, "Package.MD5")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "PackageConda", n, "Package.MD5")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.MD5")
+	}
+	n += int64(size)
+	s.Package.MD5 = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.Name")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", n, "Package.Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "PackageConda", n, "Package.Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "PackageConda", n, "Package.Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.Name")
+	}
+	n += int64(size)
+	s.Package.Name = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.Sha256")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", n, "Package.Sha256")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "PackageConda", n, "Package.Sha256")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "PackageConda", n, "Package.Sha256")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.Sha256")
+	}
+	n += int64(size)
+	s.Package.Sha256 = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.Size")
+	}
+	n += 4
+	s.Package.Size = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.Subdir")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", n, "Package.Subdir")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "PackageConda", n, "Package.Subdir")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "PackageConda", n, "Package.Subdir")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.Subdir")
+	}
+	n += int64(size)
+	s.Package.Subdir = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.Timestamp")
+	}
+	n += 8
+	s.Package.Timestamp = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.Version")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", n, "Package.Version")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "PackageConda", n, "Package.Version")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "PackageConda", n, "Package.Version")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Package.Version")
+	}
+	n += int64(size)
+	s.Package.Version = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Constrains")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "PackageConda", n, "Constrains")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "PackageConda", n, "Constrains")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "PackageConda", n, "Constrains")
+	}
+	s.Constrains = make([]string, size)
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Constrains[%d]", i1)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", n, "Constrains[%d]", i1)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "PackageConda", n, "Constrains[%d]", i1)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "PackageConda", n, "Constrains[%d]", i1)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "Constrains[%d]", i1)
+		}
+		n += int64(size)
+		s.Constrains[i1] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "LegacyBz2Md5")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", n, "LegacyBz2Md5")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "PackageConda", n, "LegacyBz2Md5")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "PackageConda", n, "LegacyBz2Md5")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "LegacyBz2Md5")
+	}
+	n += int64(size)
+	s.LegacyBz2Md5 = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "LicenseFamily")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", n, "LicenseFamily")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "PackageConda", n, "LicenseFamily")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "PackageConda", n, "LicenseFamily")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "PackageConda", n, "LicenseFamily")
+	}
+	n += int64(size)
+	s.LicenseFamily = *(*string)(unsafe.Pointer(&tmp))
+	return n, nil
+}
+
+func (s *PackageConda) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 38
+	size += len(s.Package.Build) + len(s.Package.License) + len(s.Package.MD5) + len(s.Package.Name) + len(s.Package.Sha256) + len(s.Package.Subdir) + len(s.Package.Version) + len(s.LegacyBz2Md5) + len(s.LicenseFamily)
+	for _, v := range s.Package.Depends {
+		size += 2
+		size += len(v)
+	}
+	for _, v := range s.Constrains {
+		size += 2
+		size += len(v)
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Package.Build)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.Build))
+	}
+	buf[offset] = byte(len(s.Package.Build))
+	buf[offset+1] = byte(len(s.Package.Build) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Package.Build)
+	offset += len(s.Package.Build)
+	buf[offset] = byte(s.Package.BuildNumber)
+	buf[offset+1] = byte(s.Package.BuildNumber >> 8)
+	buf[offset+2] = byte(s.Package.BuildNumber >> 16)
+	buf[offset+3] = byte(s.Package.BuildNumber >> 24)
+	offset += 4
+	if uint64(len(s.Package.Depends)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.Depends))
+	}
+	buf[offset] = byte(len(s.Package.Depends))
+	buf[offset+1] = byte(len(s.Package.Depends) >> 8)
+	offset += 2
+	for _, v := range s.Package.Depends {
+		if uint64(len(v)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	if uint64(len(s.Package.License)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.License))
+	}
+	buf[offset] = byte(len(s.Package.License))
+	buf[offset+1] = byte(len(s.Package.License) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Package.License)
+	offset += len(s.Package.License)
+	if uint64(len(s.Package.MD5)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.MD5))
+	}
+	buf[offset] = byte(len(s.Package.MD5))
+	buf[offset+1] = byte(len(s.Package.MD5) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Package.MD5)
+	offset += len(s.Package.MD5)
+	if uint64(len(s.Package.Name)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.Name))
+	}
+	buf[offset] = byte(len(s.Package.Name))
+	buf[offset+1] = byte(len(s.Package.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Package.Name)
+	offset += len(s.Package.Name)
+	if uint64(len(s.Package.Sha256)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.Sha256))
+	}
+	buf[offset] = byte(len(s.Package.Sha256))
+	buf[offset+1] = byte(len(s.Package.Sha256) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Package.Sha256)
+	offset += len(s.Package.Sha256)
+	buf[offset] = byte(s.Package.Size)
+	buf[offset+1] = byte(s.Package.Size >> 8)
+	buf[offset+2] = byte(s.Package.Size >> 16)
+	buf[offset+3] = byte(s.Package.Size >> 24)
+	offset += 4
+	if uint64(len(s.Package.Subdir)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.Subdir))
+	}
+	buf[offset] = byte(len(s.Package.Subdir))
+	buf[offset+1] = byte(len(s.Package.Subdir) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Package.Subdir)
+	offset += len(s.Package.Subdir)
+	buf[offset] = byte(s.Package.Timestamp)
+	buf[offset+1] = byte(s.Package.Timestamp >> 8)
+	buf[offset+2] = byte(s.Package.Timestamp >> 16)
+	buf[offset+3] = byte(s.Package.Timestamp >> 24)
+	buf[offset+4] = byte(s.Package.Timestamp >> 32)
+	buf[offset+5] = byte(s.Package.Timestamp >> 40)
+	buf[offset+6] = byte(s.Package.Timestamp >> 48)
+	buf[offset+7] = byte(s.Package.Timestamp >> 56)
+	offset += 8
+	if uint64(len(s.Package.Version)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Package.Version))
+	}
+	buf[offset] = byte(len(s.Package.Version))
+	buf[offset+1] = byte(len(s.Package.Version) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Package.Version)
+	offset += len(s.Package.Version)
+	if uint64(len(s.Constrains)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Constrains))
+	}
+	buf[offset] = byte(len(s.Constrains))
+	buf[offset+1] = byte(len(s.Constrains) >> 8)
+	offset += 2
+	for _, v := range s.Constrains {
+		if uint64(len(v)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	if uint64(len(s.LegacyBz2Md5)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.LegacyBz2Md5))
+	}
+	buf[offset] = byte(len(s.LegacyBz2Md5))
+	buf[offset+1] = byte(len(s.LegacyBz2Md5) >> 8)
+	offset += 2
+	copy(buf[offset:], s.LegacyBz2Md5)
+	offset += len(s.LegacyBz2Md5)
+	if uint64(len(s.LicenseFamily)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.LicenseFamily))
+	}
+	buf[offset] = byte(len(s.LicenseFamily))
+	buf[offset+1] = byte(len(s.LicenseFamily) >> 8)
+	offset += 2
+	copy(buf[offset:], s.LicenseFamily)
+	offset += len(s.LicenseFamily)
+	return buf, nil
+}
+
+func (s *PackageConda) EncodedSize() int {
+	size := 38
+	size += len(s.Package.Build) + len(s.Package.License) + len(s.Package.MD5) + len(s.Package.Name) + len(s.Package.Sha256) + len(s.Package.Subdir) + len(s.Package.Version) + len(s.LegacyBz2Md5) + len(s.LicenseFamily)
+	for _, v := range s.Package.Depends {
+		size += 2
+		size += len(v)
+	}
+	for _, v := range s.Constrains {
+		size += 2
+		size += len(v)
+	}
+	return size
+}
+
+func (s *PackageConda) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *PackageConda) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *PackageConda) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Build")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", int64(offset), "Package.Build")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Build")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Build")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Package.Build = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 6 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.BuildNumber")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Package.BuildNumber = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "PackageConda", int64(offset), "Package.Depends")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Depends")
+	}
+	s.Package.Depends = make([]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Depends[%d]", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", int64(offset), "Package.Depends[%d]", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Depends[%d]", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Depends[%d]", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Package.Depends[i] = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.License")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", int64(offset), "Package.License")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.License")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.License")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Package.License = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.MD5")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", int64(offset), "Package.MD5")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.MD5")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.MD5")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Package.MD5 = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Name")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", int64(offset), "Package.Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Package.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Sha256")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", int64(offset), "Package.Sha256")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Sha256")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Sha256")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Package.Sha256 = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 6 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Size")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Package.Size = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", int64(offset), "Package.Subdir")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Subdir")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Subdir")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Package.Subdir = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 10 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Timestamp")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	s.Package.Timestamp = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", int64(offset), "Package.Version")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Version")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Package.Version")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Package.Version = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Constrains")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "PackageConda", int64(offset), "Constrains")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Constrains")
+	}
+	s.Constrains = make([]string, size)
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Constrains[%d]", i1)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", int64(offset), "Constrains[%d]", i1)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Constrains[%d]", i1)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "Constrains[%d]", i1)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Constrains[i1] = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "LegacyBz2Md5")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", int64(offset), "LegacyBz2Md5")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "LegacyBz2Md5")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "LegacyBz2Md5")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.LegacyBz2Md5 = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "LicenseFamily")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "PackageConda", int64(offset), "LicenseFamily")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "LicenseFamily")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "PackageConda", int64(offset), "LicenseFamily")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.LicenseFamily = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	return offset, nil
+}
+
+func (s *PackageConda) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *RepoData) WriteTo(w io.Writer) (n int64, err error) {
+	size := 12
+	size += len(s.Info.Subdir)
+	for _, v := range s.Packages {
+		size += 32
+		size += len(v.Build) + len(v.License) + len(v.MD5) + len(v.Name) + len(v.Sha256) + len(v.Subdir) + len(v.Version)
+		for _, v1 := range v.Depends {
+			size += 2
+			size += len(v1)
+		}
+	}
+	for _, v := range s.PackagesConda {
+		size += 38
+		size += len(v.Package.Build) + len(v.Package.License) + len(v.Package.MD5) + len(v.Package.Name) + len(v.Package.Sha256) + len(v.Package.Subdir) + len(v.Package.Version) + len(v.LegacyBz2Md5) + len(v.LicenseFamily)
+		for _, v1 := range v.Package.Depends {
+			size += 2
+			size += len(v1)
+		}
+		for _, v1 := range v.Constrains {
+			size += 2
+			size += len(v1)
+		}
+	}
+	for _, v := range s.Removed {
+		size += 2
+		size += len(v)
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Info.Subdir)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Info.Subdir))
+	}
+	buf[offset] = byte(len(s.Info.Subdir))
+	buf[offset+1] = byte(len(s.Info.Subdir) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Info.Subdir)
+	offset += len(s.Info.Subdir)
+	if uint64(len(s.Packages)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Packages))
+	}
+	buf[offset] = byte(len(s.Packages))
+	buf[offset+1] = byte(len(s.Packages) >> 8)
+	offset += 2
+	for _, v := range s.Packages {
+		if uint64(len(v.Build)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Build))
+		}
+		buf[offset] = byte(len(v.Build))
+		buf[offset+1] = byte(len(v.Build) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Build)
+		offset += len(v.Build)
+		buf[offset] = byte(v.BuildNumber)
+		buf[offset+1] = byte(v.BuildNumber >> 8)
+		buf[offset+2] = byte(v.BuildNumber >> 16)
+		buf[offset+3] = byte(v.BuildNumber >> 24)
+		offset += 4
+		if uint64(len(v.Depends)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Depends))
+		}
+		buf[offset] = byte(len(v.Depends))
+		buf[offset+1] = byte(len(v.Depends) >> 8)
+		offset += 2
+		for _, v1 := range v.Depends {
+			if uint64(len(v1)) > math.MaxUint16 {
+				return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v1))
+			}
+			buf[offset] = byte(len(v1))
+			buf[offset+1] = byte(len(v1) >> 8)
+			offset += 2
+			copy(buf[offset:], v1)
+			offset += len(v1)
+		}
+		if uint64(len(v.License)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.License))
+		}
+		buf[offset] = byte(len(v.License))
+		buf[offset+1] = byte(len(v.License) >> 8)
+		offset += 2
+		copy(buf[offset:], v.License)
+		offset += len(v.License)
+		if uint64(len(v.MD5)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.MD5))
+		}
+		buf[offset] = byte(len(v.MD5))
+		buf[offset+1] = byte(len(v.MD5) >> 8)
+		offset += 2
+		copy(buf[offset:], v.MD5)
+		offset += len(v.MD5)
+		if uint64(len(v.Name)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Name))
+		}
+		buf[offset] = byte(len(v.Name))
+		buf[offset+1] = byte(len(v.Name) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Name)
+		offset += len(v.Name)
+		if uint64(len(v.Sha256)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Sha256))
+		}
+		buf[offset] = byte(len(v.Sha256))
+		buf[offset+1] = byte(len(v.Sha256) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Sha256)
+		offset += len(v.Sha256)
+		buf[offset] = byte(v.Size)
+		buf[offset+1] = byte(v.Size >> 8)
+		buf[offset+2] = byte(v.Size >> 16)
+		buf[offset+3] = byte(v.Size >> 24)
+		offset += 4
+		if uint64(len(v.Subdir)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Subdir))
+		}
+		buf[offset] = byte(len(v.Subdir))
+		buf[offset+1] = byte(len(v.Subdir) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Subdir)
+		offset += len(v.Subdir)
+		buf[offset] = byte(v.Timestamp)
+		buf[offset+1] = byte(v.Timestamp >> 8)
+		buf[offset+2] = byte(v.Timestamp >> 16)
+		buf[offset+3] = byte(v.Timestamp >> 24)
+		buf[offset+4] = byte(v.Timestamp >> 32)
+		buf[offset+5] = byte(v.Timestamp >> 40)
+		buf[offset+6] = byte(v.Timestamp >> 48)
+		buf[offset+7] = byte(v.Timestamp >> 56)
+		offset += 8
+		if uint64(len(v.Version)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Version))
+		}
+		buf[offset] = byte(len(v.Version))
+		buf[offset+1] = byte(len(v.Version) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Version)
+		offset += len(v.Version)
+	}
+	if uint64(len(s.PackagesConda)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.PackagesConda))
+	}
+	buf[offset] = byte(len(s.PackagesConda))
+	buf[offset+1] = byte(len(s.PackagesConda) >> 8)
+	offset += 2
+	for _, v := range s.PackagesConda {
+		if uint64(len(v.Package.Build)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.Build))
+		}
+		buf[offset] = byte(len(v.Package.Build))
+		buf[offset+1] = byte(len(v.Package.Build) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Package.Build)
+		offset += len(v.Package.Build)
+		buf[offset] = byte(v.Package.BuildNumber)
+		buf[offset+1] = byte(v.Package.BuildNumber >> 8)
+		buf[offset+2] = byte(v.Package.BuildNumber >> 16)
+		buf[offset+3] = byte(v.Package.BuildNumber >> 24)
+		offset += 4
+		if uint64(len(v.Package.Depends)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.Depends))
+		}
+		buf[offset] = byte(len(v.Package.Depends))
+		buf[offset+1] = byte(len(v.Package.Depends) >> 8)
+		offset += 2
+		for _, v1 := range v.Package.Depends {
+			if uint64(len(v1)) > math.MaxUint16 {
+				return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v1))
+			}
+			buf[offset] = byte(len(v1))
+			buf[offset+1] = byte(len(v1) >> 8)
+			offset += 2
+			copy(buf[offset:], v1)
+			offset += len(v1)
+		}
+		if uint64(len(v.Package.License)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.License))
+		}
+		buf[offset] = byte(len(v.Package.License))
+		buf[offset+1] = byte(len(v.Package.License) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Package.License)
+		offset += len(v.Package.License)
+		if uint64(len(v.Package.MD5)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.MD5))
+		}
+		buf[offset] = byte(len(v.Package.MD5))
+		buf[offset+1] = byte(len(v.Package.MD5) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Package.MD5)
+		offset += len(v.Package.MD5)
+		if uint64(len(v.Package.Name)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.Name))
+		}
+		buf[offset] = byte(len(v.Package.Name))
+		buf[offset+1] = byte(len(v.Package.Name) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Package.Name)
+		offset += len(v.Package.Name)
+		if uint64(len(v.Package.Sha256)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.Sha256))
+		}
+		buf[offset] = byte(len(v.Package.Sha256))
+		buf[offset+1] = byte(len(v.Package.Sha256) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Package.Sha256)
+		offset += len(v.Package.Sha256)
+		buf[offset] = byte(v.Package.Size)
+		buf[offset+1] = byte(v.Package.Size >> 8)
+		buf[offset+2] = byte(v.Package.Size >> 16)
+		buf[offset+3] = byte(v.Package.Size >> 24)
+		offset += 4
+		if uint64(len(v.Package.Subdir)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.Subdir))
+		}
+		buf[offset] = byte(len(v.Package.Subdir))
+		buf[offset+1] = byte(len(v.Package.Subdir) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Package.Subdir)
+		offset += len(v.Package.Subdir)
+		buf[offset] = byte(v.Package.Timestamp)
+		buf[offset+1] = byte(v.Package.Timestamp >> 8)
+		buf[offset+2] = byte(v.Package.Timestamp >> 16)
+		buf[offset+3] = byte(v.Package.Timestamp >> 24)
+		buf[offset+4] = byte(v.Package.Timestamp >> 32)
+		buf[offset+5] = byte(v.Package.Timestamp >> 40)
+		buf[offset+6] = byte(v.Package.Timestamp >> 48)
+		buf[offset+7] = byte(v.Package.Timestamp >> 56)
+		offset += 8
+		if uint64(len(v.Package.Version)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.Version))
+		}
+		buf[offset] = byte(len(v.Package.Version))
+		buf[offset+1] = byte(len(v.Package.Version) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Package.Version)
+		offset += len(v.Package.Version)
+		if uint64(len(v.Constrains)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Constrains))
+		}
+		buf[offset] = byte(len(v.Constrains))
+		buf[offset+1] = byte(len(v.Constrains) >> 8)
+		offset += 2
+		for _, v1 := range v.Constrains {
+			if uint64(len(v1)) > math.MaxUint16 {
+				return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v1))
+			}
+			buf[offset] = byte(len(v1))
+			buf[offset+1] = byte(len(v1) >> 8)
+			offset += 2
+			copy(buf[offset:], v1)
+			offset += len(v1)
+		}
+		if uint64(len(v.LegacyBz2Md5)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.LegacyBz2Md5))
+		}
+		buf[offset] = byte(len(v.LegacyBz2Md5))
+		buf[offset+1] = byte(len(v.LegacyBz2Md5) >> 8)
+		offset += 2
+		copy(buf[offset:], v.LegacyBz2Md5)
+		offset += len(v.LegacyBz2Md5)
+		if uint64(len(v.LicenseFamily)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.LicenseFamily))
+		}
+		buf[offset] = byte(len(v.LicenseFamily))
+		buf[offset+1] = byte(len(v.LicenseFamily) >> 8)
+		offset += 2
+		copy(buf[offset:], v.LicenseFamily)
+		offset += len(v.LicenseFamily)
+	}
+	if uint64(len(s.Removed)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Removed))
+	}
+	buf[offset] = byte(len(s.Removed))
+	buf[offset+1] = byte(len(s.Removed) >> 8)
+	offset += 2
+	for _, v := range s.Removed {
+		if uint64(len(v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	buf[offset] = byte(s.RepoDataVersion)
+	buf[offset+1] = byte(s.RepoDataVersion >> 8)
+	buf[offset+2] = byte(s.RepoDataVersion >> 16)
+	buf[offset+3] = byte(s.RepoDataVersion >> 24)
+	offset += 4
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *RepoData) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *RepoData) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *RepoData) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Info.Subdir")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "Info.Subdir")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "Info.Subdir")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "Info.Subdir")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Info.Subdir")
+	}
+	n += int64(size)
+	s.Info.Subdir = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "RepoData", n, "Packages")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "Packages")
+	}
+	if size > binenc.MaxAlloc/160 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "Packages")
+	}
+	s.Packages = make([]Package, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].Build", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "Packages[%d].Build", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "Packages[%d].Build", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "Packages[%d].Build", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].Build", i)
+		}
+		n += int64(size)
+		s.Packages[i].Build = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].BuildNumber", i)
+		}
+		n += 4
+		s.Packages[i].BuildNumber = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].Depends", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "RepoData", n, "Packages[%d].Depends", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "Packages[%d].Depends", i)
+		}
+		if size > binenc.MaxAlloc/16 {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "Packages[%d].Depends", i)
+		}
+		s.Packages[i].Depends = make([]string, size)
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].Depends[%d]", i, i1)
+			}
+			n += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "Packages[%d].Depends[%d]", i, i1)
+			}
+			if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "Packages[%d].Depends[%d]", i, i1)
+			}
+			if size > binenc.MaxAlloc {
+				return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "Packages[%d].Depends[%d]", i, i1)
+			}
+			tmp = d.Arena(size)
+			if nr, err := io.ReadFull(r, tmp); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].Depends[%d]", i, i1)
+			}
+			n += int64(size)
+			s.Packages[i].Depends[i1] = *(*string)(unsafe.Pointer(&tmp))
+		}
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].License", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "Packages[%d].License", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "Packages[%d].License", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "Packages[%d].License", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].License", i)
+		}
+		n += int64(size)
+		s.Packages[i].License = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].MD5", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "Packages[%d].MD5", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "Packages[%d].MD5", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "Packages[%d].MD5", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].MD5", i)
+		}
+		n += int64(size)
+		s.Packages[i].MD5 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].Name", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "Packages[%d].Name", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "Packages[%d].Name", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "Packages[%d].Name", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].Name", i)
+		}
+		n += int64(size)
+		s.Packages[i].Name = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].Sha256", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "Packages[%d].Sha256", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "Packages[%d].Sha256", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "Packages[%d].Sha256", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].Sha256", i)
+		}
+		n += int64(size)
+		s.Packages[i].Sha256 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].Size", i)
+		}
+		n += 4
+		s.Packages[i].Size = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].Subdir", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "Packages[%d].Subdir", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "Packages[%d].Subdir", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "Packages[%d].Subdir", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].Subdir", i)
+		}
+		n += int64(size)
+		s.Packages[i].Subdir = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].Timestamp", i)
+		}
+		n += 8
+		s.Packages[i].Timestamp = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].Version", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "Packages[%d].Version", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "Packages[%d].Version", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "Packages[%d].Version", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Packages[%d].Version", i)
+		}
+		n += int64(size)
+		s.Packages[i].Version = *(*string)(unsafe.Pointer(&tmp))
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "RepoData", n, "PackagesConda")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "PackagesConda")
+	}
+	if size > binenc.MaxAlloc/216 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "PackagesConda")
+	}
+	s.PackagesConda = make([]PackageConda, size)
+	si2 := size
+	for i2 := 0; i2 < si2; i2++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.Build", i2)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "PackagesConda[%d].Package.Build", i2)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "PackagesConda[%d].Package.Build", i2)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "PackagesConda[%d].Package.Build", i2)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.Build", i2)
+		}
+		n += int64(size)
+		s.PackagesConda[i2].Package.Build = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.BuildNumber", i2)
+		}
+		n += 4
+		s.PackagesConda[i2].Package.BuildNumber = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.Depends", i2)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "RepoData", n, "PackagesConda[%d].Package.Depends", i2)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "PackagesConda[%d].Package.Depends", i2)
+		}
+		if size > binenc.MaxAlloc/16 {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "PackagesConda[%d].Package.Depends", i2)
+		}
+		s.PackagesConda[i2].Package.Depends = make([]string, size)
+		si3 := size
+		for i3 := 0; i3 < si3; i3++ {
+			if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.Depends[%d]", i2, i3)
+			}
+			n += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "PackagesConda[%d].Package.Depends[%d]", i2, i3)
+			}
+			if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "PackagesConda[%d].Package.Depends[%d]", i2, i3)
+			}
+			if size > binenc.MaxAlloc {
+				return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "PackagesConda[%d].Package.Depends[%d]", i2, i3)
+			}
+			tmp = d.Arena(size)
+			if nr, err := io.ReadFull(r, tmp); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.Depends[%d]", i2, i3)
+			}
+			n += int64(size)
+			s.PackagesConda[i2].Package.Depends[i3] = *(*string)(unsafe.Pointer(&tmp))
+		}
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.License", i2)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "PackagesConda[%d].Package.License", i2)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "PackagesConda[%d].Package.License", i2)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "PackagesConda[%d].Package.License", i2)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.License", i2)
+		}
+		n += int64(size)
+		s.PackagesConda[i2].Package.License = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.MD5", i2)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "PackagesConda[%d].Package.MD5", i2)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "PackagesConda[%d].Package.MD5", i2)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "PackagesConda[%d].Package.MD5", i2)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.MD5", i2)
+		}
+		n += int64(size)
+		s.PackagesConda[i2].Package.MD5 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.Name", i2)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "PackagesConda[%d].Package.Name", i2)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "PackagesConda[%d].Package.Name", i2)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "PackagesConda[%d].Package.Name", i2)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.Name", i2)
+		}
+		n += int64(size)
+		s.PackagesConda[i2].Package.Name = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.Sha256", i2)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "PackagesConda[%d].Package.Sha256", i2)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "PackagesConda[%d].Package.Sha256", i2)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "PackagesConda[%d].Package.Sha256", i2)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.Sha256", i2)
+		}
+		n += int64(size)
+		s.PackagesConda[i2].Package.Sha256 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.Size", i2)
+		}
+		n += 4
+		s.PackagesConda[i2].Package.Size = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.Subdir", i2)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "PackagesConda[%d].Package.Subdir", i2)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "PackagesConda[%d].Package.Subdir", i2)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "PackagesConda[%d].Package.Subdir", i2)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.Subdir", i2)
+		}
+		n += int64(size)
+		s.PackagesConda[i2].Package.Subdir = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.Timestamp", i2)
+		}
+		n += 8
+		s.PackagesConda[i2].Package.Timestamp = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.Version", i2)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "PackagesConda[%d].Package.Version", i2)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "PackagesConda[%d].Package.Version", i2)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "PackagesConda[%d].Package.Version", i2)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Package.Version", i2)
+		}
+		n += int64(size)
+		s.PackagesConda[i2].Package.Version = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Constrains", i2)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "RepoData", n, "PackagesConda[%d].Constrains", i2)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "PackagesConda[%d].Constrains", i2)
+		}
+		if size > binenc.MaxAlloc/16 {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "PackagesConda[%d].Constrains", i2)
+		}
+		s.PackagesConda[i2].Constrains = make([]string, size)
+		si4 := size
+		for i4 := 0; i4 < si4; i4++ {
+			if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Constrains[%d]", i2, i4)
+			}
+			n += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "PackagesConda[%d].Constrains[%d]", i2, i4)
+			}
+			if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "PackagesConda[%d].Constrains[%d]", i2, i4)
+			}
+			if size > binenc.MaxAlloc {
+				return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "PackagesConda[%d].Constrains[%d]", i2, i4)
+			}
+			tmp = d.Arena(size)
+			if nr, err := io.ReadFull(r, tmp); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].Constrains[%d]", i2, i4)
+			}
+			n += int64(size)
+			s.PackagesConda[i2].Constrains[i4] = *(*string)(unsafe.Pointer(&tmp))
+		}
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].LegacyBz2Md5", i2)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "PackagesConda[%d].LegacyBz2Md5", i2)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "PackagesConda[%d].LegacyBz2Md5", i2)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "PackagesConda[%d].LegacyBz2Md5", i2)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].LegacyBz2Md5", i2)
+		}
+		n += int64(size)
+		s.PackagesConda[i2].LegacyBz2Md5 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].LicenseFamily", i2)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "PackagesConda[%d].LicenseFamily", i2)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "PackagesConda[%d].LicenseFamily", i2)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "PackagesConda[%d].LicenseFamily", i2)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "PackagesConda[%d].LicenseFamily", i2)
+		}
+		n += int64(size)
+		s.PackagesConda[i2].LicenseFamily = *(*string)(unsafe.Pointer(&tmp))
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Removed")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "RepoData", n, "Removed")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "Removed")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "Removed")
+	}
+	s.Removed = make([]string, size)
+	si5 := size
+	for i5 := 0; i5 < si5; i5++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Removed[%d]", i5)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", n, "Removed[%d]", i5)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "RepoData", n, "Removed[%d]", i5)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "RepoData", n, "Removed[%d]", i5)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "RepoData", n, "Removed[%d]", i5)
+		}
+		n += int64(size)
+		s.Removed[i5] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "RepoData", n, "RepoDataVersion")
+	}
+	n += 4
+	s.RepoDataVersion = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	return n, nil
+}
+
+func (s *RepoData) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 12
+	size += len(s.Info.Subdir)
+	for _, v := range s.Packages {
+		size += 32
+		size += len(v.Build) + len(v.License) + len(v.MD5) + len(v.Name) + len(v.Sha256) + len(v.Subdir) + len(v.Version)
+		for _, v1 := range v.Depends {
+			size += 2
+			size += len(v1)
+		}
+	}
+	for _, v := range s.PackagesConda {
+		size += 38
+		size += len(v.Package.Build) + len(v.Package.License) + len(v.Package.MD5) + len(v.Package.Name) + len(v.Package.Sha256) + len(v.Package.Subdir) + len(v.Package.Version) + len(v.LegacyBz2Md5) + len(v.LicenseFamily)
+		for _, v1 := range v.Package.Depends {
+			size += 2
+			size += len(v1)
+		}
+		for _, v1 := range v.Constrains {
+			size += 2
+			size += len(v1)
+		}
+	}
+	for _, v := range s.Removed {
+		size += 2
+		size += len(v)
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Info.Subdir)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Info.Subdir))
+	}
+	buf[offset] = byte(len(s.Info.Subdir))
+	buf[offset+1] = byte(len(s.Info.Subdir) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Info.Subdir)
+	offset += len(s.Info.Subdir)
+	if uint64(len(s.Packages)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Packages))
+	}
+	buf[offset] = byte(len(s.Packages))
+	buf[offset+1] = byte(len(s.Packages) >> 8)
+	offset += 2
+	for _, v := range s.Packages {
+		if uint64(len(v.Build)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Build))
+		}
+		buf[offset] = byte(len(v.Build))
+		buf[offset+1] = byte(len(v.Build) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Build)
+		offset += len(v.Build)
+		buf[offset] = byte(v.BuildNumber)
+		buf[offset+1] = byte(v.BuildNumber >> 8)
+		buf[offset+2] = byte(v.BuildNumber >> 16)
+		buf[offset+3] = byte(v.BuildNumber >> 24)
+		offset += 4
+		if uint64(len(v.Depends)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Depends))
+		}
+		buf[offset] = byte(len(v.Depends))
+		buf[offset+1] = byte(len(v.Depends) >> 8)
+		offset += 2
+		for _, v1 := range v.Depends {
+			if uint64(len(v1)) > math.MaxUint16 {
+				return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v1))
+			}
+			buf[offset] = byte(len(v1))
+			buf[offset+1] = byte(len(v1) >> 8)
+			offset += 2
+			copy(buf[offset:], v1)
+			offset += len(v1)
+		}
+		if uint64(len(v.License)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.License))
+		}
+		buf[offset] = byte(len(v.License))
+		buf[offset+1] = byte(len(v.License) >> 8)
+		offset += 2
+		copy(buf[offset:], v.License)
+		offset += len(v.License)
+		if uint64(len(v.MD5)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.MD5))
+		}
+		buf[offset] = byte(len(v.MD5))
+		buf[offset+1] = byte(len(v.MD5) >> 8)
+		offset += 2
+		copy(buf[offset:], v.MD5)
+		offset += len(v.MD5)
+		if uint64(len(v.Name)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Name))
+		}
+		buf[offset] = byte(len(v.Name))
+		buf[offset+1] = byte(len(v.Name) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Name)
+		offset += len(v.Name)
+		if uint64(len(v.Sha256)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Sha256))
+		}
+		buf[offset] = byte(len(v.Sha256))
+		buf[offset+1] = byte(len(v.Sha256) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Sha256)
+		offset += len(v.Sha256)
+		buf[offset] = byte(v.Size)
+		buf[offset+1] = byte(v.Size >> 8)
+		buf[offset+2] = byte(v.Size >> 16)
+		buf[offset+3] = byte(v.Size >> 24)
+		offset += 4
+		if uint64(len(v.Subdir)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Subdir))
+		}
+		buf[offset] = byte(len(v.Subdir))
+		buf[offset+1] = byte(len(v.Subdir) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Subdir)
+		offset += len(v.Subdir)
+		buf[offset] = byte(v.Timestamp)
+		buf[offset+1] = byte(v.Timestamp >> 8)
+		buf[offset+2] = byte(v.Timestamp >> 16)
+		buf[offset+3] = byte(v.Timestamp >> 24)
+		buf[offset+4] = byte(v.Timestamp >> 32)
+		buf[offset+5] = byte(v.Timestamp >> 40)
+		buf[offset+6] = byte(v.Timestamp >> 48)
+		buf[offset+7] = byte(v.Timestamp >> 56)
+		offset += 8
+		if uint64(len(v.Version)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Version))
+		}
+		buf[offset] = byte(len(v.Version))
+		buf[offset+1] = byte(len(v.Version) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Version)
+		offset += len(v.Version)
+	}
+	if uint64(len(s.PackagesConda)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.PackagesConda))
+	}
+	buf[offset] = byte(len(s.PackagesConda))
+	buf[offset+1] = byte(len(s.PackagesConda) >> 8)
+	offset += 2
+	for _, v := range s.PackagesConda {
+		if uint64(len(v.Package.Build)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.Build))
+		}
+		buf[offset] = byte(len(v.Package.Build))
+		buf[offset+1] = byte(len(v.Package.Build) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Package.Build)
+		offset += len(v.Package.Build)
+		buf[offset] = byte(v.Package.BuildNumber)
+		buf[offset+1] = byte(v.Package.BuildNumber >> 8)
+		buf[offset+2] = byte(v.Package.BuildNumber >> 16)
+		buf[offset+3] = byte(v.Package.BuildNumber >> 24)
+		offset += 4
+		if uint64(len(v.Package.Depends)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.Depends))
+		}
+		buf[offset] = byte(len(v.Package.Depends))
+		buf[offset+1] = byte(len(v.Package.Depends) >> 8)
+		offset += 2
+		for _, v1 := range v.Package.Depends {
+			if uint64(len(v1)) > math.MaxUint16 {
+				return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v1))
+			}
+			buf[offset] = byte(len(v1))
+			buf[offset+1] = byte(len(v1) >> 8)
+			offset += 2
+			copy(buf[offset:], v1)
+			offset += len(v1)
+		}
+		if uint64(len(v.Package.License)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.License))
+		}
+		buf[offset] = byte(len(v.Package.License))
+		buf[offset+1] = byte(len(v.Package.License) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Package.License)
+		offset += len(v.Package.License)
+		if uint64(len(v.Package.MD5)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.MD5))
+		}
+		buf[offset] = byte(len(v.Package.MD5))
+		buf[offset+1] = byte(len(v.Package.MD5) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Package.MD5)
+		offset += len(v.Package.MD5)
+		if uint64(len(v.Package.Name)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.Name))
+		}
+		buf[offset] = byte(len(v.Package.Name))
+		buf[offset+1] = byte(len(v.Package.Name) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Package.Name)
+		offset += len(v.Package.Name)
+		if uint64(len(v.Package.Sha256)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.Sha256))
+		}
+		buf[offset] = byte(len(v.Package.Sha256))
+		buf[offset+1] = byte(len(v.Package.Sha256) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Package.Sha256)
+		offset += len(v.Package.Sha256)
+		buf[offset] = byte(v.Package.Size)
+		buf[offset+1] = byte(v.Package.Size >> 8)
+		buf[offset+2] = byte(v.Package.Size >> 16)
+		buf[offset+3] = byte(v.Package.Size >> 24)
+		offset += 4
+		if uint64(len(v.Package.Subdir)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.Subdir))
+		}
+		buf[offset] = byte(len(v.Package.Subdir))
+		buf[offset+1] = byte(len(v.Package.Subdir) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Package.Subdir)
+		offset += len(v.Package.Subdir)
+		buf[offset] = byte(v.Package.Timestamp)
+		buf[offset+1] = byte(v.Package.Timestamp >> 8)
+		buf[offset+2] = byte(v.Package.Timestamp >> 16)
+		buf[offset+3] = byte(v.Package.Timestamp >> 24)
+		buf[offset+4] = byte(v.Package.Timestamp >> 32)
+		buf[offset+5] = byte(v.Package.Timestamp >> 40)
+		buf[offset+6] = byte(v.Package.Timestamp >> 48)
+		buf[offset+7] = byte(v.Package.Timestamp >> 56)
+		offset += 8
+		if uint64(len(v.Package.Version)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Package.Version))
+		}
+		buf[offset] = byte(len(v.Package.Version))
+		buf[offset+1] = byte(len(v.Package.Version) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Package.Version)
+		offset += len(v.Package.Version)
+		if uint64(len(v.Constrains)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Constrains))
+		}
+		buf[offset] = byte(len(v.Constrains))
+		buf[offset+1] = byte(len(v.Constrains) >> 8)
+		offset += 2
+		for _, v1 := range v.Constrains {
+			if uint64(len(v1)) > math.MaxUint16 {
+				return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v1))
+			}
+			buf[offset] = byte(len(v1))
+			buf[offset+1] = byte(len(v1) >> 8)
+			offset += 2
+			copy(buf[offset:], v1)
+			offset += len(v1)
+		}
+		if uint64(len(v.LegacyBz2Md5)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.LegacyBz2Md5))
+		}
+		buf[offset] = byte(len(v.LegacyBz2Md5))
+		buf[offset+1] = byte(len(v.LegacyBz2Md5) >> 8)
+		offset += 2
+		copy(buf[offset:], v.LegacyBz2Md5)
+		offset += len(v.LegacyBz2Md5)
+		if uint64(len(v.LicenseFamily)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.LicenseFamily))
+		}
+		buf[offset] = byte(len(v.LicenseFamily))
+		buf[offset+1] = byte(len(v.LicenseFamily) >> 8)
+		offset += 2
+		copy(buf[offset:], v.LicenseFamily)
+		offset += len(v.LicenseFamily)
+	}
+	if uint64(len(s.Removed)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Removed))
+	}
+	buf[offset] = byte(len(s.Removed))
+	buf[offset+1] = byte(len(s.Removed) >> 8)
+	offset += 2
+	for _, v := range s.Removed {
+		if uint64(len(v)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	buf[offset] = byte(s.RepoDataVersion)
+	buf[offset+1] = byte(s.RepoDataVersion >> 8)
+	buf[offset+2] = byte(s.RepoDataVersion >> 16)
+	buf[offset+3] = byte(s.RepoDataVersion >> 24)
+	offset += 4
+	return buf, nil
+}
+
+func (s *RepoData) EncodedSize() int {
+	size := 12
+	size += len(s.Info.Subdir)
+	for _, v := range s.Packages {
+		size += 32
+		size += len(v.Build) + len(v.License) + len(v.MD5) + len(v.Name) + len(v.Sha256) + len(v.Subdir) + len(v.Version)
+		for _, v1 := range v.Depends {
+			size += 2
+			size += len(v1)
+		}
+	}
+	for _, v := range s.PackagesConda {
+		size += 38
+		size += len(v.Package.Build) + len(v.Package.License) + len(v.Package.MD5) + len(v.Package.Name) + len(v.Package.Sha256) + len(v.Package.Subdir) + len(v.Package.Version) + len(v.LegacyBz2Md5) + len(v.LicenseFamily)
+		for _, v1 := range v.Package.Depends {
+			size += 2
+			size += len(v1)
+		}
+		for _, v1 := range v.Constrains {
+			size += 2
+			size += len(v1)
+		}
+	}
+	for _, v := range s.Removed {
+		size += 2
+		size += len(v)
+	}
+	return size
+}
+
+func (s *RepoData) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *RepoData) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *RepoData) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Info.Subdir")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "Info.Subdir")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Info.Subdir")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Info.Subdir")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Info.Subdir = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "RepoData", int64(offset), "Packages")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages")
+	}
+	s.Packages = make([]Package, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Build", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "Packages[%d].Build", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Build", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Build", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Packages[i].Build = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 6 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].BuildNumber", i)
+		}
+		buf = data[offset : offset+4]
+		offset += 4
+		s.Packages[i].BuildNumber = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "RepoData", int64(offset), "Packages[%d].Depends", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Depends", i)
+		}
+		s.Packages[i].Depends = make([]string, size)
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if len(data)-offset < 2 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Depends[%d]", i, i1)
+			}
+			buf = data[offset : offset+2]
+			offset += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "Packages[%d].Depends[%d]", i, i1)
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Depends[%d]", i, i1)
+			}
+			if c-m < size {
+				c = size
+				if c < 2*cap(strBuf) {
+					c = 2 * cap(strBuf)
+				}
+				strBuf = append([]byte(nil), make([]byte, c)...)
+				m = 0
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Depends[%d]", i, i1)
+			}
+			offset += copy(strBuf[m:m+size], data[offset:])
+			tmp = strBuf[m : m+size]
+			s.Packages[i].Depends[i1] = *(*string)(unsafe.Pointer(&tmp))
+			m += size
+		}
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].License", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "Packages[%d].License", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].License", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].License", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Packages[i].License = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].MD5", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "Packages[%d].MD5", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].MD5", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].MD5", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Packages[i].MD5 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Name", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "Packages[%d].Name", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Name", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Name", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Packages[i].Name = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Sha256", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "Packages[%d].Sha256", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Sha256", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Sha256", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Packages[i].Sha256 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 6 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Size", i)
+		}
+		buf = data[offset : offset+4]
+		offset += 4
+		s.Packages[i].Size = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "Packages[%d].Subdir", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Subdir", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Subdir", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Packages[i].Subdir = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 10 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Timestamp", i)
+		}
+		buf = data[offset : offset+8]
+		offset += 8
+		s.Packages[i].Timestamp = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "Packages[%d].Version", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Version", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Packages[%d].Version", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Packages[i].Version = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "RepoData", int64(offset), "PackagesConda")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda")
+	}
+	s.PackagesConda = make([]PackageConda, size)
+	si2 := size
+	for i2 := 0; i2 < si2; i2++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Build", i2)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "PackagesConda[%d].Package.Build", i2)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Build", i2)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Build", i2)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.PackagesConda[i2].Package.Build = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 6 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.BuildNumber", i2)
+		}
+		buf = data[offset : offset+4]
+		offset += 4
+		s.PackagesConda[i2].Package.BuildNumber = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "RepoData", int64(offset), "PackagesConda[%d].Package.Depends", i2)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Depends", i2)
+		}
+		s.PackagesConda[i2].Package.Depends = make([]string, size)
+		si3 := size
+		for i3 := 0; i3 < si3; i3++ {
+			if len(data)-offset < 2 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Depends[%d]", i2, i3)
+			}
+			buf = data[offset : offset+2]
+			offset += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "PackagesConda[%d].Package.Depends[%d]", i2, i3)
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Depends[%d]", i2, i3)
+			}
+			if c-m < size {
+				c = size
+				if c < 2*cap(strBuf) {
+					c = 2 * cap(strBuf)
+				}
+				strBuf = append([]byte(nil), make([]byte, c)...)
+				m = 0
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Depends[%d]", i2, i3)
+			}
+			offset += copy(strBuf[m:m+size], data[offset:])
+			tmp = strBuf[m : m+size]
+			s.PackagesConda[i2].Package.Depends[i3] = *(*string)(unsafe.Pointer(&tmp))
+			m += size
+		}
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.License", i2)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "PackagesConda[%d].Package.License", i2)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.License", i2)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.License", i2)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.PackagesConda[i2].Package.License = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.MD5", i2)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "PackagesConda[%d].Package.MD5", i2)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.MD5", i2)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.MD5", i2)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.PackagesConda[i2].Package.MD5 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Name", i2)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "PackagesConda[%d].Package.Name", i2)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Name", i2)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Name", i2)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.PackagesConda[i2].Package.Name = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Sha256", i2)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "PackagesConda[%d].Package.Sha256", i2)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Sha256", i2)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Sha256", i2)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.PackagesConda[i2].Package.Sha256 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 6 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Size", i2)
+		}
+		buf = data[offset : offset+4]
+		offset += 4
+		s.PackagesConda[i2].Package.Size = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "PackagesConda[%d].Package.Subdir", i2)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Subdir", i2)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Subdir", i2)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.PackagesConda[i2].Package.Subdir = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 10 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Timestamp", i2)
+		}
+		buf = data[offset : offset+8]
+		offset += 8
+		s.PackagesConda[i2].Package.Timestamp = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "PackagesConda[%d].Package.Version", i2)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Version", i2)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Package.Version", i2)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.PackagesConda[i2].Package.Version = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Constrains", i2)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "RepoData", int64(offset), "PackagesConda[%d].Constrains", i2)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Constrains", i2)
+		}
+		s.PackagesConda[i2].Constrains = make([]string, size)
+		si4 := size
+		for i4 := 0; i4 < si4; i4++ {
+			if len(data)-offset < 2 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Constrains[%d]", i2, i4)
+			}
+			buf = data[offset : offset+2]
+			offset += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "PackagesConda[%d].Constrains[%d]", i2, i4)
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Constrains[%d]", i2, i4)
+			}
+			if c-m < size {
+				c = size
+				if c < 2*cap(strBuf) {
+					c = 2 * cap(strBuf)
+				}
+				strBuf = append([]byte(nil), make([]byte, c)...)
+				m = 0
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].Constrains[%d]", i2, i4)
+			}
+			offset += copy(strBuf[m:m+size], data[offset:])
+			tmp = strBuf[m : m+size]
+			s.PackagesConda[i2].Constrains[i4] = *(*string)(unsafe.Pointer(&tmp))
+			m += size
+		}
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].LegacyBz2Md5", i2)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "PackagesConda[%d].LegacyBz2Md5", i2)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].LegacyBz2Md5", i2)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].LegacyBz2Md5", i2)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.PackagesConda[i2].LegacyBz2Md5 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].LicenseFamily", i2)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "PackagesConda[%d].LicenseFamily", i2)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].LicenseFamily", i2)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "PackagesConda[%d].LicenseFamily", i2)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.PackagesConda[i2].LicenseFamily = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Removed")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "RepoData", int64(offset), "Removed")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Removed")
+	}
+	s.Removed = make([]string, size)
+	si5 := size
+	for i5 := 0; i5 < si5; i5++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Removed[%d]", i5)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "RepoData", int64(offset), "Removed[%d]", i5)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Removed[%d]", i5)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "Removed[%d]", i5)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Removed[i5] = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	}
+	if len(data)-offset < 4 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "RepoData", int64(offset), "RepoDataVersion")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.RepoDataVersion = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	return offset, nil
+}
+
+func (s *RepoData) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
@@ -30,13 +30,34 @@
 
 // go-binenc-gen is a tool to automate the creation of binary serialization methods.
 // Given the name of a Go source file containing structs definitions, go-binenc-gen
-// will create a new self-contained Go source file implementing
+// will create a new Go source file implementing
 //
-//	func (s *T) WriteTo(w io.Writer) (n int, err error)
-//	func (s *T) ReadFrom(r io.Reader) (err error)
+//	func (s *T) WriteTo(w io.Writer) (n int64, err error)
+//	func (s *T) ReadFrom(r io.Reader) (n int64, err error)
+//	func (s *T) AppendBinary(dst []byte) ([]byte, error)
+//	func (s *T) EncodedSize() int
+//	func (s *T) MarshalBinary() ([]byte, error)
+//	func (s *T) UnmarshalBinary(data []byte) error
+//	func (s *T) DecodeFrom(data []byte) (n int, err error)
 //
 // The file is created in the same package and directory as the package that defines
-// T. It has helpful defaults designed for use with go generate.
+// T. It has helpful defaults designed for use with go generate: given a single file
+// such as example.go, the output is example_encoding.go, so several directives can
+// coexist in one package. The -output flag overrides the file name.
+//
+// The generated file imports github.com/cezarguimaraes/go-binenc-gen/binenc,
+// which holds the types shared by the generated code, such as its options and
+// errors. The module of the package then requires the module of go-binenc-gen
+// at run time, not only to generate the file.
+//
+// Structs behind build constraints are loaded with the -tags flag, as in
+// -tags=linux,cgo, when a directory is given. The -gobuild flag then stamps the
+// output with a matching //go:build line.
+//
+// Methods are generated for every struct type of the package, unless the -type
+// flag lists the ones to generate, as in -type=Header,Request. The struct types
+// of the package referenced by those are included as well. Names that are not
+// struct types of the package are reported, and no output is written.
 //
 // The goal of the tool is to generate extremely fast binary serialization code by
 // forfeiting the convenience of runtime reflection enabled libraries, such as
@@ -53,7 +74,7 @@
 //
 //	type Request struct {
 //		Headers []Header
-//		ResponseTime uin64
+//		ResponseTime uint64
 //	}
 //
 // running this command
@@ -63,16 +84,16 @@
 // in the same directory will create the file example_encoding.go, in package example,
 // containing definitions of
 //
-//	func (s *Header) WriteTo(w io.Writer) (n int, err error)
-//	func (s *Header) ReadFrom(r io.Reader) (err error)
-//	func (s *Request) WriteTo(w io.Writer) (n int, err error)
-//	func (s *Request) ReadFrom(r io.Reader) (err error)
+//	func (s *Header) WriteTo(w io.Writer) (n int64, err error)
+//	func (s *Header) ReadFrom(r io.Reader) (n int64, err error)
+//	func (s *Request) WriteTo(w io.Writer) (n int64, err error)
+//	func (s *Request) ReadFrom(r io.Reader) (n int64, err error)
 //
 // These methods will serialize Header and Request objects, using a single allocation
 // per WriteTo. For Reads, there will be as many allocations as pointers and slices in
 // the struct:
 //
-//	func (s *Request) WriteTo(w io.Writer) (n int, err error) {
+//	func (s *Request) WriteTo(w io.Writer) (n int64, err error) {
 //	        size := 10
 //	        for _, v := range s.Headers {
 //	                size += 4
@@ -80,23 +101,30 @@
 //	        }
 //	        buf := make([]byte, size)
 //	        offset := 0
-//	        // Headers
+//	        if uint64(len(s.Headers)) > math.MaxUint16 {
+//	                return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Headers))
+//	        }
 //	        buf[offset] = byte(len(s.Headers))
 //	        buf[offset+1] = byte(len(s.Headers) >> 8)
 //	        offset += 2
 //	        for _, v := range s.Headers {
+//	                if uint64(len(v.Name)) > math.MaxUint16 {
+//	                        return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Name))
+//	                }
 //	                buf[offset] = byte(len(v.Name))
 //	                buf[offset+1] = byte(len(v.Name) >> 8)
 //	                offset += 2
 //	                copy(buf[offset:], v.Name)
 //	                offset += len(v.Name)
+//	                if uint64(len(v.Value)) > math.MaxUint16 {
+//	                        return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Value))
+//	                }
 //	                buf[offset] = byte(len(v.Value))
 //	                buf[offset+1] = byte(len(v.Value) >> 8)
 //	                offset += 2
 //	                copy(buf[offset:], v.Value)
 //	                offset += len(v.Value)
 //	        }
-//	        // ResponseTime
 //	        buf[offset] = byte(s.ResponseTime)
 //	        buf[offset+1] = byte(s.ResponseTime >> 8)
 //	        buf[offset+2] = byte(s.ResponseTime >> 16)
@@ -106,37 +134,209 @@
 //	        buf[offset+6] = byte(s.ResponseTime >> 48)
 //	        buf[offset+7] = byte(s.ResponseTime >> 56)
 //	        offset += 8
-//	        return w.Write(buf)
+//	        nw, err := w.Write(buf)
+//	        return int64(nw), err
+//	}
+//
+//	func (s *Request) ReadFrom(r io.Reader) (n int64, err error) {
+//	        return s.ReadFromOptions(r, binenc.DecodeOptions{})
+//	}
+//
+//	func (s *Request) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+//	        return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
 //	}
 //
-//	func (s *Request) Read(r io.Reader) error {
-//	        buf := make([]byte, 8)
-//	        var size uint16
-//	        // Headers
-//	        r.Read(buf[:2])
-//	        size = uint16(buf[0]) | (uint16(buf[1]) << 8)
+//	func (s *Request) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+//	        r := d.Reader()
+//	        buf := d.Buffer()
+//	        opts := d.Options()
+//	        var size int
+//	        var tmp []byte
+//	        if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+//	                return n + int64(nr), binenc.FieldError(err, "Request", n, "Headers")
+//	        }
+//	        n += 2
+//	        size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+//	        if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+//	                return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Request", n, "Headers")
+//	        }
+//	        // the MaxBytes and binenc.MaxAlloc checks of Headers
+//	        ...
 //	        s.Headers = make([]Header, size)
-//	        si := int(size)
+//	        si := size
 //	        for i := 0; i < si; i++ {
-//	                r.Read(buf[:2])
-//	                size = uint16(buf[0]) | (uint16(buf[1]) << 8)
-//	                strBuf_0 := make([]byte, size)
-//	                r.Read(strBuf_0)
-//	                s.Headers[i].Name = *(*string)(unsafe.Pointer(&strBuf_0))
-//	                r.Read(buf[:2])
-//	                size = uint16(buf[0]) | (uint16(buf[1]) << 8)
-//	                strBuf_1 := make([]byte, size)
-//	                r.Read(strBuf_1)
-//	                s.Headers[i].Value = *(*string)(unsafe.Pointer(&strBuf_1))
+//	                // Name and Value are read into memory of the arena of d
+//	                ...
+//	        }
+//	        if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+//	                return n + int64(nr), binenc.FieldError(err, "Request", n, "ResponseTime")
 //	        }
-//	        // ResponseTime
-//	        r.Read(buf[:8])
+//	        n += 8
 //	        s.ResponseTime = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
-//	        return nil
+//	        return n, nil
 //	}
 //
+// ReadFrom returns the number of bytes consumed. It returns io.EOF only when
+// r is exhausted before the first byte. Any other error is a
+// *binenc.DecodeError, which locates the failure by the path of the field being
+// decoded, such as Outer.Inners[3].Arr4[1], and its byte offset in the input.
+// It wraps the cause, io.ErrUnexpectedEOF when the input ends in the middle of
+// a value, which errors.Is reports.
+//
+// Each ReadFrom allocates its scratch memory and the arena of its strings. A
+// stream of values is rather decoded with a *binenc.Decoder, which keeps them
+// across values, so that decoding allocates for the values only:
+//
+//	d := binenc.NewDecoder(r)
+//	for {
+//		var v T
+//		if err := d.Decode(&v); err == io.EOF {
+//			break
+//		} else if err != nil {
+//			return err
+//		}
+//		...
+//	}
+//
+// Decode calls the generated DecodeBinenc method, on which ReadFrom is built.
+//
+// The encoding of a value does not mark where it ends. To stream values over
+// a connection or a pipe, the binenc/frame package of this module prefixes
+// each with its length: its Encoder batches the frames into a buffered writer,
+// and its Decoder reads them one at a time, up to an optional maximum size.
+//
+// The methods implement io.WriterTo and io.ReaderFrom. The -legacy flag generates
+// the signatures of earlier versions instead:
+//
+//	func (s *T) WriteTo(w io.Writer) (n int, err error)
+//	func (s *T) ReadFrom(r io.Reader) error
+//
+// MarshalBinary and UnmarshalBinary implement encoding.BinaryMarshaler and
+// encoding.BinaryUnmarshaler with the same encoding. UnmarshalBinary returns an
+// error when bytes are left over, unlike DecodeFrom, which decodes a value
+// from the start of data and returns the number of bytes consumed.
+//
+// DecodeFrom indexes data directly instead of going through an io.Reader. It
+// checks the bounds of data once for each run of fixed size values, and returns
+// an error wrapping io.ErrUnexpectedEOF when data is too short, along with the
+// bytes consumed by the values decoded before. The error then names the first
+// field of the run.
+//
+// AppendBinary appends the encoding to dst, growing it at most once, and
+// returns the extended slice. Reusing the returned slice, truncated to the
+// desired length, encodes many values without allocating. On error, dst is
+// returned with its original length.
+//
+// EncodedSize returns the number of bytes written by the other methods, without
+// encoding. It is computed by the same size pass, which only walks the
+// variable length parts of T.
+//
+// ReadFrom and DecodeFrom trust the lengths they read, and allocate for them,
+// up to binenc.MaxAlloc bytes when reading from r: a longer length, such as a
+// corrupt one, returns an error wrapping binenc.ErrTooLarge. Maps read from r
+// start with the room bounded by binenc.MapHint, and grow with the entries
+// actually read. Untrusted input is decoded with limits instead, set per call:
+//
+//	func (s *T) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error)
+//	func (s *T) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (n int, err error)
+//
+// The options bound the length of slices, maps and strings, the bytes consumed,
+// and the nesting depth of recursive types. Input exceeding one returns an
+// error wrapping a *binenc.LimitError, which errors.As finds, before anything
+// is allocated for it.
+//
+// With the -zerocopy flag, the strings and []byte values decoded by DecodeFrom
+// and UnmarshalBinary point into data instead of being copied, which saves
+// their allocations. The caller then owns the lifetime of data: it must not be
+// modified while any decoded value is in use, as the strings would change, and
+// it is kept alive as long as any of them is. Appending to a decoded []byte
+// copies it first. Unlike what encoding.BinaryUnmarshaler asks for,
+// UnmarshalBinary then retains data after returning. ReadFrom is unaffected.
+//
+// Decoding allocates the slices, maps and pointed to values of the value
+// decoded. With the -reuse flag, it decodes into those already held by the
+// value instead: slices with enough capacity are resliced, maps are cleared
+// and non-nil pointers are decoded through. Decoding into the same value in a
+// loop then stops allocating once it is large enough, but the values decoded
+// before are overwritten, including the elements of a slice up to its
+// capacity. The flag also generates
+//
+//	func (s *T) Reset()
+//
+// which sets s to the zero value but keeps the capacity of its slices and the
+// buckets of its maps, to put it back into a pool. Pointers are set to nil.
+//
 // Note that the serialization of Header objects during the serialization of a Request
-// is inlined. For that reason, recursive definitions are not yet supported.
+// is inlined. Recursive types, such as
+//
+//	type Node struct {
+//		Children []Node
+//		Next     *Node
+//	}
+//
+// are inlined only once: nested values are serialized through the unexported
+// sizeBinenc, writeBinenc, readBinenc and decodeBinenc methods, which call each
+// other. Values holding pointer cycles are not supported.
+//
+// The encoding does not depend on the architecture running the generated code.
+// Integers are written in little endian order, or big endian with the
+// -endian big flag, and floats through their IEEE 754 bit patterns. int, uint
+// and uintptr values take 8 bytes, or 4 bytes with the -wordsize 4 flag; values
+// that do not fit are reported as errors rather than silently truncated.
+//
+// With the -enc varint flag, integers wider than a byte are instead encoded as
+// LEB128 unsigned varints, as in encoding/binary, and signed integers are zigzag
+// encoded first, so that small values take few bytes whatever their sign.
+//
+// Slices and arrays of bytes are written with a single copy and read with a
+// single read. So are those of fixed width numbers when both the host and the
+// wire order are little endian, through an unsafe view of their memory; other
+// hosts encode them element by element, to the same bytes.
+//
+// Strings are read into an arena shared by the strings of a value, or of all
+// the values decoded by a Decoder, which they reference through an unsafe
+// conversion. The -safe flag generates code that does not import unsafe, for
+// the same encoding: strings are then copied out of the input one by one, and
+// numbers other than bytes are always encoded element by element. With -safe,
+// -zerocopy only aliases []byte values.
+//
+// Strings, slices and maps are prefixed with their length, encoded in 2 bytes
+// by default. The -lensize flag selects 1, 2, 4 or 8 byte lengths, or uvarint
+// lengths, and a struct field may override it with a tag:
+//
+//	type Blob struct {
+//		Data []byte `binenc:"len=uvarint"`
+//	}
+//
+// WriteTo returns an error, without writing anything, when a length does not
+// fit its prefix.
+//
+// The binenc tag of a struct field holds comma-separated options:
+//
+//	type Packet struct {
+//		Seq     uint64            `binenc:"width=4,endian=big"`
+//		Payload []byte            `binenc:"len=4"`
+//		Cache   map[string][]byte `binenc:"-"`
+//	}
+//
+// A "-" tag skips the field. Otherwise, len sets the length prefix encoding;
+// width the number of bytes of integers, 1, 2, 4 or 8, with out of range values
+// reported as errors; endian the byte order of integers and floats; and enc the
+// encoding of integers, fixed or varint, overriding -enc; a width implies the
+// fixed encoding. order=N sets the position of the field on the wire, so that
+// reordering the fields of a struct keeps its encoding; it must then be set on
+// all of its fields. The options apply to the value of the field up to nested
+// structs. Unknown or misplaced options are reported with their position, and
+// no output is written.
+//
+// A directive in the doc comment of a type sets the byte order of its values,
+// wherever they are encoded:
+//
+//	//binenc:endian=big
+//	type Addr uint32
+//
+// Unknown or invalid directives are reported with their position, and no output
+// is written.
 package main
 
 import (
@@ -150,18 +350,56 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/cezarguimaraes/go-binenc-gen/encoder"
 	"golang.org/x/tools/go/packages"
 )
 
+var (
+	typeNames = flag.String("type", "", "comma-separated list of struct type names; default all structs")
+	buildTags = flag.String("tags", "", "comma-separated list of build tags to apply")
+	goBuild   = flag.Bool("gobuild", false, "add a //go:build line requiring the -tags build tags to the output file")
+	output    = flag.String("output", "", "output file name; default srcdir/<file>_encoding.go, or srcdir/<pkg>_encoding.go for directories")
+	wordSize  = flag.Int("wordsize", 8, "number of bytes used for int, uint and uintptr values; 4 or 8")
+	lenSize   = flag.String("lensize", "2", "encoding of string, slice and map lengths; 1, 2, 4, 8 or uvarint")
+	endian    = flag.String("endian", "little", "byte order of integers and floats; little or big")
+	intEnc    = flag.String("enc", "fixed", "encoding of integers wider than a byte; fixed or varint")
+	safe      = flag.Bool("safe", false, "generate code that does not import unsafe, with the same encoding")
+	zeroCopy  = flag.Bool("zerocopy", false, "make DecodeFrom and UnmarshalBinary alias their input with the decoded strings and []byte values")
+	reuse     = flag.Bool("reuse", false, "decode into the slices, maps and pointers already held by the value, and generate a Reset method keeping them")
+	legacy    = flag.Bool("legacy", false, "generate the WriteTo and ReadFrom signatures of earlier versions, which do not implement io.WriterTo and io.ReaderFrom")
+)
+
 func main() {
 	log.SetFlags(0)
 	log.SetPrefix("binenc: ")
 
 	flag.Parse()
-	tags := []string{}
+	if *wordSize != 4 && *wordSize != 8 {
+		log.Fatalf("invalid -wordsize %d: must be 4 or 8", *wordSize)
+	}
+	lenBytes, err := encoder.ParseLenSize(*lenSize)
+	if err != nil {
+		log.Fatalf("-lensize: %s", err)
+	}
+	byteOrder, err := encoder.ParseEndian(*endian)
+	if err != nil {
+		log.Fatalf("-endian: %s", err)
+	}
+	encoding, err := encoder.ParseEncoding(*intEnc)
+	if err != nil {
+		log.Fatalf("-enc: %s", err)
+	}
+	var tags []string
+	if len(*buildTags) > 0 {
+		tags = strings.Split(*buildTags, ",")
+	}
+	if *goBuild && len(tags) == 0 {
+		log.Fatal("-gobuild requires build tags set with -tags")
+	}
 	args := flag.Args()
 	if len(args) == 0 {
 		args = []string{"."}
@@ -177,28 +415,65 @@ func main() {
 		dir = filepath.Dir(args[0])
 	}
 
-	g := &Generator{}
+	g := &Generator{
+		legacy: *legacy,
+		opts: encoder.Options{
+			WordSize: *wordSize,
+			LenSize:  lenBytes,
+			Endian:   byteOrder,
+			Encoding: encoding,
+			ZeroCopy: *zeroCopy,
+			Safe:     *safe,
+			Reuse:    *reuse,
+		},
+	}
+	if len(*typeNames) > 0 {
+		g.typeNames = strings.Split(*typeNames, ",")
+	}
 	g.parsePackage(args, tags)
 
 	g.generate()
 
 	fmt.Fprintf(&g.hdr, "// Code generated by \"gobinenc %s\"; DO NOT EDIT.\n", strings.Join(os.Args[1:], " "))
 	fmt.Fprintf(&g.hdr, "\n")
+	if *goBuild {
+		fmt.Fprintf(&g.hdr, "//go:build %s\n", strings.Join(tags, " && "))
+		fmt.Fprintf(&g.hdr, "\n")
+	}
 	fmt.Fprintf(&g.hdr, "package %s", g.pkg.name)
 	fmt.Fprintf(&g.hdr, "\n")
 
 	fmt.Fprintf(&g.hdr, "import (\n")
-	fmt.Fprintf(&g.hdr, "\t\"io\"\n")
-	if g.needUnsafe {
-		fmt.Fprintf(&g.hdr, "\t\"unsafe\"\n")
+	paths := g.sortedImports()
+	for _, path := range paths {
+		if isStd(path) {
+			fmt.Fprintf(&g.hdr, "\t%q\n", path)
+		}
+	}
+	for i, path := range paths {
+		if !isStd(path) {
+			if i == 0 || isStd(paths[i-1]) {
+				fmt.Fprintf(&g.hdr, "\n")
+			}
+			fmt.Fprintf(&g.hdr, "\t%q\n", path)
+		}
 	}
 	fmt.Fprintf(&g.hdr, ")")
 	fmt.Fprintf(&g.hdr, "\n")
 
 	src := g.format()
-	baseName := fmt.Sprintf("%s_encoding.go", g.pkg.name)
-	outputName := filepath.Join(dir, strings.ToLower(baseName))
-	err := os.WriteFile(outputName, src, 0644)
+	outputName := *output
+	if outputName == "" {
+		// a single file is generated on its own, which allows several
+		// go:generate directives in the same package
+		name := g.pkg.name
+		if len(args) == 1 && strings.HasSuffix(args[0], ".go") {
+			name = strings.TrimSuffix(filepath.Base(args[0]), ".go")
+		}
+		baseName := fmt.Sprintf("%s_encoding.go", name)
+		outputName = filepath.Join(dir, strings.ToLower(baseName))
+	}
+	err = os.WriteFile(outputName, src, 0644)
 	if err != nil {
 		log.Fatalf("writing output: %s", err)
 	}
@@ -229,7 +504,12 @@ type File struct {
 type Package struct {
 	name     string
 	typeInfo *types.Info
+	fset     *token.FileSet
 	files    []*File
+	// endian holds the byte order set by binenc:endian directives
+	endian map[*types.TypeName]encoder.Endian
+	// directiveErrors counts the reported directive errors
+	directiveErrors int
 }
 
 type Generator struct {
@@ -237,8 +517,24 @@ type Generator struct {
 	buf   bytes.Buffer
 	pkg   *Package
 	types *types.Package
+	fset  *token.FileSet
+
+	// tagErrors holds the reported struct tag errors
+	tagErrors map[string]bool
 
-	needUnsafe bool
+	// helpers holds the recursive types whose helper methods
+	// must be generated
+	helpers     []*types.Named
+	seenHelpers map[*types.Named]bool
+
+	// typeNames holds the types selected with -type, if any
+	typeNames []string
+
+	// legacy selects the WriteTo(io.Writer) (int, error) and
+	// ReadFrom(io.Reader) error signatures
+	legacy  bool
+	opts    encoder.Options
+	imports map[string]bool
 }
 
 func (g *Generator) parsePackage(patterns, tags []string) {
@@ -263,9 +559,12 @@ func (g *Generator) addPackage(pkg *packages.Package) {
 	g.pkg = &Package{
 		name:     pkg.Name,
 		typeInfo: pkg.TypesInfo,
+		fset:     pkg.Fset,
 		files:    make([]*File, len(pkg.Syntax)),
+		endian:   make(map[*types.TypeName]encoder.Endian),
 	}
 	g.types = pkg.Types
+	g.fset = pkg.Fset
 
 	for i, file := range pkg.Syntax {
 		g.pkg.files[i] = &File{
@@ -276,21 +575,232 @@ func (g *Generator) addPackage(pkg *packages.Package) {
 }
 
 func (g *Generator) generate() {
+	g.imports = map[string]bool{"io": true}
 	for _, file := range g.pkg.files {
 		log.Printf("generating file %s\n", file.file.Name)
 		if file.file != nil {
 			ast.Inspect(file.file, file.inspectNode)
 		}
 	}
+	if g.pkg.directiveErrors > 0 {
+		log.Fatalf("%d invalid directives", g.pkg.directiveErrors)
+	}
 
+	if len(g.typeNames) > 0 {
+		g.selectTypes()
+	}
+
+	g.opts.TypeEndian = g.pkg.endian
 	for _, file := range g.pkg.files {
 		for _, s := range file.structs {
 			g.generateWrite(s)
 			g.generateRead(s)
+			g.generateAppend(s)
+			g.generateSize(s)
+			g.generateMarshal(s)
+			g.generateDecode(s)
+			g.generateUnmarshal(s)
+			if g.opts.Reuse {
+				g.generateReset(s)
+			}
+		}
+	}
+
+	// helpers may reference further recursive types
+	for i := 0; i < len(g.helpers); i++ {
+		g.generateHelpers(g.helpers[i])
+	}
+
+	if len(g.tagErrors) > 0 {
+		log.Fatalf("%d invalid struct tags", len(g.tagErrors))
+	}
+}
+
+// addErrors reports the struct tag errors found by e, once each.
+func (g *Generator) addErrors(e *encoder.Writer) {
+	if g.tagErrors == nil {
+		g.tagErrors = make(map[string]bool)
+	}
+	for _, err := range e.Errors() {
+		msg := err.Error()
+		if tagErr, ok := err.(*encoder.TagError); ok {
+			msg = fmt.Sprintf("%s: %s", g.fset.Position(tagErr.Field.Pos()), err)
+		}
+		if !g.tagErrors[msg] {
+			g.tagErrors[msg] = true
+			log.Print(msg)
+		}
+	}
+}
+
+// addImports records the imports used by the code generated by e.
+func (g *Generator) addImports(e *encoder.Writer) {
+	for _, path := range e.Imports() {
+		g.imports[path] = true
+	}
+}
+
+// isStd reports whether path is the import path of a standard package.
+func isStd(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(first, ".")
+}
+
+func (g *Generator) sortedImports() []string {
+	var paths []string
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// addHelpers schedules the generation of helper methods for the
+// recursive types referenced by e.
+func (g *Generator) addHelpers(e *encoder.Writer) {
+	if g.seenHelpers == nil {
+		g.seenHelpers = make(map[*types.Named]bool)
+	}
+	for _, t := range e.Recursive() {
+		if !g.seenHelpers[t] {
+			g.seenHelpers[t] = true
+			g.helpers = append(g.helpers, t)
 		}
 	}
 }
 
+// generateHelpers generates the sizeBinenc, writeBinenc, readBinenc and
+// decodeBinenc methods through which values of the recursive type t are serialized
+// when nested in themselves.
+func (g *Generator) generateHelpers(t *types.Named) {
+	name := t.Obj().Name()
+	recv := "s"
+	if _, ok := t.Underlying().(*types.Struct); !ok {
+		recv = "(*s)"
+	}
+
+	e := encoder.NewWriterOptions(g.types, g.opts)
+	e.WriteField(recv, t)
+	g.Printf("func (s *%s) sizeBinenc() int {\n", name)
+	g.Printf(e.SizeExpr())
+	g.Printf("\treturn size\n")
+	g.Printf("}\n\n")
+	g.Printf("func (s *%s) writeBinenc(buf []byte, offset int) (_ int, err error) {\n", name)
+	g.Printf(e.HeaderExpr())
+	e.WriteTo(&g.buf)
+	g.Printf("\treturn offset, nil\n")
+	g.Printf("}\n\n")
+	g.addImports(e)
+	g.addHelpers(e)
+	g.addErrors(e)
+
+	e = encoder.NewWriterOptions(g.types, g.opts)
+	e.Limits()
+	e.Depth("depth")
+	e.FieldErrors(name)
+	e.ReadField(recv, t)
+	g.Printf("func (s *%s) readBinenc(d *binenc.Decoder, n int64, depth int) (_ int64, err error) {\n", name)
+	g.printDepthCheck("n", "d.Options()")
+	g.Printf(e.HeaderExpr())
+	e.WriteTo(&g.buf)
+	g.Printf("\treturn n, nil\n")
+	g.Printf("}\n\n")
+	g.addImports(e)
+	g.addHelpers(e)
+	g.addErrors(e)
+
+	e = encoder.NewWriterOptions(g.types, g.opts)
+	e.FromBytes()
+	e.Limits()
+	e.Depth("depth")
+	e.FieldErrors(name)
+	e.ReadField(recv, t)
+	g.Printf("func (s *%s) decodeBinenc(data []byte, offset int, opts binenc.DecodeOptions, depth int) (_ int, err error) {\n", name)
+	g.printDepthCheck("offset", "opts")
+	g.Printf(e.HeaderExpr())
+	e.WriteTo(&g.buf)
+	g.Printf("\treturn offset, nil\n")
+	g.Printf("}\n\n")
+	g.addImports(e)
+	g.addHelpers(e)
+	g.addErrors(e)
+}
+
+// printDepthCheck prints the check of the nesting depth of a read helper
+// against the limits opts, which returns result along with the error.
+func (g *Generator) printDepthCheck(result, opts string) {
+	g.imports[encoder.RuntimePath] = true
+	g.Printf("\tif limit := %s.MaxDepth; limit > 0 && depth > limit {\n", opts)
+	g.Printf("\t\treturn %s, &binenc.LimitError{Limit: \"MaxDepth\", Max: int64(limit)}\n", result)
+	g.Printf("\t}\n")
+}
+
+// selectTypes keeps only the structs named with -type, along with the
+// structs of the package they reference.
+func (g *Generator) selectTypes() {
+	byName := make(map[string]*Struct)
+	for _, file := range g.pkg.files {
+		for _, s := range file.structs {
+			byName[s.Name] = s
+		}
+	}
+	selected := make(map[*Struct]bool)
+	seen := make(map[*types.Named]bool)
+	var visit func(t types.Type)
+	visit = func(t types.Type) {
+		switch t := t.(type) {
+		case *types.Named:
+			if seen[t] {
+				return
+			}
+			seen[t] = true
+			if s, ok := byName[t.Obj().Name()]; ok && t.Obj().Pkg() == g.types {
+				selected[s] = true
+			}
+			visit(t.Underlying())
+		case *types.Pointer:
+			visit(t.Elem())
+		case *types.Slice:
+			visit(t.Elem())
+		case *types.Array:
+			visit(t.Elem())
+		case *types.Map:
+			visit(t.Key())
+			visit(t.Elem())
+		case *types.Struct:
+			for i := 0; i < t.NumFields(); i++ {
+				skip := reflect.StructTag(t.Tag(i)).Get("binenc") == "-"
+				if t.Field(i).Name() != "_" && !skip {
+					visit(t.Field(i).Type())
+				}
+			}
+		}
+	}
+	missing := 0
+	for _, name := range g.typeNames {
+		s, ok := byName[name]
+		if !ok {
+			log.Printf("no struct type %s in package %s", name, g.pkg.name)
+			missing++
+			continue
+		}
+		visit(s.Type)
+	}
+	if missing > 0 {
+		log.Fatalf("%d of the -type names not found", missing)
+	}
+
+	for _, file := range g.pkg.files {
+		var structs []*Struct
+		for _, s := range file.structs {
+			if selected[s] {
+				structs = append(structs, s)
+			}
+		}
+		file.structs = structs
+	}
+}
+
 func (g *Generator) Printf(format string, args ...interface{}) {
 	fmt.Fprintf(&g.buf, format, args...)
 }
@@ -306,32 +816,158 @@ func (g *Generator) format() []byte {
 }
 
 func (g *Generator) generateWrite(s *Struct) {
-	g.Printf("func (s *%s) WriteTo(w io.Writer) (n int, err error) {\n", s.Name)
-	e := encoder.NewWriter(g.types)
+	if g.legacy {
+		g.Printf("func (s *%s) WriteTo(w io.Writer) (n int, err error) {\n", s.Name)
+	} else {
+		g.Printf("func (s *%s) WriteTo(w io.Writer) (n int64, err error) {\n", s.Name)
+	}
+	e := encoder.NewWriterOptions(g.types, g.opts)
 	e.Printf("\toffset := 0\n")
 	e.WriteField("s", s.Type)
-	e.Printf("\treturn w.Write(buf)\n")
+	if g.legacy {
+		e.Printf("\treturn w.Write(buf)\n")
+	} else {
+		e.Printf("\tnw, err := w.Write(buf)\n")
+		e.Printf("\treturn int64(nw), err\n")
+	}
 	e.Printf("}\n\n")
+	g.Printf(e.HeaderExpr())
 	g.Printf(e.SizeExpr())
 	g.Printf("\tbuf := make([]byte, size)\n")
 	e.WriteTo(&g.buf)
+	g.addImports(e)
+	g.addHelpers(e)
+	g.addErrors(e)
 }
 
 func (g *Generator) generateRead(s *Struct) {
-	// TODO: decide how to handle errors from r.Read calls.
-	// For performance reasons I'd like to avoid an `if err != nil`
-	// after each call. Perhaps we can generate a ReadFrom and
-	// UnsafeReadFrom which ignores errors?
-	g.Printf("func (s *%s) ReadFrom(r io.Reader) error {\n", s.Name)
-	e := encoder.NewWriter(g.types)
+	g.imports[encoder.RuntimePath] = true
+	if g.legacy {
+		// the legacy signature does not report the bytes read
+		g.Printf("func (s *%s) ReadFrom(r io.Reader) error {\n", s.Name)
+		g.Printf("\t_, err := s.ReadFromOptions(r, binenc.DecodeOptions{})\n")
+		g.Printf("\treturn err\n")
+		g.Printf("}\n\n")
+	} else {
+		g.Printf("func (s *%s) ReadFrom(r io.Reader) (n int64, err error) {\n", s.Name)
+		g.Printf("\treturn s.ReadFromOptions(r, binenc.DecodeOptions{})\n")
+		g.Printf("}\n\n")
+	}
+	g.Printf("func (s *%s) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {\n", s.Name)
+	g.Printf("\treturn s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))\n")
+	g.Printf("}\n\n")
+
+	g.Printf("func (s *%s) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {\n", s.Name)
+	e := encoder.NewWriterOptions(g.types, g.opts)
+	e.Limits()
+	e.FieldErrors(s.Name)
 	e.ReadField("s", s.Type)
 	g.Printf(e.HeaderExpr())
 	e.WriteTo(&g.buf)
+	g.Printf("\treturn n, nil\n")
+	g.Printf("}\n\n")
+	g.addImports(e)
+	g.addHelpers(e)
+	g.addErrors(e)
+}
+
+// generateAppend generates the AppendBinary method, which encodes in place
+// after the contents of dst.
+func (g *Generator) generateAppend(s *Struct) {
+	g.Printf("func (s *%s) AppendBinary(dst []byte) (_ []byte, err error) {\n", s.Name)
+	e := encoder.NewWriterOptions(g.types, g.opts)
+	e.ErrorResult("dst")
+	e.Printf("\tif cap(dst)-len(dst) < size {\n")
+	e.Printf("\t\tdst = append(dst, make([]byte, size)...)[:len(dst)]\n")
+	e.Printf("\t}\n")
+	e.Printf("\tbuf := dst[:len(dst)+size]\n")
+	e.Printf("\toffset := len(dst)\n")
+	e.WriteField("s", s.Type)
+	e.Printf("\treturn buf, nil\n")
+	e.Printf("}\n\n")
+	g.Printf(e.HeaderExpr())
+	g.Printf(e.SizeExpr())
+	e.WriteTo(&g.buf)
+	g.addImports(e)
+	g.addHelpers(e)
+	g.addErrors(e)
+}
+
+// generateSize generates the EncodedSize method from the size pass of the
+// write code, which is otherwise discarded.
+func (g *Generator) generateSize(s *Struct) {
+	e := encoder.NewWriterOptions(g.types, g.opts)
+	e.WriteField("s", s.Type)
+	g.Printf("func (s *%s) EncodedSize() int {\n", s.Name)
+	g.Printf(e.SizeExpr())
+	g.Printf("\treturn size\n")
+	g.Printf("}\n\n")
+	g.addImports(e)
+	g.addHelpers(e)
+	g.addErrors(e)
+}
+
+// generateMarshal generates the encoding.BinaryMarshaler implementation.
+func (g *Generator) generateMarshal(s *Struct) {
+	g.Printf("func (s *%s) MarshalBinary() ([]byte, error) {\n", s.Name)
+	g.Printf("\treturn s.AppendBinary(nil)\n")
+	g.Printf("}\n\n")
+}
+
+// generateUnmarshal generates the encoding.BinaryUnmarshaler implementation,
+// on top of DecodeFrom.
+func (g *Generator) generateUnmarshal(s *Struct) {
+	g.imports["fmt"] = true
+	g.Printf("func (s *%s) UnmarshalBinary(data []byte) error {\n", s.Name)
+	g.Printf("\tn, err := s.DecodeFrom(data)\n")
+	g.Printf("\tif err != nil {\n")
+	g.Printf("\t\treturn err\n")
+	g.Printf("\t}\n")
+	g.Printf("\tif n != len(data) {\n")
+	g.Printf("\t\treturn fmt.Errorf(\"binenc: %%d trailing bytes\", len(data)-n)\n")
+	g.Printf("\t}\n")
 	g.Printf("\treturn nil\n")
 	g.Printf("}\n\n")
-	if e.NeedUnsafe() {
-		g.needUnsafe = true
-	}
+}
+
+// generateDecode generates the DecodeFrom method, which decodes straight
+// from its input.
+func (g *Generator) generateDecode(s *Struct) {
+	g.imports[encoder.RuntimePath] = true
+	g.Printf("func (s *%s) DecodeFrom(data []byte) (offset int, err error) {\n", s.Name)
+	g.Printf("\treturn s.DecodeFromOptions(data, binenc.DecodeOptions{})\n")
+	g.Printf("}\n\n")
+
+	g.Printf("func (s *%s) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {\n", s.Name)
+	e := encoder.NewWriterOptions(g.types, g.opts)
+	e.FromBytes()
+	e.Limits()
+	e.FieldErrors(s.Name)
+	e.ReadField("s", s.Type)
+	// running out of the allowed input is a limit error, see
+	// binenc.DecodeOptions.ShortInput
+	g.Printf("\tif opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {\n")
+	g.Printf("\t\tdata = data[:opts.MaxBytes]\n")
+	g.Printf("\t}\n")
+	g.Printf(e.HeaderExpr())
+	e.WriteTo(&g.buf)
+	g.Printf("\treturn offset, nil\n")
+	g.Printf("}\n\n")
+	g.addImports(e)
+	g.addHelpers(e)
+	g.addErrors(e)
+}
+
+// generateReset generates the Reset method of the -reuse flag, which clears
+// a value but keeps the memory reused by decoding.
+func (g *Generator) generateReset(s *Struct) {
+	e := encoder.NewWriterOptions(g.types, g.opts)
+	e.ResetField("s", s.Type)
+	g.Printf("func (s *%s) Reset() {\n", s.Name)
+	e.WriteTo(&g.buf)
+	g.Printf("}\n\n")
+	g.addImports(e)
+	g.addErrors(e)
 }
 
 func (f *File) inspectNode(node ast.Node) bool {
@@ -348,13 +984,52 @@ func (f *File) inspectNode(node ast.Node) bool {
 		if tspec.Type == nil {
 			continue
 		}
+		obj := f.pkg.typeInfo.Defs[tspec.Name].(*types.TypeName)
+		doc := tspec.Doc
+		if doc == nil && len(decl.Specs) == 1 {
+			doc = decl.Doc
+		}
+		f.parseDirectives(obj, doc)
 		st, ok := tspec.Type.(*ast.StructType)
 		if !ok || st.Fields == nil || st.Fields.List == nil {
 			log.Printf("not struct type or missing field list")
 			continue
 		}
-		t := f.pkg.typeInfo.TypeOf(st)
-		f.structs = append(f.structs, &Struct{tspec.Name.Name, t})
+		f.structs = append(f.structs, &Struct{tspec.Name.Name, obj.Type()})
 	}
 	return false
 }
+
+// parseDirectives records the //binenc: directives in the doc comment of
+// the type obj, such as
+//
+//	//binenc:endian=big
+func (f *File) parseDirectives(obj *types.TypeName, doc *ast.CommentGroup) {
+	if doc == nil {
+		return
+	}
+	for _, c := range doc.List {
+		if !strings.HasPrefix(c.Text, "//binenc:") {
+			continue
+		}
+		key, value, _ := strings.Cut(strings.TrimPrefix(c.Text, "//binenc:"), "=")
+		switch key {
+		case "endian":
+			e, err := encoder.ParseEndian(value)
+			if err != nil {
+				f.directiveError(c, obj, err.Error())
+				continue
+			}
+			f.pkg.endian[obj] = e
+		default:
+			f.directiveError(c, obj, "unknown directive "+c.Text)
+		}
+	}
+}
+
+// directiveError reports the error msg of the directive c of the type obj
+// with its position. The generation fails once all of them are reported.
+func (f *File) directiveError(c *ast.Comment, obj *types.TypeName, msg string) {
+	log.Printf("%s: type %s: %s", f.pkg.fset.Position(c.Pos()), obj.Name(), msg)
+	f.pkg.directiveErrors++
+}
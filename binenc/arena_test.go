@@ -0,0 +1,55 @@
+package binenc
+
+import (
+	"testing"
+	"unsafe"
+)
+
+// follows reports whether the memory of b starts where that of a ends.
+func follows(b, a []byte) bool {
+	return uintptr(unsafe.Pointer(&b[0])) == uintptr(unsafe.Pointer(&a[0]))+uintptr(len(a))
+}
+
+func TestDecoder_ArenaChunks(t *testing.T) {
+	d := NewDecoder(nil)
+	first := d.Arena(60)
+	// appending to the memory of a string copies it
+	if cap(first) != 60 {
+		t.Errorf("cap(Arena(60)) = %d, want 60", cap(first))
+	}
+	// a string past the end of the chunk starts a new one, twice as large
+	second := d.Arena(8)
+	if follows(second, first) {
+		t.Errorf("Arena(8) continues a full chunk")
+	}
+	if d.chunk != 128 {
+		t.Errorf("chunk = %d, want 128", d.chunk)
+	}
+	// a string larger than the largest chunk is allocated on its own
+	large := d.Arena(maxChunk + 1)
+	if len(large) != maxChunk+1 {
+		t.Errorf("len(Arena(%d)) = %d", maxChunk+1, len(large))
+	}
+	if next := d.Arena(8); !follows(next, second) {
+		t.Errorf("Arena(8) after a large string does not continue the chunk")
+	}
+	for d.chunk < maxChunk {
+		d.Arena(d.chunk)
+	}
+	d.Arena(maxChunk)
+	if d.chunk != maxChunk {
+		t.Errorf("chunk = %d, want at most %d", d.chunk, maxChunk)
+	}
+}
+
+func TestDecoder_Scratch(t *testing.T) {
+	d := NewDecoder(nil)
+	a := d.Scratch(16)
+	b := d.Scratch(4)
+	if len(b) != 4 || &a[0] != &b[0] {
+		t.Errorf("Scratch(4) after Scratch(16) does not reuse its memory")
+	}
+	if c := d.Scratch(32); len(c) != 32 {
+		t.Errorf("len(Scratch(32)) = %d, want 32", len(c))
+	}
+}
@@ -0,0 +1,154 @@
+// Package binenc holds the types shared by the code generated by
+// go-binenc-gen, which imports it.
+package binenc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DecodeOptions limits the resources used to decode untrusted input, in
+// the generated ReadFromOptions and DecodeFromOptions methods. A zero limit
+// is no limit.
+type DecodeOptions struct {
+	// MaxSliceLen is the maximum number of elements of a slice or map,
+	// including []byte values.
+	MaxSliceLen int
+	// MaxStringLen is the maximum number of bytes of a string.
+	MaxStringLen int
+	// MaxBytes is the maximum number of bytes consumed by a call.
+	MaxBytes int64
+	// MaxDepth is the maximum nesting depth of the values of recursive
+	// types, whose values nested in themselves are at depth 1.
+	MaxDepth int
+}
+
+// MaxAlloc is the maximum number of bytes of memory allocated for a slice
+// or string read from an io.Reader, which can't tell in advance whether it
+// holds the input of the length read.
+const MaxAlloc = 1 << 30
+
+// maxMapHint is the largest room made for the entries of a map read from an
+// io.Reader. A map takes several times the memory of its keys and values,
+// so past it the map grows with the entries actually read.
+const maxMapHint = 1 << 10
+
+// MapHint returns the size hint of a map of size entries read from an
+// io.Reader.
+func MapHint(size int) int {
+	if size > maxMapHint {
+		return maxMapHint
+	}
+	return size
+}
+
+// ErrTooLarge reports a length read from an io.Reader whose value would
+// take more than MaxAlloc bytes of memory, such as a corrupt length.
+var ErrTooLarge = errors.New("binenc: length too large to allocate")
+
+// LimitError reports input exceeding a limit of DecodeOptions. It is
+// returned before allocating for the offending value.
+type LimitError struct {
+	// Limit is the name of the DecodeOptions field, such as "MaxSliceLen".
+	Limit string
+	// Max is the value of the limit.
+	Max int64
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("binenc: input exceeds %s of %d", e.Limit, e.Max)
+}
+
+// ShortInput returns the error of decoding from data of n bytes that ends
+// before the value does: a *LimitError when n reaches MaxBytes, at which the
+// generated DecodeFromOptions cuts data, or io.ErrUnexpectedEOF.
+func (o DecodeOptions) ShortInput(n int) error {
+	if o.MaxBytes > 0 && int64(n) >= o.MaxBytes {
+		return &LimitError{Limit: "MaxBytes", Max: o.MaxBytes}
+	}
+	return io.ErrUnexpectedEOF
+}
+
+// LimitReader returns a Reader reading from r, which returns a *LimitError
+// for MaxBytes of max once max bytes were read, rather than reading more.
+func LimitReader(r io.Reader, max int64) io.Reader {
+	return &limitReader{r: r, left: max, max: max}
+}
+
+type limitReader struct {
+	r    io.Reader
+	left int64
+	max  int64
+}
+
+func (l *limitReader) Read(p []byte) (int, error) {
+	if l.left <= 0 {
+		return 0, &LimitError{Limit: "MaxBytes", Max: l.max}
+	}
+	if int64(len(p)) > l.left {
+		p = p[:l.left]
+	}
+	n, err := l.r.Read(p)
+	l.left -= int64(n)
+	return n, err
+}
+
+// DecodeError reports the failure to decode a value, at the field of it
+// given by a path of field names and indexes.
+type DecodeError struct {
+	// Type is the name of the type of the value decoded.
+	Type string
+	// Field is the path of the field within the value, such as
+	// "Inners[3].Arr4[1]", or empty for the value itself.
+	Field string
+	// Offset is the position in the input at which decoding the field
+	// failed.
+	Offset int64
+	// Err is the underlying error, such as io.ErrUnexpectedEOF or a
+	// *LimitError.
+	Err error
+}
+
+// Path returns the path of the field with the name of the type, such as
+// "Outer.Inners[3].Arr4[1]".
+func (e *DecodeError) Path() string {
+	if e.Field == "" || strings.HasPrefix(e.Field, "[") {
+		return e.Type + e.Field
+	}
+	return e.Type + "." + e.Field
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("binenc: decoding %s at offset %d: %v", e.Path(), e.Offset, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// FieldError returns err as a *DecodeError of the field of the value of type
+// typ whose path is format formatted with args, as by fmt.Sprintf, decoding
+// at offset. A *DecodeError err of a value nested in the field is extended
+// with its path, and an io.EOF is unexpected but at offset 0, where it is
+// returned as is. It is called by the generated code on failure.
+func FieldError(err error, typ string, offset int64, format string, args ...interface{}) error {
+	field := format
+	if len(args) > 0 {
+		field = fmt.Sprintf(format, args...)
+	}
+	if e, ok := err.(*DecodeError); ok {
+		if field != "" && e.Field != "" && !strings.HasPrefix(e.Field, "[") {
+			field += "."
+		}
+		return &DecodeError{Type: typ, Field: field + e.Field, Offset: e.Offset, Err: e.Err}
+	}
+	if err == io.EOF {
+		if offset == 0 {
+			return err
+		}
+		err = io.ErrUnexpectedEOF
+	}
+	return &DecodeError{Type: typ, Field: field, Offset: offset, Err: err}
+}
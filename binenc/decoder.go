@@ -0,0 +1,109 @@
+package binenc
+
+import "io"
+
+// maxChunk is the size past which the string arena of a Decoder stops
+// growing its chunks, so that a string kept from a long stream does not
+// retain much more memory than its own.
+const maxChunk = 32 << 10
+
+// Decodable is implemented by the types generated by go-binenc-gen.
+type Decodable interface {
+	// DecodeBinenc decodes the next value from d, returning the number
+	// of bytes consumed.
+	DecodeBinenc(d *Decoder) (n int64, err error)
+}
+
+// A Decoder decodes a stream of values from an io.Reader. It owns the
+// scratch memory of the generated code and the arena of the decoded strings,
+// which are reused across calls to Decode, so that decoding a stream of
+// values allocates for the values only.
+//
+// The strings decoded share the chunks of the arena, which are kept alive as
+// long as one of their strings is.
+type Decoder struct {
+	r    io.Reader
+	opts DecodeOptions
+	// limited is r limited to opts.MaxBytes per value
+	limited limitReader
+	buf     [8]byte
+	scratch []byte
+	// arena is the unused part of the current chunk of the arena
+	arena []byte
+	chunk int
+}
+
+// NewDecoder returns a Decoder reading from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return NewDecoderOptions(r, DecodeOptions{})
+}
+
+// NewDecoderOptions returns a Decoder reading from r, which decodes each
+// value within the limits of opts.
+func NewDecoderOptions(r io.Reader, opts DecodeOptions) *Decoder {
+	d := &Decoder{r: r, opts: opts}
+	d.limited = limitReader{r: r, left: opts.MaxBytes, max: opts.MaxBytes}
+	return d
+}
+
+// Decode decodes the next value from the input into v. It returns io.EOF
+// when the input ends before the value, as the generated ReadFrom does.
+func (d *Decoder) Decode(v Decodable) error {
+	d.limited.left = d.opts.MaxBytes
+	_, err := v.DecodeBinenc(d)
+	return err
+}
+
+// The methods below are called by the generated code.
+
+// Reader returns the input of the value being decoded.
+func (d *Decoder) Reader() io.Reader {
+	if d.opts.MaxBytes > 0 {
+		return &d.limited
+	}
+	return d.r
+}
+
+// Options returns the limits of the value being decoded.
+func (d *Decoder) Options() DecodeOptions {
+	return d.opts
+}
+
+// Buffer returns the scratch buffer of the fixed size values, of 8 bytes.
+func (d *Decoder) Buffer() []byte {
+	return d.buf[:]
+}
+
+// Scratch returns a scratch buffer of size bytes, which the next call
+// overwrites.
+func (d *Decoder) Scratch(size int) []byte {
+	if cap(d.scratch) < size {
+		d.scratch = make([]byte, size)
+	}
+	return d.scratch[:size]
+}
+
+// Arena returns size bytes of memory for the contents of a string, which is
+// never reused. It is carved out of chunks growing from 64 bytes up to 32KB,
+// or allocated on its own past that.
+func (d *Decoder) Arena(size int) []byte {
+	if len(d.arena) < size {
+		if size > maxChunk {
+			return make([]byte, size)
+		}
+		d.chunk = 2 * d.chunk
+		if d.chunk < 64 {
+			d.chunk = 64
+		}
+		if d.chunk > maxChunk {
+			d.chunk = maxChunk
+		}
+		if d.chunk < size {
+			d.chunk = size
+		}
+		d.arena = make([]byte, d.chunk)
+	}
+	b := d.arena[:size:size]
+	d.arena = d.arena[size:]
+	return b
+}
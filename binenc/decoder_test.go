@@ -0,0 +1,132 @@
+package binenc_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+	"github.com/cezarguimaraes/go-binenc-gen/binenc/internal/fixture"
+)
+
+// messages returns the encoding of the messages of the given texts, with
+// IDs counting from 1.
+func messages(texts ...string) []byte {
+	var data []byte
+	for i, text := range texts {
+		data = append(data, byte(i+1), 0, byte(len(text)))
+		data = append(data, text...)
+	}
+	return data
+}
+
+// stringData returns the address of the memory of s.
+func stringData(s string) uintptr {
+	return uintptr(*(*unsafe.Pointer)(unsafe.Pointer(&s)))
+}
+
+func TestDecoder_Arena(t *testing.T) {
+	texts := []string{"ab", "cde", "f"}
+	d := binenc.NewDecoder(bytes.NewReader(messages(texts...)))
+	values := make([]fixture.Message, len(texts))
+	for i := range values {
+		if err := d.Decode(&values[i]); err != nil {
+			t.Fatalf("Decode #%d: %v", i, err)
+		}
+	}
+	if err := d.Decode(new(fixture.Message)); err != io.EOF {
+		t.Fatalf("Decode at the end = %v, want io.EOF", err)
+	}
+	for i, v := range values {
+		if want := (fixture.Message{ID: uint16(i + 1), Text: texts[i]}); v != want {
+			t.Errorf("values[%d] = %+v, want %+v", i, v, want)
+		}
+		// the strings of successive values share the chunk of the arena
+		if i > 0 && stringData(v.Text) != stringData(values[i-1].Text)+uintptr(len(values[i-1].Text)) {
+			t.Errorf("values[%d].Text does not follow values[%d].Text in the arena", i, i-1)
+		}
+	}
+}
+
+func TestDecoder_MaxBytes(t *testing.T) {
+	// MaxBytes limits each value, rather than the whole stream
+	data := messages("abc", "def", "ghi", "abcdef")
+	d := binenc.NewDecoderOptions(bytes.NewReader(data), binenc.DecodeOptions{MaxBytes: 6})
+	for i := 0; i < 3; i++ {
+		var v fixture.Message
+		if err := d.Decode(&v); err != nil {
+			t.Fatalf("Decode #%d: %v", i, err)
+		}
+	}
+	err := d.Decode(new(fixture.Message))
+	var limitErr *binenc.LimitError
+	if !errors.As(err, &limitErr) || limitErr.Limit != "MaxBytes" || limitErr.Max != 6 {
+		t.Fatalf("Decode of 9 bytes = %v, want a MaxBytes LimitError", err)
+	}
+	var decodeErr *binenc.DecodeError
+	if !errors.As(err, &decodeErr) || decodeErr.Field != "Text" || decodeErr.Offset != 3 {
+		t.Errorf("Decode of 9 bytes = %v, want a DecodeError at Text, offset 3", err)
+	}
+}
+
+func TestDecoder_Options(t *testing.T) {
+	opts := binenc.DecodeOptions{MaxStringLen: 2}
+	d := binenc.NewDecoderOptions(bytes.NewReader(messages("ab", "abc")), opts)
+	if got := d.Options(); got != opts {
+		t.Errorf("Options() = %+v, want %+v", got, opts)
+	}
+	if err := d.Decode(new(fixture.Message)); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	var limitErr *binenc.LimitError
+	if err := d.Decode(new(fixture.Message)); !errors.As(err, &limitErr) || limitErr.Limit != "MaxStringLen" {
+		t.Errorf("Decode of a 3 byte string = %v, want a MaxStringLen LimitError", err)
+	}
+}
+
+func TestDecoder_Errors(t *testing.T) {
+	errBroken := errors.New("broken")
+	for _, c := range []struct {
+		name string
+		r    io.Reader
+		want error
+	}{
+		{"empty", bytes.NewReader(nil), io.EOF},
+		{"truncated", bytes.NewReader(messages("abc")[:4]), io.ErrUnexpectedEOF},
+		{"reader", iotest.ErrReader(errBroken), errBroken},
+		{"reader after length", io.MultiReader(bytes.NewReader([]byte{1, 0, 3}), iotest.ErrReader(errBroken)), errBroken},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			err := binenc.NewDecoder(c.r).Decode(new(fixture.Message))
+			if !errors.Is(err, c.want) {
+				t.Errorf("Decode = %v, want %v", err, c.want)
+			}
+			// only a clean end of input is not a DecodeError
+			var decodeErr *binenc.DecodeError
+			if got, want := errors.As(err, &decodeErr), c.want != io.EOF; got != want {
+				t.Errorf("Decode = %v, a DecodeError: %v, want %v", err, got, want)
+			}
+		})
+	}
+}
+
+func TestLimitReader(t *testing.T) {
+	r := binenc.LimitReader(bytes.NewReader([]byte("abcdef")), 4)
+	buf := make([]byte, 8)
+	if n, err := r.Read(buf); n != 4 || err != nil {
+		t.Fatalf("Read = %d, %v; want 4, nil", n, err)
+	}
+	n, err := r.Read(buf)
+	var limitErr *binenc.LimitError
+	if n != 0 || !errors.As(err, &limitErr) || limitErr.Limit != "MaxBytes" || limitErr.Max != 4 {
+		t.Fatalf("Read past the limit = %d, %v; want 0, a MaxBytes LimitError", n, err)
+	}
+	// the input ending first is reported as is
+	r = binenc.LimitReader(bytes.NewReader([]byte("ab")), 4)
+	if _, err := io.ReadAll(r); err != nil {
+		t.Errorf("ReadAll of a short input: %v", err)
+	}
+}
@@ -0,0 +1,123 @@
+// Package frame implements a stream of values of the types generated by
+// go-binenc-gen, such as over a network connection or a pipe. Each value is
+// written as a frame: its length, as a uvarint of encoding/binary, followed
+// by its encoding, so that a reader knows where each value ends.
+package frame
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+// Appender is implemented by the types generated by go-binenc-gen.
+type Appender interface {
+	AppendBinary(dst []byte) ([]byte, error)
+}
+
+// An Encoder writes values as frames to a buffered writer, which batches
+// them into fewer writes. The frames buffered are written out by Flush, or
+// once the buffer fills.
+type Encoder struct {
+	w *bufio.Writer
+	// buf holds the encoding of the value being written
+	buf []byte
+}
+
+// NewEncoder returns an Encoder writing to w, through a bufio.Writer unless
+// w is one.
+func NewEncoder(w io.Writer) *Encoder {
+	bw, ok := w.(*bufio.Writer)
+	if !ok {
+		bw = bufio.NewWriter(w)
+	}
+	return &Encoder{w: bw}
+}
+
+// Encode writes v as the next frame.
+func (e *Encoder) Encode(v Appender) error {
+	var err error
+	e.buf, err = v.AppendBinary(e.buf[:0])
+	if err != nil {
+		return err
+	}
+	var prefix [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(prefix[:], uint64(len(e.buf)))
+	if _, err := e.w.Write(prefix[:n]); err != nil {
+		return err
+	}
+	_, err = e.w.Write(e.buf)
+	return err
+}
+
+// Flush writes out the frames buffered.
+func (e *Encoder) Flush() error {
+	return e.w.Flush()
+}
+
+// A Decoder reads values from frames, one at a time. It decodes them through
+// a binenc.Decoder, whose scratch memory and string arena it keeps.
+type Decoder struct {
+	r *bufio.Reader
+	// frame is the rest of the frame being decoded
+	frame io.LimitedReader
+	d     *binenc.Decoder
+	opts  binenc.DecodeOptions
+}
+
+// NewDecoder returns a Decoder reading from r, through a bufio.Reader unless
+// r is one.
+func NewDecoder(r io.Reader) *Decoder {
+	return NewDecoderOptions(r, binenc.DecodeOptions{})
+}
+
+// NewDecoderOptions returns a Decoder reading from r, which decodes each
+// value within the limits of opts. opts.MaxBytes is the maximum size of a
+// frame.
+func NewDecoderOptions(r io.Reader, opts binenc.DecodeOptions) *Decoder {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	d := &Decoder{r: br, opts: opts}
+	d.frame.R = br
+	d.d = binenc.NewDecoderOptions(&d.frame, opts)
+	return d
+}
+
+// Decode reads the next frame into v. It returns io.EOF when the input ends
+// before the frame, and a *binenc.LimitError for MaxBytes when the frame is
+// larger than allowed, after which the input is not read any further. When
+// decoding the value fails, or it leaves bytes of the frame over, the rest
+// of the frame is skipped, so that the next call decodes the next frame.
+func (d *Decoder) Decode(v binenc.Decodable) error {
+	size, err := binary.ReadUvarint(d.r)
+	if err != nil {
+		return err
+	}
+	if d.opts.MaxBytes > 0 && size > uint64(d.opts.MaxBytes) {
+		return &binenc.LimitError{Limit: "MaxBytes", Max: d.opts.MaxBytes}
+	}
+	if size > math.MaxInt64 {
+		return fmt.Errorf("binenc: frame size %d overflows int64", size)
+	}
+	d.frame.N = int64(size)
+	err = d.d.Decode(v)
+	if err == io.EOF {
+		// the value is missing, rather than the frame
+		err = io.ErrUnexpectedEOF
+	}
+	if err == nil && d.frame.N > 0 {
+		err = fmt.Errorf("binenc: %d trailing bytes in frame", d.frame.N)
+	}
+	if err != nil && d.frame.N > 0 {
+		if _, skipErr := io.Copy(io.Discard, &d.frame); skipErr != nil {
+			return skipErr
+		}
+	}
+	return err
+}
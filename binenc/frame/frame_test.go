@@ -0,0 +1,113 @@
+package frame
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+	"github.com/cezarguimaraes/go-binenc-gen/binenc/internal/fixture"
+)
+
+// frame returns a frame holding payload.
+func frame(payload ...byte) []byte {
+	return append(binary.AppendUvarint(nil, uint64(len(payload))), payload...)
+}
+
+func TestRoundTrip(t *testing.T) {
+	messages := []fixture.Message{{ID: 1, Text: "hello"}, {ID: 2}, {ID: 65535, Text: string(make([]byte, 200))}}
+	var buf bytes.Buffer
+	e := NewEncoder(&buf)
+	for i := range messages {
+		if err := e.Encode(&messages[i]); err != nil {
+			t.Fatalf("Encode #%d: %v", i, err)
+		}
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Encode wrote %d bytes before Flush", buf.Len())
+	}
+	if err := e.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	// the length of a frame takes two bytes past 127
+	if want := 1 + 8 + 1 + 3 + 2 + 203; buf.Len() != want {
+		t.Errorf("encoded %d bytes, want %d", buf.Len(), want)
+	}
+
+	d := NewDecoder(&buf)
+	for i, want := range messages {
+		var got fixture.Message
+		if err := d.Decode(&got); err != nil {
+			t.Fatalf("Decode #%d: %v", i, err)
+		}
+		if got != want {
+			t.Errorf("Decode #%d = %+v, want %+v", i, got, want)
+		}
+	}
+	if err := d.Decode(new(fixture.Message)); err != io.EOF {
+		t.Errorf("Decode at the end = %v, want io.EOF", err)
+	}
+}
+
+func TestDecode_TooLarge(t *testing.T) {
+	data := frame(1, 0, 5, 'h', 'e', 'l', 'l', 'o')
+	d := NewDecoderOptions(bytes.NewReader(data), binenc.DecodeOptions{MaxBytes: 7})
+	err := d.Decode(new(fixture.Message))
+	var limitErr *binenc.LimitError
+	if !errors.As(err, &limitErr) || limitErr.Limit != "MaxBytes" || limitErr.Max != 7 {
+		t.Errorf("Decode of an 8 byte frame = %v, want a MaxBytes LimitError", err)
+	}
+
+	// a size that overflows int64 is reported rather than trusted
+	data = binary.AppendUvarint(nil, 1<<63)
+	if err := NewDecoder(bytes.NewReader(data)).Decode(new(fixture.Message)); err == nil {
+		t.Errorf("Decode of a frame of 1<<63 bytes succeeded")
+	}
+}
+
+func TestDecode_Truncated(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		data []byte
+	}{
+		{"header", []byte{0x80}},
+		{"empty body", []byte{8}},
+		{"body", frame(1, 0, 5, 'h', 'e', 'l', 'l', 'o')[:5]},
+		// the frame ends before the value
+		{"frame", frame(1, 0, 5, 'h', 'e')},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			err := NewDecoder(bytes.NewReader(c.data)).Decode(new(fixture.Message))
+			if !errors.Is(err, io.ErrUnexpectedEOF) {
+				t.Errorf("Decode = %v, want io.ErrUnexpectedEOF", err)
+			}
+		})
+	}
+}
+
+func TestDecode_Skip(t *testing.T) {
+	var data []byte
+	// the text is longer than MaxStringLen
+	data = append(data, frame(1, 0, 5, 'h', 'e', 'l', 'l', 'o')...)
+	// the frame holds a byte past the value
+	data = append(data, frame(2, 0, 1, 'a', 'b')...)
+	data = append(data, frame(3, 0, 2, 'o', 'k')...)
+
+	d := NewDecoderOptions(bytes.NewReader(data), binenc.DecodeOptions{MaxStringLen: 4})
+	var limitErr *binenc.LimitError
+	if err := d.Decode(new(fixture.Message)); !errors.As(err, &limitErr) || limitErr.Limit != "MaxStringLen" {
+		t.Fatalf("Decode of a long text = %v, want a MaxStringLen LimitError", err)
+	}
+	if err := d.Decode(new(fixture.Message)); err == nil {
+		t.Fatalf("Decode of a frame with trailing bytes succeeded")
+	}
+	var got fixture.Message
+	if err := d.Decode(&got); err != nil {
+		t.Fatalf("Decode after the failed frames: %v", err)
+	}
+	if want := (fixture.Message{ID: 3, Text: "ok"}); got != want {
+		t.Errorf("Decode after the failed frames = %+v, want %+v", got, want)
+	}
+}
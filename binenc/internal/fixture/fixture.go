@@ -0,0 +1,12 @@
+// Package fixture holds a type encoded by go-binenc-gen, shared by the tests
+// of the binenc packages.
+package fixture
+
+//go:generate go-binenc-gen fixture.go
+
+// Message is encoded as a 2-byte ID followed by Text, after its 1-byte
+// length.
+type Message struct {
+	ID   uint16
+	Text string `binenc:"len=1"`
+}
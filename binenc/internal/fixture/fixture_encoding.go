@@ -0,0 +1,162 @@
+// Code generated by "gobinenc fixture.go"; DO NOT EDIT.
+
+package fixture
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Message) WriteTo(w io.Writer) (n int64, err error) {
+	size := 3
+	size += len(s.Text)
+	buf := make([]byte, size)
+	offset := 0
+	buf[offset] = byte(s.ID)
+	buf[offset+1] = byte(s.ID >> 8)
+	offset += 2
+	if uint64(len(s.Text)) > math.MaxUint8 {
+		return 0, fmt.Errorf("binenc: length %d overflows 1-byte prefix", len(s.Text))
+	}
+	buf[offset] = byte(len(s.Text))
+	offset += 1
+	copy(buf[offset:], s.Text)
+	offset += len(s.Text)
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Message) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Message) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Message) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "ID")
+	}
+	n += 2
+	s.ID = uint16(buf[0]) | (uint16(buf[1]) << 8)
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Text")
+	}
+	n += 1
+	size = int(uint8(buf[0]))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Message", n, "Text")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Message", n, "Text")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Message", n, "Text")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Text")
+	}
+	n += int64(size)
+	s.Text = *(*string)(unsafe.Pointer(&tmp))
+	return n, nil
+}
+
+func (s *Message) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 3
+	size += len(s.Text)
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(s.ID)
+	buf[offset+1] = byte(s.ID >> 8)
+	offset += 2
+	if uint64(len(s.Text)) > math.MaxUint8 {
+		return dst, fmt.Errorf("binenc: length %d overflows 1-byte prefix", len(s.Text))
+	}
+	buf[offset] = byte(len(s.Text))
+	offset += 1
+	copy(buf[offset:], s.Text)
+	offset += len(s.Text)
+	return buf, nil
+}
+
+func (s *Message) EncodedSize() int {
+	size := 3
+	size += len(s.Text)
+	return size
+}
+
+func (s *Message) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Message) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Message) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 3 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "ID")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	s.ID = uint16(buf[0]) | (uint16(buf[1]) << 8)
+	buf = data[offset : offset+1]
+	offset += 1
+	size = int(uint8(buf[0]))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Message", int64(offset), "Text")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Text")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Text")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Text = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	return offset, nil
+}
+
+func (s *Message) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+//go:build 386 || amd64 || amd64p32 || alpha || arm || arm64 || loong64 || mipsle || mips64le || mips64p32le || nios2 || ppc64le || riscv || riscv64 || sh || wasm
+
+package binenc
+
+// LittleEndian reports whether the host stores numbers in little endian
+// order, in which case the generated code copies the memory of slices and
+// arrays of numbers at once for the little endian wire order. Being a
+// constant, the compiler drops the branch not taken on the host.
+const LittleEndian = true
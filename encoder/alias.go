@@ -0,0 +1,31 @@
+package encoder
+
+// With Options.ZeroCopy, decoding from data makes string and []byte values
+// point into data rather than into memory of their own. Strings go through
+// an unsafe conversion of the aliased bytes, which must then never change.
+
+// aliasBytes reads a []byte of size bytes into name, sharing the memory of
+// data. Its capacity is capped so that appending to it never clobbers data.
+func (w *Writer) aliasBytes(name string) {
+	w.endSegment()
+	w.checkBounds("size")
+	w.Printf("\t%s = data[offset : offset+size : offset+size]\n", name)
+	w.Printf("\toffset += size\n")
+}
+
+// aliasString reads a string of size bytes into name, sharing the memory
+// of data.
+func (w *Writer) aliasString(name string) {
+	w.imports["unsafe"] = true
+	w.usedTmp = true
+	w.endSegment()
+	w.checkBounds("size")
+	w.Printf("\ttmp = data[offset : offset+size]\n")
+	w.Printf("\t%s = *(*string)(unsafe.Pointer(&tmp))\n", name)
+	w.Printf("\toffset += size\n")
+}
+
+// zeroCopy reports whether strings and []byte values are aliased into data.
+func (w *Writer) zeroCopy() bool {
+	return w.fromBytes && w.opts.ZeroCopy
+}
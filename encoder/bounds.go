@@ -0,0 +1,86 @@
+package encoder
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Decoding from data checks its bounds once per segment, a run of fixed
+// size reads within a single block of the generated code, rather than once
+// per read. The size of a segment is only known once it ends, so its check
+// is printed with a marker, replaced by the size in Bytes and WriteTo.
+
+// segmentFmt is the marker of the size of a segment, by index.
+const segmentFmt = "\x00%d\x00"
+
+var segmentMarker = regexp.MustCompile("\x00([0-9]+)\x00")
+
+// segment is a run of fixed size reads from data.
+type segment struct {
+	// block is the id of the block of the generated code holding the reads
+	block int
+	size  int
+}
+
+// trackBlocks records the blocks opened and closed by the generated code s,
+// which starts each block on a line ending with { and ends it on a line
+// starting with }.
+func (w *Writer) trackBlocks(s string) {
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "}") && len(w.blocks) > 1 {
+			w.blocks = w.blocks[:len(w.blocks)-1]
+		}
+		if strings.HasSuffix(line, "{") {
+			w.nextBlock++
+			w.blocks = append(w.blocks, w.nextBlock)
+		}
+	}
+}
+
+// readSegment reslices the next nbytes bytes of data into buf, extending
+// the current segment when it is in the same block, or starting a new one.
+func (w *Writer) readSegment(nbytes int) {
+	block := w.blocks[len(w.blocks)-1]
+	if w.segment == nil || w.segment.block != block {
+		w.segment = &segment{block: block}
+		w.segments = append(w.segments, w.segment)
+		w.checkBounds(fmt.Sprintf(segmentFmt, len(w.segments)-1))
+	}
+	w.segment.size += nbytes
+	w.Printf("\tbuf = data[offset : offset+%d]\n", nbytes)
+	w.Printf("\toffset += %d\n", nbytes)
+}
+
+// checkBounds returns an error when data holds less than size bytes past
+// offset. With limits, data may have been cut at opts.MaxBytes.
+func (w *Writer) checkBounds(size string) {
+	err := "io.ErrUnexpectedEOF"
+	if w.limits {
+		err = "opts.ShortInput(len(data))"
+	}
+	w.Printf("\tif len(data)-offset < %s {\n", size)
+	w.readErr(err)
+	w.Printf("\t}\n")
+}
+
+// endSegment ends the current segment, before offset is advanced by a
+// variable amount.
+func (w *Writer) endSegment() {
+	w.segment = nil
+}
+
+// resolveSegments returns the generated code with the size of each segment
+// in place of its marker.
+func (w *Writer) resolveSegments() []byte {
+	if len(w.segments) == 0 {
+		return w.buf.Bytes()
+	}
+	return segmentMarker.ReplaceAllFunc(w.buf.Bytes(), func(m []byte) []byte {
+		i, _ := strconv.Atoi(string(bytes.Trim(m, "\x00")))
+		return []byte(strconv.Itoa(w.segments[i].size))
+	})
+}
@@ -0,0 +1,149 @@
+package encoder
+
+import (
+	"fmt"
+	"go/types"
+)
+
+// Slices and arrays of fixed width numbers are copied at once. Bytes are
+// copied as is, while the memory of wider numbers is only copied on little
+// endian hosts, as told by binenc.LittleEndian, and for little endian wire
+// order, as it then matches the encoding of the elements. Other hosts fall
+// back to an element loop.
+
+// bulkSize returns the size of the elements of type elem when slices and
+// arrays of them may be copied at once, or 0.
+func (w *Writer) bulkSize(elem types.Type) int {
+	b, ok := elem.Underlying().(*types.Basic)
+	if !ok || b.Info()&(types.IsInteger|types.IsFloat|types.IsComplex) == 0 || isWordSized(b) {
+		return 0
+	}
+	if w.opts.Safe && !isByte(elem) {
+		// other elements are only copied through unsafe
+		return 0
+	}
+	size := int(w.stdSizes.Sizeof(b))
+	if b.Info()&types.IsInteger != 0 && ((w.width != 0 && w.width != size) || w.isVarint(b)) {
+		return 0
+	}
+	if size > 1 && w.elemBigEndian(elem) {
+		return 0
+	}
+	return size
+}
+
+// elemBigEndian reports whether the numbers of type elem are big endian
+// on the wire, as set by enterNamed for named types.
+func (w *Writer) elemBigEndian(elem types.Type) bool {
+	named, ok := elem.(*types.Named)
+	if !ok {
+		return w.bigEndian
+	}
+	if w.fieldEndian != nil {
+		return *w.fieldEndian == BigEndian
+	}
+	return w.endianOf(named) == BigEndian
+}
+
+// isByte reports whether t is byte, which needs no conversion to be copied.
+func isByte(t types.Type) bool {
+	return types.Identical(t, types.Typ[types.Byte])
+}
+
+// rawBytes returns an expression of the n bytes of memory starting at the
+// first element of the slice or array name.
+func (w *Writer) rawBytes(name, n string) string {
+	w.imports["unsafe"] = true
+	return fmt.Sprintf("unsafe.Slice((*byte)(unsafe.Pointer(&%s[0])), %s)", name, n)
+}
+
+// writeBulkSlice writes the elements of the slice name, whose elements of
+// type elem take size bytes each.
+func (w *Writer) writeBulkSlice(name string, elem types.Type, size int) {
+	l := length(name)
+	if isByte(elem) {
+		w.Printf(copyFmt, name)
+		w.addDynamicOffset(l)
+		return
+	}
+	if size == 1 {
+		w.Printf("\tif %s > 0 {\n", l)
+		w.Printf(copyFmt, w.rawBytes(name, l))
+		w.Printf("\t}\n")
+		w.addDynamicOffset(l)
+		return
+	}
+	n := fmt.Sprintf("%d*%s", size, l)
+	w.imports[RuntimePath] = true
+	w.Printf("\tif binenc.LittleEndian && %s > 0 {\n", l)
+	w.Printf(copyFmt, w.rawBytes(name, n))
+	w.Printf(dynOffsetFmt, n)
+	w.Printf("\t} else {\n")
+	w.writeSliceLoop(name, elem)
+	w.Printf("\t}\n")
+}
+
+// writeBulkArray writes the elements of the array name, of type arr, whose
+// elements take size bytes each.
+func (w *Writer) writeBulkArray(name string, arr *types.Array, size int) {
+	if isByte(arr.Elem()) {
+		w.Printf(copyFmt, name+"[:]")
+		w.addOffset(int(arr.Len()))
+		return
+	}
+	n := int(arr.Len()) * size
+	if size == 1 {
+		w.Printf(copyFmt, w.rawBytes(name, fmt.Sprint(n)))
+		w.addOffset(n)
+		return
+	}
+	w.imports[RuntimePath] = true
+	w.Printf("\tif binenc.LittleEndian {\n")
+	w.Printf(copyFmt, w.rawBytes(name, fmt.Sprint(n)))
+	w.Printf(incrOffsetFmt, n)
+	w.Printf("\t} else {\n")
+	w.writeArrayLoop(name, arr)
+	w.Printf("\t}\n")
+}
+
+// readBulkSlice reads size elements into the slice name, whose elements of
+// type elem take elemSize bytes each.
+func (w *Writer) readBulkSlice(name string, elem types.Type, elemSize int) {
+	if isByte(elem) {
+		w.readFull(name, "size")
+		return
+	}
+	if elemSize == 1 {
+		w.Printf("\tif size > 0 {\n")
+		w.readFull(w.rawBytes(name, "size"), "size")
+		w.Printf("\t}\n")
+		return
+	}
+	n := fmt.Sprintf("%d*size", elemSize)
+	w.imports[RuntimePath] = true
+	w.Printf("\tif binenc.LittleEndian && size > 0 {\n")
+	w.readFull(w.rawBytes(name, n), n)
+	w.Printf("\t} else {\n")
+	w.readSliceLoop(name, elem)
+	w.Printf("\t}\n")
+}
+
+// readBulkArray reads the elements of the array name, of type arr, whose
+// elements take size bytes each.
+func (w *Writer) readBulkArray(name string, arr *types.Array, size int) {
+	n := fmt.Sprint(int(arr.Len()) * size)
+	if isByte(arr.Elem()) {
+		w.readFull(name+"[:]", n)
+		return
+	}
+	if size == 1 {
+		w.readFull(w.rawBytes(name, n), n)
+		return
+	}
+	w.imports[RuntimePath] = true
+	w.Printf("\tif binenc.LittleEndian {\n")
+	w.readFull(w.rawBytes(name, n), n)
+	w.Printf("\t} else {\n")
+	w.readArrayLoop(name, arr)
+	w.Printf("\t}\n")
+}
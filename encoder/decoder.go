@@ -0,0 +1,31 @@
+package encoder
+
+// Unless decoding from data, the generated read code reads through the
+// *binenc.Decoder d of the enclosing function. It takes its input, its
+// limits, its scratch buffer and the memory of its strings from d, which
+// keeps them across the values it decodes, and passes d to the read helpers
+// of recursive types.
+
+// decoderHeader returns the declarations of r, buf and opts taken from d.
+func (w *Writer) decoderHeader() []string {
+	var lines []string
+	if w.usedReader {
+		lines = append(lines, "r := d.Reader()\n")
+	}
+	if w.usedBuffer {
+		lines = append(lines, "buf := d.Buffer()\n")
+	}
+	if w.usedOpts {
+		lines = append(lines, "opts := d.Options()\n")
+	}
+	return lines
+}
+
+// readArenaString reads a string of size bytes into name, from memory of
+// the arena of d.
+func (w *Writer) readArenaString(name string) {
+	w.usedTmp = true
+	w.Printf("\ttmp = d.Arena(size)\n")
+	w.readFull("tmp", "size")
+	w.Printf("\t%s = *(*string)(unsafe.Pointer(&tmp))\n", name)
+}
@@ -6,6 +6,8 @@ import (
 	"go/types"
 	"io"
 	"log"
+	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -23,9 +25,7 @@ const (
 	lenFmt           = "len(%s)"
 	copyFmt          = "\tcopy(buf[" + staticIndex + ":], %s)\n"
 	booleanFmt       = "\tif %s {\n\t" + byteFmt + "\t} else {\n\t" + byteFmt + "\t}\n"
-	forStartFmt      = "\tfor _, %s := range %s {\n"
-	readBytesFmt     = "\tr.Read(buf[:%d])\n"
-	bytesCastFmt     = "(*(*[%d]byte)(unsafe.Pointer(&(%s))))[:]"
+	errFmt           = "\treturn %s, %s\n"
 )
 
 func abs(x int) int {
@@ -65,46 +65,153 @@ type Writer struct {
 
 	stdSizes *types.StdSizes
 	pkg      *types.Package
+	opts     Options
 
-	sizeExprs    []string
-	dynamicSizes [][]string
-	sizes        []int
-	forLvl       int
+	levels []*sizeLevel
+	forLvl int
+
+	// named holds the named types being inlined
+	named []*types.Named
+	// recursive holds the named types serialized through helper methods
+	recursive []*types.Named
 
 	bigEndian bool
+	// endians holds the byte order of the values enclosing the named types
+	endians []bool
+
+	// lenSize is the length prefix encoding of the current field
+	lenSize int
+	// encoding is the integer encoding of the current field
+	encoding Encoding
+	// width is the number of bytes of the integers of the current field,
+	// or 0 for their size
+	width int
+	// fieldEndian is the byte order set by the tag of the current field
+	fieldEndian *Endian
+	errs        []error
 
 	strBufCount int
 	usedSize    bool
+	usedUvarint bool
+	usedTmp     bool
 	usedBuffer  bool
-	needUnsafe  bool
+	// fromBytes selects decoding from the data slice instead of reading
+	// through the *binenc.Decoder d
+	fromBytes bool
+	// usedReader and usedOpts record the use of r and opts taken from d
+	usedReader bool
+	usedOpts   bool
+	// errResult is returned along with errors by the write code
+	errResult string
+	// blocks holds the ids of the open blocks of the generated code, the
+	// last one being nextBlock at most, to tell the segments apart
+	blocks    []int
+	nextBlock int
+	// segments holds the bounds checked runs of reads from data, the
+	// current one being extended by the next read, if any
+	segments []*segment
+	segment  *segment
+	// limits enables the checks of the decoding limits, see Limits, and
+	// depth is the expression of the nesting depth of the values read
+	limits bool
+	depth  string
+	// errType is the type named by the errors of the read code, see
+	// FieldErrors, field is the name of the field being read, and paths
+	// holds the paths of the map entry variables
+	errType string
+	field   string
+	paths   map[string]fieldPath
+	imports map[string]bool
+}
+
+// Options configures the generated code.
+type Options struct {
+	// WordSize is the number of bytes used on the wire for int, uint and
+	// uintptr values, either 4 or 8. Defaults to 8, which never truncates.
+	WordSize int
+	// LenSize is the encoding of string, slice and map lengths: the
+	// number of bytes 1, 2, 4 or 8, or LenUvarint. Defaults to 2.
+	// Struct fields may override it with a `binenc:"len=N"` tag.
+	// See fieldTag for the other options of field tags.
+	LenSize int
+	// Endian is the byte order of integers and floats.
+	Endian Endian
+	// Encoding is the encoding of integers wider than a byte, which
+	// struct fields may override with a `binenc:"enc=E"` tag.
+	Encoding Encoding
+	// TypeEndian overrides Endian for the values of the given types,
+	// wherever they are encoded.
+	TypeEndian map[*types.TypeName]Endian
+	// ZeroCopy makes the code decoding from a byte slice alias it with the
+	// decoded strings and []byte values, instead of copying their bytes.
+	// With Safe, only []byte values are aliased.
+	ZeroCopy bool
+	// Safe makes the generated code not import unsafe, at the cost of
+	// copies. It does not change the encoding.
+	Safe bool
+	// Reuse makes the read code decode into the slices, maps and pointers
+	// already held by the value read, rather than allocating new ones.
+	Reuse bool
+}
+
+// sizeLevel holds the size computation of a single loop of the generated
+// size expression. Level 0 is the top-level, outside of any loop.
+type sizeLevel struct {
+	static  int
+	dynamic []string
+	// body holds the rendered size computation of nested loops
+	body []string
 }
 
 func NewWriter(pkg *types.Package) *Writer {
+	return NewWriterOptions(pkg, Options{})
+}
+
+func NewWriterOptions(pkg *types.Package, opts Options) *Writer {
+	if opts.WordSize == 0 {
+		opts.WordSize = 8
+	}
+	if opts.LenSize == 0 {
+		opts.LenSize = 2
+	}
 	enc := &Writer{
 		buf: &bytes.Buffer{},
 		stdSizes: &types.StdSizes{
-			WordSize: 4,
-			MaxAlign: 4,
+			WordSize: int64(opts.WordSize),
+			MaxAlign: int64(opts.WordSize),
 		},
-		pkg:    pkg,
-		forLvl: -1,
+		pkg:       pkg,
+		opts:      opts,
+		lenSize:   opts.LenSize,
+		encoding:  opts.Encoding,
+		bigEndian: opts.Endian == BigEndian,
+		forLvl:    -1,
+		errResult: "0",
+		blocks:    []int{0},
+		depth:     "0",
+		paths:     make(map[string]fieldPath),
+		imports:   make(map[string]bool),
 	}
 	enc.pushForLvl()
 	return enc
 }
 
 func (w *Writer) Printf(format string, args ...interface{}) {
-	fmt.Fprintf(w.buf, format, args...)
+	s := fmt.Sprintf(format, args...)
+	if w.fromBytes {
+		w.trackBlocks(s)
+	}
+	w.buf.WriteString(s)
 }
 
 func (w *Writer) addOffset(size int) {
 	w.Printf(incrOffsetFmt, size)
-	w.sizes[w.forLvl] += size
+	w.levels[w.forLvl].static += size
 }
 
 func (w *Writer) addDynamicOffset(name string) {
 	w.Printf(dynOffsetFmt, name)
-	w.dynamicSizes[w.forLvl] = append(w.dynamicSizes[w.forLvl], name)
+	w.levels[w.forLvl].dynamic = append(w.levels[w.forLvl].dynamic, name)
 }
 
 func (w *Writer) writeByte(offset int, name string, incrOffset bool) {
@@ -112,10 +219,9 @@ func (w *Writer) writeByte(offset int, name string, incrOffset bool) {
 }
 
 func (w *Writer) writeString(name string) {
-	strLen := length(name)
-	w.writeNumberN(strLen, 2, true)
+	w.writeLength(name)
 	w.Printf(copyFmt, name)
-	w.addDynamicOffset(strLen)
+	w.addDynamicOffset(length(name))
 }
 
 func (w *Writer) writeNumberN(name string, nbytes int, unsigned bool) {
@@ -134,74 +240,118 @@ func (w *Writer) writeNumberN(name string, nbytes int, unsigned bool) {
 
 func (w *Writer) writeBoolean(name string) {
 	w.Printf(booleanFmt, name, staticIndex, "0x01", staticIndex, "0x00")
+	w.addOffset(1)
 }
 
 func (w *Writer) pushForLvl() {
-	w.sizes = append(w.sizes, 0)
-	w.dynamicSizes = append(w.dynamicSizes, []string{})
+	w.levels = append(w.levels, &sizeLevel{})
 	w.forLvl += 1
-	if w.forLvl > 0 {
-		w.sizeExprs = append(w.sizeExprs, "}\n")
+}
+
+// sizeMark returns a function reporting whether the size code of the
+// current level got dynamic terms or blocks since, which reference the
+// values written rather than only adding their static size.
+func (w *Writer) sizeMark() func() bool {
+	lvl := w.levels[w.forLvl]
+	dynamic, body := len(lvl.dynamic), len(lvl.body)
+	return func() bool {
+		return len(lvl.dynamic) > dynamic || len(lvl.body) > body
 	}
 }
 
-func (w *Writer) popForLvl(name string, static bool, staticLen int) {
-	// exprs are inserted in reverse order
-	defer func() {
-		w.dynamicSizes = w.dynamicSizes[:w.forLvl]
-		w.sizes = w.sizes[:w.forLvl]
-		w.forLvl -= 1
-	}()
+// popRangeLvl closes a size level that is repeated for every element of
+// the slice or map name, iterated with the given range variables, of which
+// those not used by the size code are "_".
+func (w *Writer) popRangeLvl(name string, vars ...string) {
+	w.popForLvl(
+		func(string) string {
+			return rangeStart(name, vars...)
+		},
+		func(parent *sizeLevel, static int) {
+			parent.dynamic = append(parent.dynamic, fmt.Sprintf("%d * len(%s)", static, name))
+		},
+	)
+}
 
-	// avoid adding a for that only sums a constant
-	// also avoids compiler error for unused variable
-	if len(w.dynamicSizes[w.forLvl]) == 0 && w.forLvl > 0 {
-		// remove closing bracket, not sure if this is sound
-		w.sizeExprs = w.sizeExprs[:len(w.sizeExprs)-1]
+// popArrayLvl closes a size level that is repeated n times.
+func (w *Writer) popArrayLvl(n int) {
+	v := indexForVar(w.forLvl)
+	w.popForLvl(
+		func(string) string {
+			return fmt.Sprintf("\tfor %s := 0; %s < %d; %s++ {\n", v, v, n, v)
+		},
+		func(parent *sizeLevel, static int) {
+			parent.static += static * n
+		},
+	)
+}
 
-		if !static {
-			w.dynamicSizes[w.forLvl-1] = append(w.dynamicSizes[w.forLvl-1],
-				fmt.Sprintf("%d * len(%s)", w.sizes[w.forLvl], name),
-			)
-		} else {
-			w.sizes[w.forLvl-1] += w.sizes[w.forLvl] * staticLen
+// popIfLvl closes a size level that is only added when cond holds.
+func (w *Writer) popIfLvl(cond string) {
+	w.popForLvl(
+		func(string) string {
+			return fmt.Sprintf("\tif %s {\n", cond)
+		},
+		nil,
+	)
+}
+
+// popForLvl closes the current size level, rendering it into its parent
+// as a block opened by header. Levels that only sum a constant are folded
+// into the parent instead, when fold is given, which avoids compiler
+// errors for unused variables.
+func (w *Writer) popForLvl(header func(inner string) string, fold func(parent *sizeLevel, static int)) {
+	lvl := w.levels[w.forLvl]
+	w.levels = w.levels[:w.forLvl]
+	w.forLvl -= 1
+	parent := w.levels[w.forLvl]
+
+	if len(lvl.dynamic) == 0 && len(lvl.body) == 0 {
+		if lvl.static == 0 {
+			return
+		}
+		if fold != nil {
+			fold(parent, lvl.static)
+			return
 		}
-		return
 	}
 
-	// add dynamic sizes
-	if len(w.dynamicSizes[w.forLvl]) > 0 {
-		w.sizeExprs = append(w.sizeExprs,
-			fmt.Sprintf(
-				"\tsize += %s\n",
-				strings.Join(w.dynamicSizes[w.forLvl], " + "),
-			),
-		)
+	inner := lvl.render("+=")
+	parent.body = append(parent.body, header(inner)+inner+"\t}\n")
+}
+
+// render returns the size computation of the level. sizeOp is the
+// operator used for its static size.
+func (l *sizeLevel) render(sizeOp string) string {
+	var lines []string
+	if l.static != 0 || sizeOp != "+=" {
+		lines = append(lines, fmt.Sprintf("\tsize %s %d\n", sizeOp, l.static))
 	}
+	if len(l.dynamic) > 0 {
+		lines = append(lines, fmt.Sprintf("\tsize += %s\n", strings.Join(l.dynamic, " + ")))
+	}
+	lines = append(lines, l.body...)
+	return strings.Join(lines, "")
+}
 
-	// add static sizes
-	sizeOp := "+="
-	if w.forLvl == 0 {
-		sizeOp = ":="
+// rangeStart returns the header of a for range loop over name with the
+// range variables vars, of which the unused ones are "_".
+func rangeStart(name string, vars ...string) string {
+	for len(vars) > 0 && vars[len(vars)-1] == "_" {
+		vars = vars[:len(vars)-1]
 	}
-	w.sizeExprs = append(w.sizeExprs,
-		fmt.Sprintf(
-			"\tsize %s %d\n",
-			sizeOp,
-			w.sizes[w.forLvl],
-		),
-	)
-	if w.forLvl > 0 {
-		if !static {
-			w.sizeExprs = append(w.sizeExprs,
-				fmt.Sprintf(forStartFmt, rangeForVar(w.forLvl), name),
-			)
-		} else {
-			w.sizeExprs = append(w.sizeExprs,
-				fmt.Sprintf("\tfor %s := 0; %s < %d; %s++ {\n", indexForVar(w.forLvl), indexForVar(w.forLvl), staticLen, indexForVar(w.forLvl)),
-			)
-		}
+	if len(vars) == 0 {
+		return fmt.Sprintf("\tfor range %s {\n", name)
 	}
+	return fmt.Sprintf("\tfor %s := range %s {\n", strings.Join(vars, ", "), name)
+}
+
+// usedVar returns v, or "_" when it is not used.
+func usedVar(v string, used bool) string {
+	if !used {
+		return "_"
+	}
+	return v
 }
 
 func (w *Writer) WriteField(name string, t types.Type) {
@@ -215,62 +365,170 @@ func rangeForVar(lvl int) string {
 	return fmt.Sprintf("v%d", lvl-1)
 }
 
-func (w *Writer) writeBytes(name string, nbytes int) {
-	w.needUnsafe = true
-	w.Printf(copyFmt, fmt.Sprintf(bytesCastFmt, nbytes, name))
-	w.addOffset(nbytes)
+// deref returns an expression dereferencing the pointer name, whose
+// element is of type elem.
+func deref(name string, elem types.Type) string {
+	if _, ok := elem.Underlying().(*types.Basic); ok {
+		return "*" + name
+	}
+	return "(*" + name + ")"
+}
+
+func rangeKeyVar(lvl int) string {
+	if lvl == 1 {
+		return "k"
+	}
+	return fmt.Sprintf("k%d", lvl-1)
+}
+
+// isEmpty reports whether values of type t are encoded with zero bytes.
+func isEmpty(t types.Type) bool {
+	switch u := t.Underlying().(type) {
+	case *types.Struct:
+		fields, _ := structFields(u)
+		for _, f := range fields {
+			if !isEmpty(f.v.Type()) {
+				return false
+			}
+		}
+		return true
+	case *types.Array:
+		return u.Len() == 0 || isEmpty(u.Elem())
+	}
+	return false
+}
+
+// enterNamed reports whether t is already being inlined, in which case
+// it must be serialized through its helper methods. Otherwise t is pushed
+// onto the stack of inlined types and must be popped with exitNamed.
+func (w *Writer) enterNamed(t *types.Named) (recursive bool) {
+	for _, n := range w.named {
+		if n != t {
+			continue
+		}
+		if t.Obj().Pkg() != w.pkg && w.pkg != nil {
+			log.Printf("unsupported recursive type from another package: %s\n", w.typeName(t))
+		}
+		for _, r := range w.recursive {
+			if r == t {
+				return true
+			}
+		}
+		w.recursive = append(w.recursive, t)
+		return true
+	}
+	w.named = append(w.named, t)
+	w.endians = append(w.endians, w.bigEndian)
+	w.bigEndian = w.endianOf(t) == BigEndian
+	// a field byte order stops at nested structs, like the other tag options
+	if _, ok := t.Underlying().(*types.Struct); !ok && w.fieldEndian != nil {
+		w.bigEndian = *w.fieldEndian == BigEndian
+	}
+	return false
+}
+
+// isInlined reports whether t is a named type being inlined, whose
+// nested values are serialized through its helper methods.
+func (w *Writer) isInlined(t types.Type) bool {
+	for _, n := range w.named {
+		if n == t {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *Writer) exitNamed() {
+	w.named = w.named[:len(w.named)-1]
+	w.bigEndian = w.endians[len(w.endians)-1]
+	w.endians = w.endians[:len(w.endians)-1]
+}
+
+// Recursive returns the named types referenced by the generated code
+// through their sizeBinenc, writeBinenc and readBinenc helper methods.
+func (w *Writer) Recursive() []*types.Named {
+	return w.recursive
 }
 
 func (w *Writer) writeField(name string, t types.Type) {
+	orig := t
+	if named, ok := t.(*types.Named); ok {
+		if w.enterNamed(named) {
+			w.Printf("\toffset, err = %s.writeBinenc(buf, offset)\n", name)
+			w.Printf("\tif err != nil {\n")
+			w.writeErr("err")
+			w.Printf("\t}\n")
+			w.levels[w.forLvl].dynamic = append(w.levels[w.forLvl].dynamic, name+".sizeBinenc()")
+			return
+		}
+		defer w.exitNamed()
+	}
 	t = t.Underlying()
 	if ptr, ok := t.(*types.Pointer); ok {
-		w.WriteField("*"+name, ptr.Elem())
+		// a presence byte precedes the pointed value, which is
+		// only written for non-nil pointers
+		cond := name + " != nil"
+		w.writeBoolean(cond)
+		w.pushForLvl()
+		w.Printf("\tif %s {\n", cond)
+		w.writeField(deref(name, ptr.Elem()), ptr.Elem())
+		w.popIfLvl(cond)
+		w.Printf("\t}\n")
 		return
 	}
 	if slc, ok := t.(*types.Slice); ok {
-		// TODO: specially handle []byte
-		slcLen := length(name)
-		w.writeNumberN(slcLen, 2, true)
+		w.writeLength(name)
+		if size := w.bulkSize(slc.Elem()); size > 0 {
+			w.writeBulkSlice(name, slc.Elem(), size)
+			return
+		}
+		w.writeSliceLoop(name, slc.Elem())
+		return
+	}
+	if m, ok := t.(*types.Map); ok {
+		w.writeLength(name)
 		w.pushForLvl()
-		w.Printf(forStartFmt, rangeForVar(w.forLvl), name)
-		w.writeField(rangeForVar(w.forLvl), slc.Elem())
-		w.popForLvl(name, false, 0)
+		k, v := rangeKeyVar(w.forLvl), rangeForVar(w.forLvl)
+		// nothing is written for empty keys and values
+		w.Printf(rangeStart(name, usedVar(k, !isEmpty(m.Key())), usedVar(v, !isEmpty(m.Elem()))))
+		keySize := w.sizeMark()
+		w.writeField(k, m.Key())
+		keyDynamic := keySize()
+		elemSize := w.sizeMark()
+		w.writeField(v, m.Elem())
+		w.popRangeLvl(name, usedVar(k, keyDynamic), usedVar(v, elemSize()))
 		w.Printf("\t}\n")
 		return
 	}
 	if s, ok := t.(*types.Struct); ok {
-		for i := 0; i < s.NumFields(); i++ {
-			f := s.Field(i)
-			if f.Name() == "_" {
-				continue
-			}
-			selector := fmt.Sprintf("%s.%s", name, f.Name())
-			w.writeField(selector, f.Type())
+		for _, f := range w.structFields(s) {
+			exit := w.enterField(f.tag)
+			w.writeField(fmt.Sprintf("%s.%s", name, f.v.Name()), f.v.Type())
+			exit()
 		}
 		return
 	}
 	if arr, ok := t.(*types.Array); ok {
-		w.pushForLvl()
-		w.Printf("\tfor %s := 0; %s < %d; %s++ {\n", indexForVar(w.forLvl), indexForVar(w.forLvl), arr.Len(), indexForVar(w.forLvl))
-		w.writeField(fmt.Sprintf("%s[%s]", name, indexForVar(w.forLvl)), arr.Elem())
-		w.popForLvl(name, true, int(arr.Len()))
-		w.Printf("\t}\n")
+		if size := w.bulkSize(arr.Elem()); size > 0 && arr.Len() > 0 {
+			w.writeBulkArray(name, arr, size)
+			return
+		}
+		w.writeArrayLoop(name, arr)
 		return
 	}
 	switch f := t.(type) {
 	case *types.Basic:
 		info := f.Info()
 		if info&types.IsInteger != 0 {
-			unsigned := info&types.IsUnsigned != 0
-			size := w.stdSizes.Sizeof(f)
-			w.writeNumberN(name, int(size), unsigned)
+			w.writeInteger(name, f)
 		} else if info&types.IsBoolean != 0 {
 			w.writeBoolean(name)
 		} else if info&types.IsString != 0 {
 			w.writeString(name)
-		} else if info&types.IsFloat != 0 || info&types.IsComplex != 0 {
-			size := w.stdSizes.Sizeof(f)
-			w.writeBytes(name, int(size))
+		} else if info&types.IsFloat != 0 {
+			w.writeFloat(name, orig, f)
+		} else if info&types.IsComplex != 0 {
+			w.writeComplex(name, f)
 		} else {
 			log.Printf("unknown type: %s\n", f.Name())
 		}
@@ -279,28 +537,102 @@ func (w *Writer) writeField(name string, t types.Type) {
 	}
 }
 
-func (w *Writer) readNumberN(name string, nbytes int, unsigned bool) {
-	w.usedBuffer = true
-	w.Printf(readBytesFmt, nbytes)
+// writeSliceLoop writes the elements of the slice name one by one.
+func (w *Writer) writeSliceLoop(name string, elem types.Type) {
+	w.pushForLvl()
+	if w.isInlined(elem) {
+		// elements serialized through their helpers are indexed, as
+		// copies would escape to the heap through the recursion
+		i := indexForVar(w.forLvl)
+		w.Printf("\tfor %s := range %s {\n", i, name)
+		elemSize := w.sizeMark()
+		w.writeField(fmt.Sprintf("%s[%s]", name, i), elem)
+		w.popRangeLvl(name, usedVar(i, elemSize()))
+		w.Printf("\t}\n")
+		return
+	}
+	v := rangeForVar(w.forLvl)
+	// nothing is written for empty elements
+	w.Printf(rangeStart(name, "_", usedVar(v, !isEmpty(elem))))
+	elemSize := w.sizeMark()
+	w.writeField(v, elem)
+	w.popRangeLvl(name, "_", usedVar(v, elemSize()))
+	w.Printf("\t}\n")
+}
+
+// writeArrayLoop writes the elements of the array name one by one.
+func (w *Writer) writeArrayLoop(name string, arr *types.Array) {
+	w.pushForLvl()
+	w.Printf("\tfor %s := 0; %s < %d; %s++ {\n", indexForVar(w.forLvl), indexForVar(w.forLvl), arr.Len(), indexForVar(w.forLvl))
+	w.writeField(fmt.Sprintf("%s[%s]", name, indexForVar(w.forLvl)), arr.Elem())
+	w.popArrayLvl(int(arr.Len()))
+	w.Printf("\t}\n")
+}
+
+// numberExpr returns an expression decoding the nbytes unsigned integer
+// starting at buf[start].
+func (w *Writer) numberExpr(start, nbytes int) string {
 	exprParts := []string{}
-	start, end, incr := 0, nbytes, 1
+	first, end, incr := 0, nbytes, 1
 	if w.bigEndian {
-		start, end, incr = nbytes-1, -1, -1
+		first, end, incr = nbytes-1, -1, -1
 	}
-	for i := start; i != end; i += incr {
-		b := fmt.Sprintf("buf[%d]", abs(i-start))
+	for i := first; i != end; i += incr {
+		b := fmt.Sprintf("buf[%d]", start+abs(i-first))
 		b = fmt.Sprintf(unsignedCastFmt, 8*nbytes, b)
 		exprParts = append(exprParts, lshift(b, i))
 	}
-	expr := strings.Join(exprParts, " | ")
-	if !unsigned {
-		expr = fmt.Sprintf("int%d(%s)", 8*nbytes, expr)
+	return strings.Join(exprParts, " | ")
+}
+
+// writeErr returns the error expr from the write code.
+func (w *Writer) writeErr(expr string) {
+	w.Printf(errFmt, w.errResult, expr)
+}
+
+// readErr returns the error expr from the read code.
+func (w *Writer) readErr(expr string) {
+	if w.fromBytes {
+		w.Printf(errFmt, "offset", w.fieldErr(expr))
+		return
 	}
-	w.Printf("\t%s = %s\n", name, expr)
+	w.Printf(errFmt, "n", w.fieldErr(expr))
+}
+
+// readFull reads exactly nbytes bytes, an int expression, into the slice
+// dst. Reading from r counts them into the int64 n, and a short read
+// returns the bytes read so far and its error. Reading from data advances
+// offset, and a short slice is an io.ErrUnexpectedEOF.
+func (w *Writer) readFull(dst, nbytes string) {
+	if w.fromBytes {
+		w.endSegment()
+		w.checkBounds(nbytes)
+		w.Printf("\toffset += copy(%s, data[offset:])\n", dst)
+		return
+	}
+	w.usedReader = true
+	if _, err := strconv.Atoi(nbytes); err != nil {
+		nbytes = fmt.Sprintf("int64(%s)", nbytes)
+	}
+	w.Printf("\tif nr, err := io.ReadFull(r, %s); err != nil {\n", dst)
+	w.Printf(errFmt, "n + int64(nr)", w.fieldErr("err"))
+	w.Printf("\t}\n")
+	w.Printf("\tn += %s\n", nbytes)
+}
+
+// readBytes reads the next nbytes bytes into buf. Decoding from data
+// reslices it rather than copying, see readSegment.
+func (w *Writer) readBytes(nbytes int) {
+	w.usedBuffer = true
+	if w.fromBytes {
+		w.readSegment(nbytes)
+		return
+	}
+	w.readFull(fmt.Sprintf("buf[:%d]", nbytes), strconv.Itoa(nbytes))
 }
 
 func (w *Writer) readBoolean(name string) {
-	w.Printf(readBytesFmt, 1)
+	w.readBytes(1)
 	w.Printf("\tif buf[0] == byte(0x01) {\n")
 	w.Printf("\t%s = true\n", name)
 	w.Printf("} else {\n")
@@ -309,11 +641,26 @@ func (w *Writer) readBoolean(name string) {
 }
 
 func (w *Writer) readString(name string) {
-	w.needUnsafe = true
-	w.usedSize = true
-	w.readNumberN("size", 2, true)
-	w.Printf("\tif c - m < int(size) {\n")
-	w.Printf("\tc = int(size)\n")
+	w.readLength()
+	// decoding from data without an arena checks the bounds right away
+	w.checkLength("MaxStringLen", !w.fromBytes || !(w.opts.Safe || w.zeroCopy()))
+	w.checkAlloc(1)
+	if w.opts.Safe {
+		w.readStringCopy(name)
+		return
+	}
+	if w.zeroCopy() {
+		w.aliasString(name)
+		return
+	}
+	w.imports["unsafe"] = true
+	if !w.fromBytes {
+		w.readArenaString(name)
+		return
+	}
+	// decoding from data, the strings of a call share the arena strBuf
+	w.Printf("\tif c - m < size {\n")
+	w.Printf("\tc = size\n")
 	w.Printf("\tif c < 2*cap(strBuf) {\n")
 	w.Printf("\tc = 2*cap(strBuf)\n")
 	w.Printf("\t}\n")
@@ -323,12 +670,12 @@ func (w *Writer) readString(name string) {
 	// do we need to copy previous bytes here??
 	w.Printf("\tm = 0\n")
 	w.Printf("\t}\n")
-	w.Printf("\tr.Read(strBuf[m:m+int(size)])\n")
-	w.Printf("\ttmp = strBuf[m:m+int(size)]\n")
+	w.readFull("strBuf[m:m+size]", "size")
+	w.Printf("\ttmp = strBuf[m:m+size]\n")
 	// based on strings.Builder.Strings
 	// https://cs.opensource.google/go/go/+/refs/tags/go1.19.4:src/strings/builder.go;l=48
 	w.Printf("\t%s = *(*string)(unsafe.Pointer(&tmp))\n", name)
-	w.Printf("\tm += int(size)\n")
+	w.Printf("\tm += size\n")
 	w.strBufCount += 1
 }
 
@@ -339,6 +686,20 @@ func indexForVar(lvl int) string {
 	return fmt.Sprintf("i%d", lvl)
 }
 
+func indexForKey(lvl int) string {
+	if lvl == 0 {
+		return "k"
+	}
+	return fmt.Sprintf("k%d", lvl)
+}
+
+func indexForElem(lvl int) string {
+	if lvl == 0 {
+		return "v"
+	}
+	return fmt.Sprintf("v%d", lvl)
+}
+
 func indexForSize(lvl int) string {
 	if lvl == 0 {
 		return "si"
@@ -348,83 +709,146 @@ func indexForSize(lvl int) string {
 
 func (w *Writer) HeaderExpr() string {
 	var lines []string
-	if w.usedBuffer {
-		// TODO: use smallest buffer possible
-		lines = append(lines, "buf := make([]byte, 8)\n")
+	if !w.fromBytes {
+		lines = w.decoderHeader()
+	} else if w.usedBuffer {
+		lines = append(lines, "var buf []byte\n")
 	}
 	if w.usedSize {
-		lines = append(lines, "var size uint16\n")
+		lines = append(lines, "var size int\n")
+	}
+	if w.usedUvarint {
+		lines = append(lines, "var usize uint64\n")
+	}
+	if w.strBufCount > 0 || w.usedTmp {
+		lines = append(lines, "var tmp []byte\n")
 	}
 	if w.strBufCount > 0 {
-		lines = append(lines, "var tmp []byte\n", "m := 0\n", "c := 64\n", "strBuf := make([]byte, c)\n")
+		lines = append(lines, "m := 0\n", "c := 64\n", "strBuf := make([]byte, c)\n")
 	}
 	return strings.Join(lines, "")
 }
 
+// FromBytes makes the generated read code decode from the data slice,
+// starting at and advancing the int offset, instead of reading from r.
+func (w *Writer) FromBytes() {
+	w.fromBytes = true
+}
+
+// ErrorResult sets the result returned along with errors by the generated
+// write code, 0 by default.
+func (w *Writer) ErrorResult(result string) {
+	w.errResult = result
+}
+
 func (w *Writer) typeName(t types.Type) string {
-	return types.TypeString(t, types.RelativeTo(w.pkg))
+	return types.TypeString(t, w.qualify)
 }
 
-func (w *Writer) readBytes(name string, nbytes int) {
-	w.needUnsafe = true
-	w.Printf("\tr.Read(%s)\n", fmt.Sprintf(bytesCastFmt, nbytes, name))
+// qualify returns the qualifier of the types of the package p in the
+// generated code, recording its import unless p is the generated package.
+func (w *Writer) qualify(p *types.Package) string {
+	if p == w.pkg {
+		return ""
+	}
+	w.imports[p.Path()] = true
+	return p.Name()
 }
 
 func (w *Writer) ReadField(name string, t types.Type) {
+	field := w.field
+	w.field = name
+	defer func() { w.field = field }()
+	orig := t
+	if named, ok := t.(*types.Named); ok {
+		if w.enterNamed(named) {
+			if w.fromBytes {
+				w.endSegment()
+				w.Printf("\tif offset, err = %s.decodeBinenc(data, offset%s); err != nil {\n", name, w.helperArgs())
+			} else {
+				w.Printf("\tif n, err = %s.readBinenc(d, n%s); err != nil {\n", name, w.helperArgs())
+			}
+			w.readErr("err")
+			w.Printf("\t}\n")
+			return
+		}
+		defer w.exitNamed()
+	}
 	t = t.Underlying()
 	if ptr, ok := t.(*types.Pointer); ok {
-		w.Printf("\t%s = new(%s)\n", name, w.typeName(ptr.Elem()))
-		w.ReadField("*"+name, ptr.Elem())
+		w.readBytes(1)
+		w.Printf("\tif buf[0] == byte(0x01) {\n")
+		w.newPointer(name, ptr.Elem())
+		w.ReadField(deref(name, ptr.Elem()), ptr.Elem())
+		w.Printf("\t} else {\n")
+		w.Printf("\t%s = nil\n", name)
+		w.Printf("\t}\n")
 		return
 	}
 	if slc, ok := t.(*types.Slice); ok {
-		// TODO: specially handle []byte
-		w.usedSize = true
-		slcLen := "size"
-		w.readNumberN(slcLen, 2, true)
-		w.Printf("\t%s = make(%s, %s)\n", name, w.typeName(slc), slcLen)
-		// intentionally never decrease forLvl
-		// to never reuse index variables
-		w.Printf("\t%s := int(%s)\n", indexForSize(w.forLvl), slcLen)
+		w.readLength()
+		if isByte(slc.Elem()) && w.zeroCopy() {
+			w.checkLength("MaxSliceLen", false)
+			w.aliasBytes(name)
+			return
+		}
+		w.checkLength("MaxSliceLen", !isEmpty(slc.Elem()))
+		w.checkAlloc(w.stdSizes.Sizeof(slc.Elem()))
+		w.makeSlice(name, slc)
+		if size := w.bulkSize(slc.Elem()); size > 0 {
+			w.readBulkSlice(name, slc.Elem(), size)
+			return
+		}
+		w.readSliceLoop(name, slc.Elem())
+		return
+	}
+	if m, ok := t.(*types.Map); ok {
+		w.readLength()
+		w.checkLength("MaxSliceLen", !isEmpty(m.Key()) || !isEmpty(m.Elem()))
+		w.makeMap(name, m)
+		w.Printf("\t%s := size\n", indexForSize(w.forLvl))
 		w.Printf("\tfor %s := 0; %s < %s; %s++ {\n", indexForVar(w.forLvl), indexForVar(w.forLvl), indexForSize(w.forLvl), indexForVar(w.forLvl))
+		k, v := indexForKey(w.forLvl), indexForElem(w.forLvl)
+		w.Printf("\tvar %s %s\n", k, w.typeName(m.Key()))
+		w.Printf("\tvar %s %s\n", v, w.typeName(m.Elem()))
 		w.forLvl += 1
-		w.ReadField(fmt.Sprintf("%s[%s]", name, indexForVar(w.forLvl-1)), slc.Elem())
+		w.aliasMapEntry(name, k, v, m.Key())
+		w.ReadField(k, m.Key())
+		w.ReadField(v, m.Elem())
+		w.Printf("\t%s[%s] = %s\n", name, k, v)
 		w.Printf("\t}\n")
 		return
 	}
 	// TODO: add tests for read
 	if s, ok := t.(*types.Struct); ok {
-		for i := 0; i < s.NumFields(); i++ {
-			f := s.Field(i)
-			if f.Name() == "_" {
-				continue
-			}
-			selector := fmt.Sprintf("%s.%s", name, f.Name())
-			w.ReadField(selector, f.Type())
+		for _, f := range w.structFields(s) {
+			exit := w.enterField(f.tag)
+			w.ReadField(fmt.Sprintf("%s.%s", name, f.v.Name()), f.v.Type())
+			exit()
 		}
 		return
 	}
 	if arr, ok := t.(*types.Array); ok {
-		w.Printf("\tfor %s := 0; %s < %d; %s++ {\n", indexForVar(w.forLvl), indexForVar(w.forLvl), arr.Len(), indexForVar(w.forLvl))
-		w.forLvl += 1
-		w.ReadField(fmt.Sprintf("%s[%s]", name, indexForVar(w.forLvl-1)), arr.Elem())
-		w.Printf("\t}\n")
+		if size := w.bulkSize(arr.Elem()); size > 0 && arr.Len() > 0 {
+			w.readBulkArray(name, arr, size)
+			return
+		}
+		w.readArrayLoop(name, arr)
 		return
 	}
 	switch f := t.(type) {
 	case *types.Basic:
 		info := f.Info()
 		if info&types.IsInteger != 0 {
-			unsigned := info&types.IsUnsigned != 0
-			size := w.stdSizes.Sizeof(f)
-			w.readNumberN(name, int(size), unsigned)
+			w.readInteger(name, orig, f)
 		} else if info&types.IsBoolean != 0 {
 			w.readBoolean(name)
 		} else if info&types.IsString != 0 {
 			w.readString(name)
-		} else if info&types.IsFloat != 0 || info&types.IsComplex != 0 {
-			size := w.stdSizes.Sizeof(f)
-			w.readBytes(name, int(size))
+		} else if info&types.IsFloat != 0 {
+			w.readFloat(name, orig, f)
+		} else if info&types.IsComplex != 0 {
+			w.readComplex(name, orig, f)
 		} else {
 			log.Printf("unknown type: %s\n", f.Name())
 		}
@@ -433,25 +857,45 @@ func (w *Writer) ReadField(name string, t types.Type) {
 	}
 }
 
+// readSliceLoop reads the elements of the slice name one by one, its
+// length being held by the size variable.
+func (w *Writer) readSliceLoop(name string, elem types.Type) {
+	// intentionally never decrease forLvl
+	// to never reuse index variables
+	w.Printf("\t%s := size\n", indexForSize(w.forLvl))
+	w.Printf("\tfor %s := 0; %s < %s; %s++ {\n", indexForVar(w.forLvl), indexForVar(w.forLvl), indexForSize(w.forLvl), indexForVar(w.forLvl))
+	w.forLvl += 1
+	w.ReadField(fmt.Sprintf("%s[%s]", name, indexForVar(w.forLvl-1)), elem)
+	w.Printf("\t}\n")
+}
+
+// readArrayLoop reads the elements of the array name one by one.
+func (w *Writer) readArrayLoop(name string, arr *types.Array) {
+	w.Printf("\tfor %s := 0; %s < %d; %s++ {\n", indexForVar(w.forLvl), indexForVar(w.forLvl), arr.Len(), indexForVar(w.forLvl))
+	w.forLvl += 1
+	w.ReadField(fmt.Sprintf("%s[%s]", name, indexForVar(w.forLvl-1)), arr.Elem())
+	w.Printf("\t}\n")
+}
+
 func (w *Writer) SizeExpr() string {
-	if w.forLvl == 0 {
-		w.popForLvl("ignored", false, -1)
-	}
-	res := make([]string, len(w.sizeExprs))
-	for i := 0; i < len(w.sizeExprs); i++ {
-		res[i] = w.sizeExprs[len(w.sizeExprs)-i-1]
-	}
-	return strings.Join(res, "")
+	return w.levels[0].render(":=")
 }
 
 func (w *Writer) Bytes() []byte {
-	return w.buf.Bytes()
+	return w.resolveSegments()
 }
 
 func (w *Writer) WriteTo(writer io.Writer) (n int64, err error) {
-	return w.buf.WriteTo(writer)
+	nw, err := writer.Write(w.resolveSegments())
+	return int64(nw), err
 }
 
-func (w *Writer) NeedUnsafe() bool {
-	return w.needUnsafe
+// Imports returns the sorted import paths used by the generated code.
+func (w *Writer) Imports() []string {
+	var paths []string
+	for path := range w.imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
 }
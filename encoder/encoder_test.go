@@ -33,8 +33,24 @@ func TestWriteField_Pointers(t *testing.T) {
 		{
 			name: "**byte",
 			want: []string{
-				"buf[offset] = byte(**test)",
+				"if test != nil {",
+				"buf[offset] = byte(0x01)",
+				"} else {",
+				"buf[offset] = byte(0x00)",
+				"}",
+				"offset += 1",
+				"if test != nil {",
+				"if (*test) != nil {",
+				"buf[offset] = byte(0x01)",
+				"} else {",
+				"buf[offset] = byte(0x00)",
+				"}",
+				"offset += 1",
+				"if (*test) != nil {",
+				"buf[offset] = byte(*(*test))",
 				"offset += 1",
+				"}",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.NewPointer(types.Typ[types.Byte])),
@@ -42,8 +58,16 @@ func TestWriteField_Pointers(t *testing.T) {
 		{
 			name: "*byte",
 			want: []string{
+				"if test != nil {",
+				"buf[offset] = byte(0x01)",
+				"} else {",
+				"buf[offset] = byte(0x00)",
+				"}",
+				"offset += 1",
+				"if test != nil {",
 				"buf[offset] = byte(*test)",
 				"offset += 1",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Byte]),
@@ -51,8 +75,16 @@ func TestWriteField_Pointers(t *testing.T) {
 		{
 			name: "*int8",
 			want: []string{
+				"if test != nil {",
+				"buf[offset] = byte(0x01)",
+				"} else {",
+				"buf[offset] = byte(0x00)",
+				"}",
+				"offset += 1",
+				"if test != nil {",
 				"buf[offset] = byte(uint8(*test))",
 				"offset += 1",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Int8]),
@@ -60,8 +92,16 @@ func TestWriteField_Pointers(t *testing.T) {
 		{
 			name: "*uint8",
 			want: []string{
+				"if test != nil {",
+				"buf[offset] = byte(0x01)",
+				"} else {",
+				"buf[offset] = byte(0x00)",
+				"}",
+				"offset += 1",
+				"if test != nil {",
 				"buf[offset] = byte(*test)",
 				"offset += 1",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Uint8]),
@@ -69,9 +109,17 @@ func TestWriteField_Pointers(t *testing.T) {
 		{
 			name: "*int16",
 			want: []string{
+				"if test != nil {",
+				"buf[offset] = byte(0x01)",
+				"} else {",
+				"buf[offset] = byte(0x00)",
+				"}",
+				"offset += 1",
+				"if test != nil {",
 				"buf[offset] = byte(uint16(*test))",
 				"buf[offset + 1] = byte(uint16(*test) >> 8)",
 				"offset += 2",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Int16]),
@@ -79,9 +127,17 @@ func TestWriteField_Pointers(t *testing.T) {
 		{
 			name: "*uint16",
 			want: []string{
+				"if test != nil {",
+				"buf[offset] = byte(0x01)",
+				"} else {",
+				"buf[offset] = byte(0x00)",
+				"}",
+				"offset += 1",
+				"if test != nil {",
 				"buf[offset] = byte(*test)",
 				"buf[offset + 1] = byte(*test >> 8)",
 				"offset += 2",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Uint16]),
@@ -89,11 +145,19 @@ func TestWriteField_Pointers(t *testing.T) {
 		{
 			name: "*uint32",
 			want: []string{
+				"if test != nil {",
+				"buf[offset] = byte(0x01)",
+				"} else {",
+				"buf[offset] = byte(0x00)",
+				"}",
+				"offset += 1",
+				"if test != nil {",
 				"buf[offset] = byte(*test)",
 				"buf[offset + 1] = byte(*test >> 8)",
 				"buf[offset + 2] = byte(*test >> 16)",
 				"buf[offset + 3] = byte(*test >> 24)",
 				"offset += 4",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Uint32]),
@@ -101,6 +165,13 @@ func TestWriteField_Pointers(t *testing.T) {
 		{
 			name: "*uint64",
 			want: []string{
+				"if test != nil {",
+				"buf[offset] = byte(0x01)",
+				"} else {",
+				"buf[offset] = byte(0x00)",
+				"}",
+				"offset += 1",
+				"if test != nil {",
 				"buf[offset] = byte(*test)",
 				"buf[offset + 1] = byte(*test >> 8)",
 				"buf[offset + 2] = byte(*test >> 16)",
@@ -110,6 +181,7 @@ func TestWriteField_Pointers(t *testing.T) {
 				"buf[offset + 6] = byte(*test >> 48)",
 				"buf[offset + 7] = byte(*test >> 56)",
 				"offset += 8",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Uint64]),
@@ -117,11 +189,19 @@ func TestWriteField_Pointers(t *testing.T) {
 		{
 			name: "*int32",
 			want: []string{
+				"if test != nil {",
+				"buf[offset] = byte(0x01)",
+				"} else {",
+				"buf[offset] = byte(0x00)",
+				"}",
+				"offset += 1",
+				"if test != nil {",
 				"buf[offset] = byte(uint32(*test))",
 				"buf[offset + 1] = byte(uint32(*test) >> 8)",
 				"buf[offset + 2] = byte(uint32(*test) >> 16)",
 				"buf[offset + 3] = byte(uint32(*test) >> 24)",
 				"offset += 4",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Int32]),
@@ -129,6 +209,13 @@ func TestWriteField_Pointers(t *testing.T) {
 		{
 			name: "*int64",
 			want: []string{
+				"if test != nil {",
+				"buf[offset] = byte(0x01)",
+				"} else {",
+				"buf[offset] = byte(0x00)",
+				"}",
+				"offset += 1",
+				"if test != nil {",
 				"buf[offset] = byte(uint64(*test))",
 				"buf[offset + 1] = byte(uint64(*test) >> 8)",
 				"buf[offset + 2] = byte(uint64(*test) >> 16)",
@@ -138,6 +225,7 @@ func TestWriteField_Pointers(t *testing.T) {
 				"buf[offset + 6] = byte(uint64(*test) >> 48)",
 				"buf[offset + 7] = byte(uint64(*test) >> 56)",
 				"offset += 8",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Int64]),
@@ -165,10 +253,18 @@ func TestReadField_Pointers(t *testing.T) {
 		{
 			name: "*[10]int8",
 			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
 				"test = new([10]int8)",
-				"for i := 0; i < 10; i++ {",
-				"r.Read(buf[:1])",
-				"*test[i] = int8(uint8(buf[0]))",
+				"if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&(*test)[0])), 10)); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 10",
+				"} else {",
+				"test = nil",
 				"}",
 				"",
 			},
@@ -177,9 +273,20 @@ func TestReadField_Pointers(t *testing.T) {
 		{
 			name: "*byte",
 			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
 				"test = new(uint8)",
-				"r.Read(buf[:1])",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
 				"*test = uint8(buf[0])",
+				"} else {",
+				"test = nil",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Byte]),
@@ -187,10 +294,29 @@ func TestReadField_Pointers(t *testing.T) {
 		{
 			name: "**int8",
 			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
 				"test = new(*int8)",
-				"*test = new(int8)",
-				"r.Read(buf[:1])",
-				"**test = int8(uint8(buf[0]))",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
+				"(*test) = new(int8)",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"*(*test) = int8(uint8(buf[0]))",
+				"} else {",
+				"(*test) = nil",
+				"}",
+				"} else {",
+				"test = nil",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.NewPointer(types.Typ[types.Int8])),
@@ -198,9 +324,20 @@ func TestReadField_Pointers(t *testing.T) {
 		{
 			name: "*int8",
 			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
 				"test = new(int8)",
-				"r.Read(buf[:1])",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
 				"*test = int8(uint8(buf[0]))",
+				"} else {",
+				"test = nil",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Int8]),
@@ -208,9 +345,20 @@ func TestReadField_Pointers(t *testing.T) {
 		{
 			name: "*uint8",
 			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
 				"test = new(uint8)",
-				"r.Read(buf[:1])",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
 				"*test = uint8(buf[0])",
+				"} else {",
+				"test = nil",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Uint8]),
@@ -218,9 +366,20 @@ func TestReadField_Pointers(t *testing.T) {
 		{
 			name: "*int16",
 			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
 				"test = new(int16)",
-				"r.Read(buf[:2])",
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
 				"*test = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"} else {",
+				"test = nil",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Int16]),
@@ -228,9 +387,20 @@ func TestReadField_Pointers(t *testing.T) {
 		{
 			name: "*uint16",
 			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
 				"test = new(uint16)",
-				"r.Read(buf[:2])",
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
 				"*test = uint16(buf[0]) | (uint16(buf[1]) << 8)",
+				"} else {",
+				"test = nil",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Uint16]),
@@ -238,9 +408,20 @@ func TestReadField_Pointers(t *testing.T) {
 		{
 			name: "*uint32",
 			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
 				"test = new(uint32)",
-				"r.Read(buf[:4])",
+				"if nr, err := io.ReadFull(r, buf[:4]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 4",
 				"*test = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)",
+				"} else {",
+				"test = nil",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Uint32]),
@@ -248,9 +429,20 @@ func TestReadField_Pointers(t *testing.T) {
 		{
 			name: "*uint64",
 			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
 				"test = new(uint64)",
-				"r.Read(buf[:8])",
+				"if nr, err := io.ReadFull(r, buf[:8]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 8",
 				"*test = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)",
+				"} else {",
+				"test = nil",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Uint64]),
@@ -258,9 +450,20 @@ func TestReadField_Pointers(t *testing.T) {
 		{
 			name: "*int32",
 			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
 				"test = new(int32)",
-				"r.Read(buf[:4])",
+				"if nr, err := io.ReadFull(r, buf[:4]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 4",
 				"*test = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))",
+				"} else {",
+				"test = nil",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Int32]),
@@ -268,9 +471,20 @@ func TestReadField_Pointers(t *testing.T) {
 		{
 			name: "*int64",
 			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
 				"test = new(int64)",
-				"r.Read(buf[:8])",
+				"if nr, err := io.ReadFull(r, buf[:8]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 8",
 				"*test = int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))",
+				"} else {",
+				"test = nil",
+				"}",
 				"",
 			},
 			t: types.NewPointer(types.Typ[types.Int64]),
@@ -298,7 +512,10 @@ func TestReadField_IntegerTypes(t *testing.T) {
 		{
 			name: "byte",
 			want: []string{
-				"r.Read(buf[:1])",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
 				"test = uint8(buf[0])",
 				"",
 			},
@@ -307,7 +524,10 @@ func TestReadField_IntegerTypes(t *testing.T) {
 		{
 			name: "int8",
 			want: []string{
-				"r.Read(buf[:1])",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
 				"test = int8(uint8(buf[0]))",
 				"",
 			},
@@ -316,7 +536,10 @@ func TestReadField_IntegerTypes(t *testing.T) {
 		{
 			name: "uint8",
 			want: []string{
-				"r.Read(buf[:1])",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
 				"test = uint8(buf[0])",
 				"",
 			},
@@ -325,7 +548,10 @@ func TestReadField_IntegerTypes(t *testing.T) {
 		{
 			name: "int16",
 			want: []string{
-				"r.Read(buf[:2])",
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
 				"test = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))",
 				"",
 			},
@@ -334,7 +560,10 @@ func TestReadField_IntegerTypes(t *testing.T) {
 		{
 			name: "uint16",
 			want: []string{
-				"r.Read(buf[:2])",
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
 				"test = uint16(buf[0]) | (uint16(buf[1]) << 8)",
 				"",
 			},
@@ -343,7 +572,10 @@ func TestReadField_IntegerTypes(t *testing.T) {
 		{
 			name: "uint32",
 			want: []string{
-				"r.Read(buf[:4])",
+				"if nr, err := io.ReadFull(r, buf[:4]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 4",
 				"test = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)",
 				"",
 			},
@@ -352,7 +584,10 @@ func TestReadField_IntegerTypes(t *testing.T) {
 		{
 			name: "uint64",
 			want: []string{
-				"r.Read(buf[:8])",
+				"if nr, err := io.ReadFull(r, buf[:8]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 8",
 				"test = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)",
 				"",
 			},
@@ -361,7 +596,10 @@ func TestReadField_IntegerTypes(t *testing.T) {
 		{
 			name: "Int32",
 			want: []string{
-				"r.Read(buf[:4])",
+				"if nr, err := io.ReadFull(r, buf[:4]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 4",
 				"test = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))",
 				"",
 			},
@@ -370,12 +608,47 @@ func TestReadField_IntegerTypes(t *testing.T) {
 		{
 			name: "Int64",
 			want: []string{
-				"r.Read(buf[:8])",
+				"if nr, err := io.ReadFull(r, buf[:8]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 8",
 				"test = int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))",
 				"",
 			},
 			t: types.Typ[types.Int64],
 		},
+		{
+			name: "int",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:8]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 8",
+				"if x := int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)); int64(int(x)) != x {",
+				"return n, fmt.Errorf(\"binenc: %d overflows int\", x)",
+				"} else {",
+				"test = int(x)",
+				"}",
+				"",
+			},
+			t: types.Typ[types.Int],
+		},
+		{
+			name: "uint",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:8]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 8",
+				"if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); uint64(uint(x)) != x {",
+				"return n, fmt.Errorf(\"binenc: %d overflows uint\", x)",
+				"} else {",
+				"test = uint(x)",
+				"}",
+				"",
+			},
+			t: types.Typ[types.Uint],
+		},
 	}
 
 	for _, c := range cases {
@@ -499,6 +772,38 @@ func TestWriteField_IntegerTypes(t *testing.T) {
 			},
 			t: types.Typ[types.Int64],
 		},
+		{
+			name: "int",
+			want: []string{
+				"buf[offset] = byte(uint64(test))",
+				"buf[offset + 1] = byte(uint64(test) >> 8)",
+				"buf[offset + 2] = byte(uint64(test) >> 16)",
+				"buf[offset + 3] = byte(uint64(test) >> 24)",
+				"buf[offset + 4] = byte(uint64(test) >> 32)",
+				"buf[offset + 5] = byte(uint64(test) >> 40)",
+				"buf[offset + 6] = byte(uint64(test) >> 48)",
+				"buf[offset + 7] = byte(uint64(test) >> 56)",
+				"offset += 8",
+				"",
+			},
+			t: types.Typ[types.Int],
+		},
+		{
+			name: "uint",
+			want: []string{
+				"buf[offset] = byte(test)",
+				"buf[offset + 1] = byte(test >> 8)",
+				"buf[offset + 2] = byte(test >> 16)",
+				"buf[offset + 3] = byte(test >> 24)",
+				"buf[offset + 4] = byte(test >> 32)",
+				"buf[offset + 5] = byte(test >> 40)",
+				"buf[offset + 6] = byte(test >> 48)",
+				"buf[offset + 7] = byte(test >> 56)",
+				"offset += 8",
+				"",
+			},
+			t: types.Typ[types.Uint],
+		},
 	}
 
 	for _, c := range cases {
@@ -513,156 +818,211 @@ func TestWriteField_IntegerTypes(t *testing.T) {
 	}
 }
 
-func TestReadField_Array(t *testing.T) {
+func TestWriteField_WordSize(t *testing.T) {
 	cases := []struct {
-		name           string
-		want           []string
-		wantHeaderExpr []string
-		t              types.Type
+		name string
+		want []string
+		t    types.Type
 	}{
 		{
-			name: "[]int16",
+			name: "int",
 			want: []string{
-				"for i := 0; i < 10; i++ {",
-				"r.Read(buf[:2])",
-				"test[i] = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"if test < math.MinInt32 || test > math.MaxInt32 {",
+				"return 0, fmt.Errorf(\"binenc: %d overflows 4 bytes\", test)",
 				"}",
+				"buf[offset] = byte(uint32(test))",
+				"buf[offset + 1] = byte(uint32(test) >> 8)",
+				"buf[offset + 2] = byte(uint32(test) >> 16)",
+				"buf[offset + 3] = byte(uint32(test) >> 24)",
+				"offset += 4",
 				"",
 			},
-			wantHeaderExpr: []string{
-				"buf := make([]byte, 8)",
-				"",
-			},
-			t: types.NewArray(types.Typ[types.Int16], 10),
+			t: types.Typ[types.Int],
 		},
 		{
-			name: "[][]int16",
+			name: "uint",
 			want: []string{
-				"for i := 0; i < 8; i++ {",
-				"for i1 := 0; i1 < 8; i1++ {",
-				"r.Read(buf[:2])",
-				"test[i][i1] = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))",
-				"}",
+				"if uint64(test) > math.MaxUint32 {",
+				"return 0, fmt.Errorf(\"binenc: %d overflows 4 bytes\", test)",
 				"}",
+				"buf[offset] = byte(test)",
+				"buf[offset + 1] = byte(test >> 8)",
+				"buf[offset + 2] = byte(test >> 16)",
+				"buf[offset + 3] = byte(test >> 24)",
+				"offset += 4",
 				"",
 			},
-			wantHeaderExpr: []string{
-				"buf := make([]byte, 8)",
-				"",
-			},
-			t: types.NewArray(types.NewArray(types.Typ[types.Int16], 8), 8),
+			t: types.Typ[types.Uint],
 		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriterOptions(nil, encoder.Options{WordSize: 4})
+			e.WriteField("test", c.t)
+			lines := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, lines); diff != "" {
+				t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+		})
+	}
+}
+
+func TestReadField_WordSize(t *testing.T) {
+	cases := []struct {
+		name string
+		want []string
+		t    types.Type
+	}{
 		{
-			name: "[]string",
+			name: "int",
 			want: []string{
-				"for i := 0; i < 16; i++ {",
-				"r.Read(buf[:2])",
-				"size = uint16(buf[0]) | (uint16(buf[1]) << 8)",
-				"strBuf_0 := make([]byte, size)",
-				"r.Read(strBuf_0)",
-				"test[i] = *(*string)(unsafe.Pointer(&strBuf_0))",
+				"if nr, err := io.ReadFull(r, buf[:4]); err != nil {",
+				"return n + int64(nr), err",
 				"}",
+				"n += 4",
+				"test = int(int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)))",
 				"",
 			},
-			wantHeaderExpr: []string{
-				"buf := make([]byte, 8)",
-				"var size uint16",
+			t: types.Typ[types.Int],
+		},
+		{
+			name: "uint",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:4]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 4",
+				"test = uint(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))",
 				"",
 			},
-			t: types.NewArray(types.Typ[types.String], 16),
+			t: types.Typ[types.Uint],
 		},
 	}
 
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			e := encoder.NewWriter(nil)
+			e := encoder.NewWriterOptions(nil, encoder.Options{WordSize: 4})
 			e.ReadField("test", c.t)
 			lines := parseOutput(t, e)
 			if diff := cmp.Diff(c.want, lines); diff != "" {
 				t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
 			}
-			sizeLines := splitLinesTrim(t, e.HeaderExpr())
-			if diff := cmp.Diff(c.wantHeaderExpr, sizeLines); diff != "" {
-				t.Errorf("e.HeaderExpr(): (-want, +got):\n%s", diff)
-			}
 		})
 	}
 }
-func TestReadField_Slice(t *testing.T) {
+
+func TestWriteField_Floats(t *testing.T) {
 	cases := []struct {
-		name           string
-		want           []string
-		wantHeaderExpr []string
-		t              types.Type
+		name string
+		want []string
+		t    types.Type
 	}{
 		{
-			name: "[]int16",
+			name: "float32",
 			want: []string{
-				"r.Read(buf[:2])",
-				"size = uint16(buf[0]) | (uint16(buf[1]) << 8)",
-				"test = make([]int16, size)",
-				"si := int(size)",
-				"for i := 0; i < si; i++ {",
-				"r.Read(buf[:2])",
-				"test[i] = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))",
-				"}",
+				"buf[offset] = byte(math.Float32bits(test))",
+				"buf[offset + 1] = byte(math.Float32bits(test) >> 8)",
+				"buf[offset + 2] = byte(math.Float32bits(test) >> 16)",
+				"buf[offset + 3] = byte(math.Float32bits(test) >> 24)",
+				"offset += 4",
 				"",
 			},
-			wantHeaderExpr: []string{
-				"buf := make([]byte, 8)",
-				"var size uint16",
+			t: types.Typ[types.Float32],
+		},
+		{
+			name: "float64",
+			want: []string{
+				"buf[offset] = byte(math.Float64bits(test))",
+				"buf[offset + 1] = byte(math.Float64bits(test) >> 8)",
+				"buf[offset + 2] = byte(math.Float64bits(test) >> 16)",
+				"buf[offset + 3] = byte(math.Float64bits(test) >> 24)",
+				"buf[offset + 4] = byte(math.Float64bits(test) >> 32)",
+				"buf[offset + 5] = byte(math.Float64bits(test) >> 40)",
+				"buf[offset + 6] = byte(math.Float64bits(test) >> 48)",
+				"buf[offset + 7] = byte(math.Float64bits(test) >> 56)",
+				"offset += 8",
 				"",
 			},
-			t: types.NewSlice(types.Typ[types.Int16]),
+			t: types.Typ[types.Float64],
 		},
 		{
-			name: "[][]int16",
+			name: "complex64",
 			want: []string{
-				"r.Read(buf[:2])",
-				"size = uint16(buf[0]) | (uint16(buf[1]) << 8)",
-				"test = make([][]int16, size)",
-				"si := int(size)",
-				"for i := 0; i < si; i++ {",
-				"r.Read(buf[:2])",
-				"size = uint16(buf[0]) | (uint16(buf[1]) << 8)",
-				"test[i] = make([]int16, size)",
-				"si1 := int(size)",
-				"for i1 := 0; i1 < si1; i1++ {",
-				"r.Read(buf[:2])",
-				"test[i][i1] = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))",
-				"}",
-				"}",
+				"buf[offset] = byte(math.Float32bits(real(test)))",
+				"buf[offset + 1] = byte(math.Float32bits(real(test)) >> 8)",
+				"buf[offset + 2] = byte(math.Float32bits(real(test)) >> 16)",
+				"buf[offset + 3] = byte(math.Float32bits(real(test)) >> 24)",
+				"offset += 4",
+				"buf[offset] = byte(math.Float32bits(imag(test)))",
+				"buf[offset + 1] = byte(math.Float32bits(imag(test)) >> 8)",
+				"buf[offset + 2] = byte(math.Float32bits(imag(test)) >> 16)",
+				"buf[offset + 3] = byte(math.Float32bits(imag(test)) >> 24)",
+				"offset += 4",
 				"",
 			},
-			wantHeaderExpr: []string{
-				"buf := make([]byte, 8)",
-				"var size uint16",
+			t: types.Typ[types.Complex64],
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriter(nil)
+			e.WriteField("test", c.t)
+			lines := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, lines); diff != "" {
+				t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+		})
+	}
+}
+
+func TestReadField_Floats(t *testing.T) {
+	cases := []struct {
+		name string
+		want []string
+		t    types.Type
+	}{
+		{
+			name: "float32",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:4]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 4",
+				"test = math.Float32frombits(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))",
 				"",
 			},
-			t: types.NewSlice(types.NewSlice(types.Typ[types.Int16])),
+			t: types.Typ[types.Float32],
 		},
 		{
-			name: "[]string",
+			name: "float64",
 			want: []string{
-				"r.Read(buf[:2])",
-				"size = uint16(buf[0]) | (uint16(buf[1]) << 8)",
-				"test = make([]string, size)",
-				"si := int(size)",
-				"for i := 0; i < si; i++ {",
-				"r.Read(buf[:2])",
-				"size = uint16(buf[0]) | (uint16(buf[1]) << 8)",
-				"strBuf_0 := make([]byte, size)",
-				"r.Read(strBuf_0)",
-				"test[i] = *(*string)(unsafe.Pointer(&strBuf_0))",
+				"if nr, err := io.ReadFull(r, buf[:8]); err != nil {",
+				"return n + int64(nr), err",
 				"}",
+				"n += 8",
+				"test = math.Float64frombits(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))",
 				"",
 			},
-			wantHeaderExpr: []string{
-				"buf := make([]byte, 8)",
-				"var size uint16",
+			t: types.Typ[types.Float64],
+		},
+		{
+			name: "complex64",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:4]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 4",
+				"test = complex(math.Float32frombits(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)), 0)",
+				"if nr, err := io.ReadFull(r, buf[:4]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 4",
+				"test = complex(real(test), math.Float32frombits(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)))",
 				"",
 			},
-			t: types.NewSlice(types.Typ[types.String]),
+			t: types.Typ[types.Complex64],
 		},
 	}
 
@@ -674,360 +1034,2654 @@ func TestReadField_Slice(t *testing.T) {
 			if diff := cmp.Diff(c.want, lines); diff != "" {
 				t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
 			}
-			sizeLines := splitLinesTrim(t, e.HeaderExpr())
-			if diff := cmp.Diff(c.wantHeaderExpr, sizeLines); diff != "" {
-				t.Errorf("e.HeaderExpr(): (-want, +got):\n%s", diff)
-			}
 		})
 	}
 }
 
-func TestWriteField_Array(t *testing.T) {
+func TestWriteField_LenSize(t *testing.T) {
 	cases := []struct {
 		name         string
+		lenSize      int
 		want         []string
 		wantSizeExpr []string
-		t            types.Type
 	}{
 		{
-			name: "[10]int16",
+			name:    "1",
+			lenSize: 1,
 			want: []string{
-				"for i1 := 0; i1 < 10; i1++ {",
-				"buf[offset] = byte(uint16(test[i1]))",
-				"buf[offset + 1] = byte(uint16(test[i1]) >> 8)",
-				"offset += 2",
+				"if uint64(len(test)) > math.MaxUint8 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 1-byte prefix", len(test))`,
+				"}",
+				"buf[offset] = byte(len(test))",
+				"offset += 1",
+				"for _, v := range test {",
+				"if v {",
+				"buf[offset] = byte(0x01)",
+				"} else {",
+				"buf[offset] = byte(0x00)",
+				"}",
+				"offset += 1",
 				"}",
 				"",
 			},
 			wantSizeExpr: []string{
-				"size := 20",
+				"size := 1",
+				"size += 1 * len(test)",
 				"",
 			},
-			t: types.NewArray(types.Typ[types.Int16], 10),
 		},
 		{
-			name: "[8][8]int16",
+			name:    "4",
+			lenSize: 4,
 			want: []string{
-				"for i1 := 0; i1 < 8; i1++ {",
-				"for i2 := 0; i2 < 8; i2++ {",
-				"buf[offset] = byte(uint16(test[i1][i2]))",
-				"buf[offset + 1] = byte(uint16(test[i1][i2]) >> 8)",
-				"offset += 2",
+				"if uint64(len(test)) > math.MaxUint32 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 4-byte prefix", len(test))`,
+				"}",
+				"buf[offset] = byte(len(test))",
+				"buf[offset + 1] = byte(len(test) >> 8)",
+				"buf[offset + 2] = byte(len(test) >> 16)",
+				"buf[offset + 3] = byte(len(test) >> 24)",
+				"offset += 4",
+				"for _, v := range test {",
+				"if v {",
+				"buf[offset] = byte(0x01)",
+				"} else {",
+				"buf[offset] = byte(0x00)",
 				"}",
+				"offset += 1",
 				"}",
 				"",
 			},
 			wantSizeExpr: []string{
-				"size := 128",
+				"size := 4",
+				"size += 1 * len(test)",
 				"",
 			},
-			t: types.NewArray(types.NewArray(types.Typ[types.Int16], 8), 8),
 		},
 		{
-			name: "[16]string",
+			name:    "8",
+			lenSize: 8,
 			want: []string{
-				"for i1 := 0; i1 < 16; i1++ {",
-				"buf[offset] = byte(len(test[i1]))",
-				"buf[offset + 1] = byte(len(test[i1]) >> 8)",
-				"offset += 2",
-				"copy(buf[offset:], test[i1])",
-				"offset += len(test[i1])",
+				"buf[offset] = byte(len(test))",
+				"buf[offset + 1] = byte(len(test) >> 8)",
+				"buf[offset + 2] = byte(len(test) >> 16)",
+				"buf[offset + 3] = byte(len(test) >> 24)",
+				"buf[offset + 4] = byte(len(test) >> 32)",
+				"buf[offset + 5] = byte(len(test) >> 40)",
+				"buf[offset + 6] = byte(len(test) >> 48)",
+				"buf[offset + 7] = byte(len(test) >> 56)",
+				"offset += 8",
+				"for _, v := range test {",
+				"if v {",
+				"buf[offset] = byte(0x01)",
+				"} else {",
+				"buf[offset] = byte(0x00)",
+				"}",
+				"offset += 1",
 				"}",
 				"",
 			},
 			wantSizeExpr: []string{
-				"size := 0",
-				"for i1 := 0; i1 < 16; i1++ {",
-				"size += 2",
-				"size += len(test[i1])",
+				"size := 8",
+				"size += 1 * len(test)",
+				"",
+			},
+		},
+		{
+			name:    "uvarint",
+			lenSize: encoder.LenUvarint,
+			want: []string{
+				"offset += binary.PutUvarint(buf[offset:], uint64(len(test)))",
+				"for _, v := range test {",
+				"if v {",
+				"buf[offset] = byte(0x01)",
+				"} else {",
+				"buf[offset] = byte(0x00)",
+				"}",
+				"offset += 1",
 				"}",
 				"",
 			},
-			t: types.NewArray(types.Typ[types.String], 16),
+			wantSizeExpr: []string{
+				"size := 0",
+				"size += (bits.Len64(uint64(len(test))|1)+6)/7 + 1 * len(test)",
+				"",
+			},
 		},
 	}
 
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			e := encoder.NewWriter(nil)
-			e.WriteField("test", c.t)
+			typ := types.NewSlice(types.Typ[types.Bool])
+			e := encoder.NewWriterOptions(nil, encoder.Options{LenSize: c.lenSize})
+			e.WriteField("test", typ)
 			lines := parseOutput(t, e)
 			if diff := cmp.Diff(c.want, lines); diff != "" {
-				t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+				t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", typ.String(), diff)
 			}
-			sizeLines := splitLinesTrim(t, e.SizeExpr())
-			if diff := cmp.Diff(c.wantSizeExpr, sizeLines); diff != "" {
+			hdrLines := splitLinesTrim(t, e.SizeExpr())
+			if diff := cmp.Diff(c.wantSizeExpr, hdrLines); diff != "" {
 				t.Errorf("e.SizeExpr(): (-want, +got):\n%s", diff)
 			}
 		})
 	}
 }
-func TestWriteField_Slice(t *testing.T) {
+
+func TestReadField_LenSize(t *testing.T) {
 	cases := []struct {
-		name         string
-		want         []string
-		wantSizeExpr []string
-		t            types.Type
+		name           string
+		lenSize        int
+		want           []string
+		wantHeaderExpr []string
 	}{
 		{
-			name: "[]int16",
+			name:    "1",
+			lenSize: 1,
 			want: []string{
-				"buf[offset] = byte(len(test))",
-				"buf[offset + 1] = byte(len(test) >> 8)",
-				"offset += 2",
-				"for _, v := range test {",
-				"buf[offset] = byte(uint16(v))",
-				"buf[offset + 1] = byte(uint16(v) >> 8)",
-				"offset += 2",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"size = int(uint8(buf[0]))",
+				"test = make([]bool, size)",
+				"si := size",
+				"for i := 0; i < si; i++ {",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
+				"test[i] = true",
+				"} else {",
+				"test[i] = false",
+				"}",
 				"}",
 				"",
 			},
-			wantSizeExpr: []string{
-				"size := 2",
-				"size += 2 * len(test)",
+			wantHeaderExpr: []string{
+				"r := d.Reader()",
+				"buf := d.Buffer()",
+				"var size int",
 				"",
 			},
-			t: types.NewSlice(types.Typ[types.Int16]),
 		},
 		{
-			name: "[][]int16",
+			name:    "4",
+			lenSize: 4,
 			want: []string{
-				"buf[offset] = byte(len(test))",
-				"buf[offset + 1] = byte(len(test) >> 8)",
-				"offset += 2",
-				"for _, v := range test {",
-				"buf[offset] = byte(len(v))",
-				"buf[offset + 1] = byte(len(v) >> 8)",
-				"offset += 2",
-				"for _, v1 := range v {",
-				"buf[offset] = byte(uint16(v1))",
-				"buf[offset + 1] = byte(uint16(v1) >> 8)",
-				"offset += 2",
+				"if nr, err := io.ReadFull(r, buf[:4]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 4",
+				"if x := uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24); uint64(x) > math.MaxInt {",
+				`return n, fmt.Errorf("binenc: length %d overflows int", x)`,
+				"} else {",
+				"size = int(x)",
+				"}",
+				"test = make([]bool, size)",
+				"si := size",
+				"for i := 0; i < si; i++ {",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
+				"test[i] = true",
+				"} else {",
+				"test[i] = false",
 				"}",
 				"}",
 				"",
 			},
-			wantSizeExpr: []string{
-				"size := 2",
-				"for _, v := range test {",
-				"size += 2",
-				"size += 2 * len(v)",
-				"}",
+			wantHeaderExpr: []string{
+				"r := d.Reader()",
+				"buf := d.Buffer()",
+				"var size int",
 				"",
 			},
-			t: types.NewSlice(types.NewSlice(types.Typ[types.Int16])),
 		},
 		{
-			name: "[]string",
+			name:    "8",
+			lenSize: 8,
 			want: []string{
-				"buf[offset] = byte(len(test))",
-				"buf[offset + 1] = byte(len(test) >> 8)",
-				"offset += 2",
-				"for _, v := range test {",
-				"buf[offset] = byte(len(v))",
-				"buf[offset + 1] = byte(len(v) >> 8)",
-				"offset += 2",
-				"copy(buf[offset:], v)",
-				"offset += len(v)",
+				"if nr, err := io.ReadFull(r, buf[:8]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 8",
+				"if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {",
+				`return n, fmt.Errorf("binenc: length %d overflows int", x)`,
+				"} else {",
+				"size = int(x)",
+				"}",
+				"test = make([]bool, size)",
+				"si := size",
+				"for i := 0; i < si; i++ {",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
+				"test[i] = true",
+				"} else {",
+				"test[i] = false",
+				"}",
 				"}",
 				"",
 			},
-			wantSizeExpr: []string{
-				"size := 2",
-				"for _, v := range test {",
-				"size += 2",
-				"size += len(v)",
+			wantHeaderExpr: []string{
+				"r := d.Reader()",
+				"buf := d.Buffer()",
+				"var size int",
+				"",
+			},
+		},
+		{
+			name:    "uvarint",
+			lenSize: encoder.LenUvarint,
+			want: []string{
+				"usize = 0",
+				"for shift := 0; ; shift += 7 {",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if shift == 63 && buf[0] > 1 {",
+				`return n, fmt.Errorf("binenc: length overflows 64 bits")`,
+				"}",
+				"usize |= uint64(buf[0]&0x7f) << shift",
+				"if buf[0] < 0x80 {",
+				"break",
+				"}",
+				"}",
+				"if usize > math.MaxInt {",
+				`return n, fmt.Errorf("binenc: length %d overflows int", usize)`,
+				"}",
+				"size = int(usize)",
+				"test = make([]bool, size)",
+				"si := size",
+				"for i := 0; i < si; i++ {",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
+				"test[i] = true",
+				"} else {",
+				"test[i] = false",
+				"}",
 				"}",
 				"",
 			},
-			t: types.NewSlice(types.Typ[types.String]),
+			wantHeaderExpr: []string{
+				"r := d.Reader()",
+				"buf := d.Buffer()",
+				"var size int",
+				"var usize uint64",
+				"",
+			},
 		},
 	}
 
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			e := encoder.NewWriter(nil)
-			e.WriteField("test", c.t)
+			typ := types.NewSlice(types.Typ[types.Bool])
+			e := encoder.NewWriterOptions(nil, encoder.Options{LenSize: c.lenSize})
+			e.ReadField("test", typ)
 			lines := parseOutput(t, e)
 			if diff := cmp.Diff(c.want, lines); diff != "" {
-				t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+				t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", typ.String(), diff)
 			}
-			sizeLines := splitLinesTrim(t, e.SizeExpr())
-			if diff := cmp.Diff(c.wantSizeExpr, sizeLines); diff != "" {
-				t.Errorf("e.SizeExpr(): (-want, +got):\n%s", diff)
+			hdrLines := splitLinesTrim(t, e.HeaderExpr())
+			if diff := cmp.Diff(c.wantHeaderExpr, hdrLines); diff != "" {
+				t.Errorf("e.HeaderExpr(): (-want, +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestReadField_Boolean(t *testing.T) {
+func TestWriteField_LenTag(t *testing.T) {
+	fields := []*types.Var{
+		types.NewField(token.NoPos, nil, "A", types.NewSlice(types.Typ[types.String]), false),
+		types.NewField(token.NoPos, nil, "B", types.Typ[types.String], false),
+	}
+	st := types.NewStruct(fields, []string{`json:"a" binenc:"len=1"`, ""})
 	e := encoder.NewWriter(nil)
-	e.ReadField("test", types.Typ[types.Bool])
+	e.WriteField("test", st)
 	got := parseOutput(t, e)
 	want := []string{
-		"r.Read(buf[:1])",
-		"if buf[0] == byte(0x01) {",
-		"test = true",
-		"} else {",
-		"test = false",
+		"if uint64(len(test.A)) > math.MaxUint8 {",
+		`return 0, fmt.Errorf("binenc: length %d overflows 1-byte prefix", len(test.A))`,
+		"}",
+		"buf[offset] = byte(len(test.A))",
+		"offset += 1",
+		"for _, v := range test.A {",
+		"if uint64(len(v)) > math.MaxUint8 {",
+		`return 0, fmt.Errorf("binenc: length %d overflows 1-byte prefix", len(v))`,
+		"}",
+		"buf[offset] = byte(len(v))",
+		"offset += 1",
+		"copy(buf[offset:], v)",
+		"offset += len(v)",
+		"}",
+		"if uint64(len(test.B)) > math.MaxUint16 {",
+		`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test.B))`,
 		"}",
+		"buf[offset] = byte(len(test.B))",
+		"buf[offset + 1] = byte(len(test.B) >> 8)",
+		"offset += 2",
+		"copy(buf[offset:], test.B)",
+		"offset += len(test.B)",
 		"",
 	}
 	if diff := cmp.Diff(want, got); diff != "" {
-		t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", types.Typ[types.Bool].String(), diff)
+		t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", st.String(), diff)
 	}
 }
 
-func TestWriteField_Boolean(t *testing.T) {
-	e := encoder.NewWriter(nil)
-	e.WriteField("test", types.Typ[types.Bool])
-	got := parseOutput(t, e)
-	want := []string{
-		"if test {",
-		"buf[offset] = byte(0x01)",
-		"} else {",
-		"buf[offset] = byte(0x00)",
-		"}",
-		"",
+func TestWriteField_Varint(t *testing.T) {
+	cases := []struct {
+		name         string
+		want         []string
+		wantSizeExpr []string
+		t            types.Type
+	}{
+		{
+			name: "uint32",
+			want: []string{
+				"offset += binary.PutUvarint(buf[offset:], uint64(test))",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 0",
+				"size += (bits.Len64(uint64(test)|1)+6)/7",
+				"",
+			},
+			t: types.Typ[types.Uint32],
+		},
+		{
+			name: "[]int",
+			want: []string{
+				"offset += binary.PutUvarint(buf[offset:], uint64(len(test)))",
+				"for _, v := range test {",
+				"offset += binary.PutVarint(buf[offset:], int64(v))",
+				"}",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 0",
+				"size += (bits.Len64(uint64(len(test))|1)+6)/7",
+				"for _, v := range test {",
+				"size += (bits.Len64(uint64(v)<<1^uint64(int64(v)>>63)|1)+6)/7",
+				"}",
+				"",
+			},
+			t: types.NewSlice(types.Typ[types.Int]),
+		},
+		{
+			name: "int8",
+			want: []string{
+				"buf[offset] = byte(uint8(test))",
+				"offset += 1",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 1",
+				"",
+			},
+			t: types.Typ[types.Int8],
+		},
+		{
+			name: "tags",
+			want: []string{
+				"buf[offset] = byte(test.A)",
+				"buf[offset + 1] = byte(test.A >> 8)",
+				"offset += 2",
+				"if uint64(test.B) > math.MaxUint8 {",
+				`return 0, fmt.Errorf("binenc: %d overflows 1 bytes", test.B)`,
+				"}",
+				"buf[offset] = byte(test.B)",
+				"offset += 1",
+				"offset += binary.PutUvarint(buf[offset:], uint64(test.C))",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 3",
+				"size += (bits.Len64(uint64(test.C)|1)+6)/7",
+				"",
+			},
+			t: types.NewStruct([]*types.Var{
+				types.NewField(token.NoPos, nil, "A", types.Typ[types.Uint16], false),
+				types.NewField(token.NoPos, nil, "B", types.Typ[types.Uint16], false),
+				types.NewField(token.NoPos, nil, "C", types.Typ[types.Uint16], false),
+			}, []string{`binenc:"enc=fixed"`, `binenc:"width=1"`, ""}),
+		},
 	}
-	if diff := cmp.Diff(want, got); diff != "" {
-		t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", types.Typ[types.Bool].String(), diff)
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriterOptions(nil, encoder.Options{LenSize: encoder.LenUvarint, Encoding: encoder.Varint})
+			e.WriteField("test", c.t)
+			got := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+			gotSizeExpr := splitLinesTrim(t, e.SizeExpr())
+			if diff := cmp.Diff(c.wantSizeExpr, gotSizeExpr); diff != "" {
+				t.Errorf("e.SizeExpr(): (-want, +got):\n%s", diff)
+			}
+		})
 	}
 }
 
-func TestReadField_String(t *testing.T) {
-	e := encoder.NewWriter(nil)
-	e.ReadField("test", types.Typ[types.String])
-	got := parseOutput(t, e)
-	want := []string{
-		"r.Read(buf[:2])",
-		"size = uint16(buf[0]) | (uint16(buf[1]) << 8)",
-		"strBuf_0 := make([]byte, size)",
-		"r.Read(strBuf_0)",
-		"test = *(*string)(unsafe.Pointer(&strBuf_0))",
-		"",
-	}
-	if diff := cmp.Diff(want, got); diff != "" {
-		t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", types.Typ[types.String].String(), diff)
+func TestReadField_Varint(t *testing.T) {
+	uvarint := []string{
+		"usize = 0",
+		"for shift := 0; ; shift += 7 {",
+		"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += 1",
+		"if shift == 63 && buf[0] > 1 {",
+		`return n, fmt.Errorf("binenc: varint overflows 64 bits")`,
+		"}",
+		"usize |= uint64(buf[0]&0x7f) << shift",
+		"if buf[0] < 0x80 {",
+		"break",
+		"}",
+		"}",
+	}
+	cases := []struct {
+		name string
+		want []string
+		t    types.Type
+	}{
+		{
+			name: "uint64",
+			want: append(uvarint[:len(uvarint):len(uvarint)],
+				"test = usize",
+				"",
+			),
+			t: types.Typ[types.Uint64],
+		},
+		{
+			name: "int16",
+			want: append(uvarint[:len(uvarint):len(uvarint)],
+				"if x := int64(usize>>1) ^ -int64(usize&1); int64(int16(x)) != x {",
+				`return n, fmt.Errorf("binenc: %d overflows int16", x)`,
+				"} else {",
+				"test = int16(x)",
+				"}",
+				"",
+			),
+			t: types.Typ[types.Int16],
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriterOptions(nil, encoder.Options{Encoding: encoder.Varint})
+			e.ReadField("test", c.t)
+			got := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+			wantHeaderExpr := []string{"r := d.Reader()", "buf := d.Buffer()", "var usize uint64", ""}
+			if diff := cmp.Diff(wantHeaderExpr, splitLinesTrim(t, e.HeaderExpr())); diff != "" {
+				t.Errorf("e.HeaderExpr(): (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseLenSize(t *testing.T) {
+	for s, want := range map[string]int{"1": 1, "2": 2, "4": 4, "8": 8, "uvarint": encoder.LenUvarint} {
+		got, err := encoder.ParseLenSize(s)
+		if err != nil || got != want {
+			t.Errorf("encoder.ParseLenSize(%q) = %d, %v; want %d, nil", s, got, err, want)
+		}
+	}
+	for _, s := range []string{"", "0", "3", "16", "varint"} {
+		if _, err := encoder.ParseLenSize(s); err == nil {
+			t.Errorf("encoder.ParseLenSize(%q): expected error", s)
+		}
+	}
+}
+
+func TestWriteField_BigEndian(t *testing.T) {
+	cases := []struct {
+		name string
+		want []string
+		t    types.Type
+	}{
+		{
+			name: "uint32",
+			want: []string{
+				"buf[offset] = byte(test >> 24)",
+				"buf[offset + 1] = byte(test >> 16)",
+				"buf[offset + 2] = byte(test >> 8)",
+				"buf[offset + 3] = byte(test)",
+				"offset += 4",
+				"",
+			},
+			t: types.Typ[types.Uint32],
+		},
+		{
+			name: "int16",
+			want: []string{
+				"buf[offset] = byte(uint16(test) >> 8)",
+				"buf[offset + 1] = byte(uint16(test))",
+				"offset += 2",
+				"",
+			},
+			t: types.Typ[types.Int16],
+		},
+		{
+			name: "float32",
+			want: []string{
+				"buf[offset] = byte(math.Float32bits(test) >> 24)",
+				"buf[offset + 1] = byte(math.Float32bits(test) >> 16)",
+				"buf[offset + 2] = byte(math.Float32bits(test) >> 8)",
+				"buf[offset + 3] = byte(math.Float32bits(test))",
+				"offset += 4",
+				"",
+			},
+			t: types.Typ[types.Float32],
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriterOptions(nil, encoder.Options{Endian: encoder.BigEndian})
+			e.WriteField("test", c.t)
+			lines := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, lines); diff != "" {
+				t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+		})
+	}
+}
+
+func TestReadField_BigEndian(t *testing.T) {
+	cases := []struct {
+		name string
+		want []string
+		t    types.Type
+	}{
+		{
+			name: "uint32",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:4]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 4",
+				"test = (uint32(buf[0]) << 24) | (uint32(buf[1]) << 16) | (uint32(buf[2]) << 8) | uint32(buf[3])",
+				"",
+			},
+			t: types.Typ[types.Uint32],
+		},
+		{
+			name: "int16",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
+				"test = int16((uint16(buf[0]) << 8) | uint16(buf[1]))",
+				"",
+			},
+			t: types.Typ[types.Int16],
+		},
+		{
+			name: "float32",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:4]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 4",
+				"test = math.Float32frombits((uint32(buf[0]) << 24) | (uint32(buf[1]) << 16) | (uint32(buf[2]) << 8) | uint32(buf[3]))",
+				"",
+			},
+			t: types.Typ[types.Float32],
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriterOptions(nil, encoder.Options{Endian: encoder.BigEndian})
+			e.ReadField("test", c.t)
+			lines := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, lines); diff != "" {
+				t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+		})
+	}
+}
+
+func TestWriteField_TypeEndian(t *testing.T) {
+	pkg := types.NewPackage("example.com/test", "test")
+	obj := types.NewTypeName(token.NoPos, pkg, "Big", nil)
+	big := types.NewNamed(obj, types.Typ[types.Uint16], nil)
+	st := types.NewStruct([]*types.Var{
+		types.NewField(token.NoPos, pkg, "A", big, false),
+		types.NewField(token.NoPos, pkg, "B", types.Typ[types.Uint16], false),
+	}, nil)
+	e := encoder.NewWriterOptions(pkg, encoder.Options{
+		TypeEndian: map[*types.TypeName]encoder.Endian{obj: encoder.BigEndian},
+	})
+	e.WriteField("test", st)
+	got := parseOutput(t, e)
+	want := []string{
+		"buf[offset] = byte(test.A >> 8)",
+		"buf[offset + 1] = byte(test.A)",
+		"offset += 2",
+		"buf[offset] = byte(test.B)",
+		"buf[offset + 1] = byte(test.B >> 8)",
+		"offset += 2",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", st.String(), diff)
+	}
+}
+
+func TestWriteField_FieldTags(t *testing.T) {
+	fields := []*types.Var{
+		types.NewField(token.NoPos, nil, "A", types.Typ[types.Uint32], false),
+		types.NewField(token.NoPos, nil, "B", types.Typ[types.Bool], false),
+		types.NewField(token.NoPos, nil, "C", types.NewSignature(nil, nil, nil, false), false),
+	}
+	st := types.NewStruct(fields, []string{`binenc:"order=1,width=2,endian=big"`, `binenc:"order=0"`, `binenc:"-"`})
+	e := encoder.NewWriter(nil)
+	e.WriteField("test", st)
+	got := parseOutput(t, e)
+	want := []string{
+		"if test.B {",
+		"buf[offset] = byte(0x01)",
+		"} else {",
+		"buf[offset] = byte(0x00)",
+		"}",
+		"offset += 1",
+		"if uint64(test.A) > math.MaxUint16 {",
+		`return 0, fmt.Errorf("binenc: %d overflows 2 bytes", test.A)`,
+		"}",
+		"buf[offset] = byte(test.A >> 8)",
+		"buf[offset + 1] = byte(test.A)",
+		"offset += 2",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", st.String(), diff)
+	}
+	if errs := e.Errors(); len(errs) != 0 {
+		t.Errorf("e.Errors() = %v; want none", errs)
+	}
+}
+
+func TestReadField_FieldTags(t *testing.T) {
+	fields := []*types.Var{
+		types.NewField(token.NoPos, nil, "A", types.Typ[types.Int8], false),
+		types.NewField(token.NoPos, nil, "B", types.Typ[types.Int64], false),
+	}
+	st := types.NewStruct(fields, []string{`binenc:"width=2"`, `binenc:"width=1"`})
+	e := encoder.NewWriter(nil)
+	e.ReadField("test", st)
+	got := parseOutput(t, e)
+	want := []string{
+		"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += 2",
+		"if x := int16(uint16(buf[0]) | (uint16(buf[1]) << 8)); int16(int8(x)) != x {",
+		`return n, fmt.Errorf("binenc: %d overflows int8", x)`,
+		"} else {",
+		"test.A = int8(x)",
+		"}",
+		"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += 1",
+		"test.B = int64(int8(uint8(buf[0])))",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", st.String(), diff)
+	}
+}
+
+func TestFieldTagErrors(t *testing.T) {
+	cases := []struct {
+		tags []string
+		want string
+	}{
+		{[]string{`binenc:"lenn=1"`, ""}, `field A: unknown option "lenn"`},
+		{[]string{`binenc:"len"`, ""}, `field A: invalid option "len": must be key=value`},
+		{[]string{`binenc:"len=3"`, ""}, `field A: invalid length size "3": must be 1, 2, 4, 8 or uvarint`},
+		{[]string{`binenc:"len=1,len=2"`, ""}, "field A: duplicate option len"},
+		{[]string{"", `binenc:"len=1"`}, "field B: len option on int16, which has no length prefix"},
+		{[]string{`binenc:"width=3"`, ""}, `field A: invalid width "3": must be 1, 2, 4 or 8`},
+		{[]string{`binenc:"width=1"`, ""}, "field A: width option on []string, which has no integers"},
+		{[]string{`binenc:"endian=middle"`, ""}, `field A: invalid byte order "middle": must be little or big`},
+		{[]string{`binenc:"endian=big"`, ""}, "field A: endian option on []string, which has no numbers"},
+		{[]string{"", `binenc:"enc=zigzag"`}, `field B: invalid encoding "zigzag": must be fixed or varint`},
+		{[]string{"", `binenc:"enc=varint,width=2"`}, "field B: width option on varint integers"},
+		{[]string{`binenc:"order=1"`, ""}, "field B: missing order option, which is set on other fields"},
+		{[]string{`binenc:"order=1"`, `binenc:"order=1"`}, "field B: duplicate order 1, also set on field A"},
+		{[]string{`binenc:"order=-1"`, ""}, `field A: invalid order "-1": must be a non-negative integer`},
+		{[]string{`binenc:len=1`, ""}, "field A: malformed tag \"binenc:len=1\""},
+	}
+	for _, c := range cases {
+		fields := []*types.Var{
+			types.NewField(token.NoPos, nil, "A", types.NewSlice(types.Typ[types.String]), false),
+			types.NewField(token.NoPos, nil, "B", types.Typ[types.Int16], false),
+		}
+		st := types.NewStruct(fields, c.tags)
+		e := encoder.NewWriter(nil)
+		e.WriteField("test", st)
+		errs := e.Errors()
+		if len(errs) != 1 {
+			t.Errorf("tags %q: got errors %v; want %q", c.tags, errs, c.want)
+			continue
+		}
+		tagErr, ok := errs[0].(*encoder.TagError)
+		if !ok {
+			t.Errorf("tags %q: got %T; want *encoder.TagError", c.tags, errs[0])
+			continue
+		}
+		if got := tagErr.Error(); got != c.want {
+			t.Errorf("tags %q: got error %q; want %q", c.tags, got, c.want)
+		}
+	}
+}
+
+func TestReadField_Array(t *testing.T) {
+	cases := []struct {
+		name           string
+		want           []string
+		wantHeaderExpr []string
+		t              types.Type
+	}{
+		{
+			name: "[]int16",
+			want: []string{
+				"if binenc.LittleEndian {",
+				"if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&test[0])), 20)); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 20",
+				"} else {",
+				"for i := 0; i < 10; i++ {",
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
+				"test[i] = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"}",
+				"}",
+				"",
+			},
+			wantHeaderExpr: []string{
+				"r := d.Reader()",
+				"buf := d.Buffer()",
+				"",
+			},
+			t: types.NewArray(types.Typ[types.Int16], 10),
+		},
+		{
+			name: "[][]int16",
+			want: []string{
+				"for i := 0; i < 8; i++ {",
+				"if binenc.LittleEndian {",
+				"if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&test[i][0])), 16)); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 16",
+				"} else {",
+				"for i1 := 0; i1 < 8; i1++ {",
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
+				"test[i][i1] = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"}",
+				"}",
+				"}",
+				"",
+			},
+			wantHeaderExpr: []string{
+				"r := d.Reader()",
+				"buf := d.Buffer()",
+				"",
+			},
+			t: types.NewArray(types.NewArray(types.Typ[types.Int16], 8), 8),
+		},
+		{
+			name: "[]string",
+			want: []string{
+				"for i := 0; i < 16; i++ {",
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
+				"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"tmp = d.Arena(size)",
+				"if nr, err := io.ReadFull(r, tmp); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += int64(size)",
+				"test[i] = *(*string)(unsafe.Pointer(&tmp))",
+				"}",
+				"",
+			},
+			wantHeaderExpr: []string{
+				"r := d.Reader()",
+				"buf := d.Buffer()",
+				"var size int",
+				"var tmp []byte",
+				"",
+			},
+			t: types.NewArray(types.Typ[types.String], 16),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriter(nil)
+			e.ReadField("test", c.t)
+			lines := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, lines); diff != "" {
+				t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+			sizeLines := splitLinesTrim(t, e.HeaderExpr())
+			if diff := cmp.Diff(c.wantHeaderExpr, sizeLines); diff != "" {
+				t.Errorf("e.HeaderExpr(): (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+func TestReadField_Slice(t *testing.T) {
+	cases := []struct {
+		name           string
+		want           []string
+		wantHeaderExpr []string
+		t              types.Type
+	}{
+		{
+			name: "[]int16",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
+				"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"test = make([]int16, size)",
+				"if binenc.LittleEndian && size > 0 {",
+				"if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&test[0])), 2*size)); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += int64(2*size)",
+				"} else {",
+				"si := size",
+				"for i := 0; i < si; i++ {",
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
+				"test[i] = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"}",
+				"}",
+				"",
+			},
+			wantHeaderExpr: []string{
+				"r := d.Reader()",
+				"buf := d.Buffer()",
+				"var size int",
+				"",
+			},
+			t: types.NewSlice(types.Typ[types.Int16]),
+		},
+		{
+			name: "[][]int16",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
+				"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"test = make([][]int16, size)",
+				"si := size",
+				"for i := 0; i < si; i++ {",
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
+				"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"test[i] = make([]int16, size)",
+				"if binenc.LittleEndian && size > 0 {",
+				"if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&test[i][0])), 2*size)); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += int64(2*size)",
+				"} else {",
+				"si1 := size",
+				"for i1 := 0; i1 < si1; i1++ {",
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
+				"test[i][i1] = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"}",
+				"}",
+				"}",
+				"",
+			},
+			wantHeaderExpr: []string{
+				"r := d.Reader()",
+				"buf := d.Buffer()",
+				"var size int",
+				"",
+			},
+			t: types.NewSlice(types.NewSlice(types.Typ[types.Int16])),
+		},
+		{
+			name: "[]string",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
+				"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"test = make([]string, size)",
+				"si := size",
+				"for i := 0; i < si; i++ {",
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
+				"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"tmp = d.Arena(size)",
+				"if nr, err := io.ReadFull(r, tmp); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += int64(size)",
+				"test[i] = *(*string)(unsafe.Pointer(&tmp))",
+				"}",
+				"",
+			},
+			wantHeaderExpr: []string{
+				"r := d.Reader()",
+				"buf := d.Buffer()",
+				"var size int",
+				"var tmp []byte",
+				"",
+			},
+			t: types.NewSlice(types.Typ[types.String]),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriter(nil)
+			e.ReadField("test", c.t)
+			lines := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, lines); diff != "" {
+				t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+			sizeLines := splitLinesTrim(t, e.HeaderExpr())
+			if diff := cmp.Diff(c.wantHeaderExpr, sizeLines); diff != "" {
+				t.Errorf("e.HeaderExpr(): (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteField_Array(t *testing.T) {
+	cases := []struct {
+		name         string
+		want         []string
+		wantSizeExpr []string
+		t            types.Type
+	}{
+		{
+			name: "[10]int16",
+			want: []string{
+				"if binenc.LittleEndian {",
+				"copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&test[0])), 20))",
+				"offset += 20",
+				"} else {",
+				"for i1 := 0; i1 < 10; i1++ {",
+				"buf[offset] = byte(uint16(test[i1]))",
+				"buf[offset + 1] = byte(uint16(test[i1]) >> 8)",
+				"offset += 2",
+				"}",
+				"}",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 20",
+				"",
+			},
+			t: types.NewArray(types.Typ[types.Int16], 10),
+		},
+		{
+			name: "[8][8]int16",
+			want: []string{
+				"for i1 := 0; i1 < 8; i1++ {",
+				"if binenc.LittleEndian {",
+				"copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&test[i1][0])), 16))",
+				"offset += 16",
+				"} else {",
+				"for i2 := 0; i2 < 8; i2++ {",
+				"buf[offset] = byte(uint16(test[i1][i2]))",
+				"buf[offset + 1] = byte(uint16(test[i1][i2]) >> 8)",
+				"offset += 2",
+				"}",
+				"}",
+				"}",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 128",
+				"",
+			},
+			t: types.NewArray(types.NewArray(types.Typ[types.Int16], 8), 8),
+		},
+		{
+			name: "[16]string",
+			want: []string{
+				"for i1 := 0; i1 < 16; i1++ {",
+				"if uint64(len(test[i1])) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test[i1]))`,
+				"}",
+				"buf[offset] = byte(len(test[i1]))",
+				"buf[offset + 1] = byte(len(test[i1]) >> 8)",
+				"offset += 2",
+				"copy(buf[offset:], test[i1])",
+				"offset += len(test[i1])",
+				"}",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 0",
+				"for i1 := 0; i1 < 16; i1++ {",
+				"size += 2",
+				"size += len(test[i1])",
+				"}",
+				"",
+			},
+			t: types.NewArray(types.Typ[types.String], 16),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriter(nil)
+			e.WriteField("test", c.t)
+			lines := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, lines); diff != "" {
+				t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+			sizeLines := splitLinesTrim(t, e.SizeExpr())
+			if diff := cmp.Diff(c.wantSizeExpr, sizeLines); diff != "" {
+				t.Errorf("e.SizeExpr(): (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+func TestWriteField_Slice(t *testing.T) {
+	cases := []struct {
+		name         string
+		want         []string
+		wantSizeExpr []string
+		t            types.Type
+	}{
+		{
+			name: "[]int16",
+			want: []string{
+				"if uint64(len(test)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test))`,
+				"}",
+				"buf[offset] = byte(len(test))",
+				"buf[offset + 1] = byte(len(test) >> 8)",
+				"offset += 2",
+				"if binenc.LittleEndian && len(test) > 0 {",
+				"copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&test[0])), 2*len(test)))",
+				"offset += 2*len(test)",
+				"} else {",
+				"for _, v := range test {",
+				"buf[offset] = byte(uint16(v))",
+				"buf[offset + 1] = byte(uint16(v) >> 8)",
+				"offset += 2",
+				"}",
+				"}",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 2",
+				"size += 2 * len(test)",
+				"",
+			},
+			t: types.NewSlice(types.Typ[types.Int16]),
+		},
+		{
+			name: "[][]int16",
+			want: []string{
+				"if uint64(len(test)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test))`,
+				"}",
+				"buf[offset] = byte(len(test))",
+				"buf[offset + 1] = byte(len(test) >> 8)",
+				"offset += 2",
+				"for _, v := range test {",
+				"if uint64(len(v)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))`,
+				"}",
+				"buf[offset] = byte(len(v))",
+				"buf[offset + 1] = byte(len(v) >> 8)",
+				"offset += 2",
+				"if binenc.LittleEndian && len(v) > 0 {",
+				"copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&v[0])), 2*len(v)))",
+				"offset += 2*len(v)",
+				"} else {",
+				"for _, v1 := range v {",
+				"buf[offset] = byte(uint16(v1))",
+				"buf[offset + 1] = byte(uint16(v1) >> 8)",
+				"offset += 2",
+				"}",
+				"}",
+				"}",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 2",
+				"for _, v := range test {",
+				"size += 2",
+				"size += 2 * len(v)",
+				"}",
+				"",
+			},
+			t: types.NewSlice(types.NewSlice(types.Typ[types.Int16])),
+		},
+		{
+			name: "[]string",
+			want: []string{
+				"if uint64(len(test)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test))`,
+				"}",
+				"buf[offset] = byte(len(test))",
+				"buf[offset + 1] = byte(len(test) >> 8)",
+				"offset += 2",
+				"for _, v := range test {",
+				"if uint64(len(v)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))`,
+				"}",
+				"buf[offset] = byte(len(v))",
+				"buf[offset + 1] = byte(len(v) >> 8)",
+				"offset += 2",
+				"copy(buf[offset:], v)",
+				"offset += len(v)",
+				"}",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 2",
+				"for _, v := range test {",
+				"size += 2",
+				"size += len(v)",
+				"}",
+				"",
+			},
+			t: types.NewSlice(types.Typ[types.String]),
+		},
+		{
+			name: "[]struct{}",
+			want: []string{
+				"if uint64(len(test)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test))`,
+				"}",
+				"buf[offset] = byte(len(test))",
+				"buf[offset + 1] = byte(len(test) >> 8)",
+				"offset += 2",
+				"for range test {",
+				"}",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 2",
+				"",
+			},
+			t: types.NewSlice(types.NewStruct(nil, nil)),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriter(nil)
+			e.WriteField("test", c.t)
+			lines := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, lines); diff != "" {
+				t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+			sizeLines := splitLinesTrim(t, e.SizeExpr())
+			if diff := cmp.Diff(c.wantSizeExpr, sizeLines); diff != "" {
+				t.Errorf("e.SizeExpr(): (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteField_Bulk(t *testing.T) {
+	cases := []struct {
+		name         string
+		opts         encoder.Options
+		want         []string
+		wantSizeExpr []string
+		t            types.Type
+	}{
+		{
+			name: "[]byte",
+			opts: encoder.Options{LenSize: 1},
+			want: []string{
+				"if uint64(len(test)) > math.MaxUint8 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 1-byte prefix", len(test))`,
+				"}",
+				"buf[offset] = byte(len(test))",
+				"offset += 1",
+				"copy(buf[offset:], test)",
+				"offset += len(test)",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 1",
+				"size += len(test)",
+				"",
+			},
+			t: types.NewSlice(types.Typ[types.Byte]),
+		},
+		{
+			name: "[4]byte",
+			want: []string{
+				"copy(buf[offset:], test[:])",
+				"offset += 4",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 4",
+				"",
+			},
+			t: types.NewArray(types.Typ[types.Byte], 4),
+		},
+		{
+			name: "[]int8",
+			opts: encoder.Options{LenSize: 1},
+			want: []string{
+				"if uint64(len(test)) > math.MaxUint8 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 1-byte prefix", len(test))`,
+				"}",
+				"buf[offset] = byte(len(test))",
+				"offset += 1",
+				"if len(test) > 0 {",
+				"copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&test[0])), len(test)))",
+				"}",
+				"offset += len(test)",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 1",
+				"size += len(test)",
+				"",
+			},
+			t: types.NewSlice(types.Typ[types.Int8]),
+		},
+		{
+			name: "[2]float64 big endian",
+			opts: encoder.Options{Endian: encoder.BigEndian},
+			want: []string{
+				"for i1 := 0; i1 < 2; i1++ {",
+				"buf[offset] = byte(math.Float64bits(test[i1]) >> 56)",
+				"buf[offset + 1] = byte(math.Float64bits(test[i1]) >> 48)",
+				"buf[offset + 2] = byte(math.Float64bits(test[i1]) >> 40)",
+				"buf[offset + 3] = byte(math.Float64bits(test[i1]) >> 32)",
+				"buf[offset + 4] = byte(math.Float64bits(test[i1]) >> 24)",
+				"buf[offset + 5] = byte(math.Float64bits(test[i1]) >> 16)",
+				"buf[offset + 6] = byte(math.Float64bits(test[i1]) >> 8)",
+				"buf[offset + 7] = byte(math.Float64bits(test[i1]))",
+				"offset += 8",
+				"}",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 16",
+				"",
+			},
+			t: types.NewArray(types.Typ[types.Float64], 2),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriterOptions(nil, c.opts)
+			e.WriteField("test", c.t)
+			got := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+			if diff := cmp.Diff(c.wantSizeExpr, splitLinesTrim(t, e.SizeExpr())); diff != "" {
+				t.Errorf("e.SizeExpr(): (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReadField_Bulk(t *testing.T) {
+	cases := []struct {
+		name string
+		want []string
+		t    types.Type
+	}{
+		{
+			name: "[]byte",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"size = int(uint8(buf[0]))",
+				"test = make([]uint8, size)",
+				"if nr, err := io.ReadFull(r, test); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += int64(size)",
+				"",
+			},
+			t: types.NewSlice(types.Typ[types.Byte]),
+		},
+		{
+			name: "[4]byte",
+			want: []string{
+				"if nr, err := io.ReadFull(r, test[:]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 4",
+				"",
+			},
+			t: types.NewArray(types.Typ[types.Byte], 4),
+		},
+		{
+			name: "[]int8",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"size = int(uint8(buf[0]))",
+				"test = make([]int8, size)",
+				"if size > 0 {",
+				"if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&test[0])), size)); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += int64(size)",
+				"}",
+				"",
+			},
+			t: types.NewSlice(types.Typ[types.Int8]),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriterOptions(nil, encoder.Options{LenSize: 1})
+			e.ReadField("test", c.t)
+			got := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+		})
+	}
+}
+
+func TestReadField_Boolean(t *testing.T) {
+	e := encoder.NewWriter(nil)
+	e.ReadField("test", types.Typ[types.Bool])
+	got := parseOutput(t, e)
+	want := []string{
+		"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += 1",
+		"if buf[0] == byte(0x01) {",
+		"test = true",
+		"} else {",
+		"test = false",
+		"}",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", types.Typ[types.Bool].String(), diff)
+	}
+}
+
+func TestWriteField_Boolean(t *testing.T) {
+	e := encoder.NewWriter(nil)
+	e.WriteField("test", types.Typ[types.Bool])
+	got := parseOutput(t, e)
+	want := []string{
+		"if test {",
+		"buf[offset] = byte(0x01)",
+		"} else {",
+		"buf[offset] = byte(0x00)",
+		"}",
+		"offset += 1",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", types.Typ[types.Bool].String(), diff)
+	}
+}
+
+func TestReadField_String(t *testing.T) {
+	e := encoder.NewWriter(nil)
+	e.ReadField("test", types.Typ[types.String])
+	got := parseOutput(t, e)
+	want := []string{
+		"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += 2",
+		"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+		"tmp = d.Arena(size)",
+		"if nr, err := io.ReadFull(r, tmp); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += int64(size)",
+		"test = *(*string)(unsafe.Pointer(&tmp))",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", types.Typ[types.String].String(), diff)
+	}
+}
+func TestWriteField_String(t *testing.T) {
+	e := encoder.NewWriter(nil)
+	e.WriteField("test", types.Typ[types.String])
+	got := parseOutput(t, e)
+	want := []string{
+		"if uint64(len(test)) > math.MaxUint16 {",
+		`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test))`,
+		"}",
+		"buf[offset] = byte(len(test))",
+		"buf[offset + 1] = byte(len(test) >> 8)",
+		"offset += 2",
+		"copy(buf[offset:], test)",
+		"offset += len(test)",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", types.Typ[types.String].String(), diff)
+	}
+}
+
+func TestWriteField_Struct(t *testing.T) {
+	e := encoder.NewWriter(nil)
+	st := types.NewStruct(
+		[]*types.Var{
+			types.NewVar(token.NoPos, nil, "x", types.Typ[types.Uint16]),
+			types.NewVar(token.NoPos, nil, "y", types.Typ[types.Uint16]),
+		},
+		nil,
+	)
+	e.WriteField("test", st)
+	got := parseOutput(t, e)
+	want := []string{
+		"buf[offset] = byte(test.x)",
+		"buf[offset + 1] = byte(test.x >> 8)",
+		"offset += 2",
+		"buf[offset] = byte(test.y)",
+		"buf[offset + 1] = byte(test.y >> 8)",
+		"offset += 2",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", st.String(), diff)
+	}
+}
+
+func TestWriteField_StructArraysStruct(t *testing.T) {
+	// type Inner struct {
+	//	foo uint32
+	// 	arr []uint8
+	// }
+
+	innerT := types.NewStruct([]*types.Var{
+		types.NewVar(token.NoPos, nil, "foo", types.Typ[types.Uint32]),
+		types.NewVar(token.NoPos, nil, "arr", types.NewSlice(types.Typ[types.Uint32])),
+	}, nil)
+
+	//type Outer struct {
+	//	arr1   []uint8
+	//	inners []Inner
+	//	arr2   []uint8
+	//}
+
+	st := types.NewStruct([]*types.Var{
+		types.NewVar(token.NoPos, nil, "arr1", types.NewSlice(types.Typ[types.Uint8])),
+		types.NewVar(token.NoPos, nil, "inners", types.NewSlice(innerT)),
+		types.NewVar(token.NoPos, nil, "arr2", types.NewSlice(types.Typ[types.Uint8])),
+	}, nil)
+
+	e := encoder.NewWriter(nil)
+	e.WriteField("test", st)
+	got := parseOutput(t, e)
+	want := []string{
+		"if uint64(len(test.arr1)) > math.MaxUint16 {",
+		`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test.arr1))`,
+		"}",
+		"buf[offset] = byte(len(test.arr1))",
+		"buf[offset + 1] = byte(len(test.arr1) >> 8)",
+		"offset += 2",
+		"copy(buf[offset:], test.arr1)",
+		"offset += len(test.arr1)",
+		"if uint64(len(test.inners)) > math.MaxUint16 {",
+		`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test.inners))`,
+		"}",
+		"buf[offset] = byte(len(test.inners))",
+		"buf[offset + 1] = byte(len(test.inners) >> 8)",
+		"offset += 2",
+		"for _, v := range test.inners {",
+		"buf[offset] = byte(v.foo)",
+		"buf[offset + 1] = byte(v.foo >> 8)",
+		"buf[offset + 2] = byte(v.foo >> 16)",
+		"buf[offset + 3] = byte(v.foo >> 24)",
+		"offset += 4",
+		"if uint64(len(v.arr)) > math.MaxUint16 {",
+		`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.arr))`,
+		"}",
+		"buf[offset] = byte(len(v.arr))",
+		"buf[offset + 1] = byte(len(v.arr) >> 8)",
+		"offset += 2",
+		"if binenc.LittleEndian && len(v.arr) > 0 {",
+		"copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&v.arr[0])), 4*len(v.arr)))",
+		"offset += 4*len(v.arr)",
+		"} else {",
+		"for _, v1 := range v.arr {",
+		"buf[offset] = byte(v1)",
+		"buf[offset + 1] = byte(v1 >> 8)",
+		"buf[offset + 2] = byte(v1 >> 16)",
+		"buf[offset + 3] = byte(v1 >> 24)",
+		"offset += 4",
+		"}",
+		"}",
+		"}",
+		"if uint64(len(test.arr2)) > math.MaxUint16 {",
+		`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test.arr2))`,
+		"}",
+		"buf[offset] = byte(len(test.arr2))",
+		"buf[offset + 1] = byte(len(test.arr2) >> 8)",
+		"offset += 2",
+		"copy(buf[offset:], test.arr2)",
+		"offset += len(test.arr2)",
+		"",
+	}
+	wantSizeExpr := []string{
+		"size := 6",
+		"size += len(test.arr1) + len(test.arr2)",
+		"for _, v := range test.inners {",
+		"size += 6",
+		"size += 4 * len(v.arr)",
+		"}",
+		""}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", st.String(), diff)
+	}
+	if diff := cmp.Diff(wantSizeExpr, splitLinesTrim(t, e.SizeExpr())); diff != "" {
+		t.Errorf("e.SizeExpr(): (-want, +got):\n%s", diff)
+	}
+}
+
+func TestWriteField_Map(t *testing.T) {
+	// type T struct {
+	//	k string
+	//	N uint32
+	// }
+	named := types.NewNamed(types.NewTypeName(token.NoPos, nil, "T", nil), types.NewStruct([]*types.Var{
+		types.NewField(token.NoPos, nil, "k", types.Typ[types.String], false),
+		types.NewField(token.NoPos, nil, "N", types.Typ[types.Uint32], false),
+	}, nil), nil)
+	cases := []struct {
+		name         string
+		want         []string
+		wantSizeExpr []string
+		t            types.Type
+	}{
+		{
+			name: "map[uint16]uint8",
+			want: []string{
+				"if uint64(len(test)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test))`,
+				"}",
+				"buf[offset] = byte(len(test))",
+				"buf[offset + 1] = byte(len(test) >> 8)",
+				"offset += 2",
+				"for k, v := range test {",
+				"buf[offset] = byte(k)",
+				"buf[offset + 1] = byte(k >> 8)",
+				"offset += 2",
+				"buf[offset] = byte(v)",
+				"offset += 1",
+				"}",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 2",
+				"size += 3 * len(test)",
+				"",
+			},
+			t: types.NewMap(types.Typ[types.Uint16], types.Typ[types.Uint8]),
+		},
+		{
+			name: "map[string]uint32",
+			want: []string{
+				"if uint64(len(test)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test))`,
+				"}",
+				"buf[offset] = byte(len(test))",
+				"buf[offset + 1] = byte(len(test) >> 8)",
+				"offset += 2",
+				"for k, v := range test {",
+				"if uint64(len(k)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))`,
+				"}",
+				"buf[offset] = byte(len(k))",
+				"buf[offset + 1] = byte(len(k) >> 8)",
+				"offset += 2",
+				"copy(buf[offset:], k)",
+				"offset += len(k)",
+				"buf[offset] = byte(v)",
+				"buf[offset + 1] = byte(v >> 8)",
+				"buf[offset + 2] = byte(v >> 16)",
+				"buf[offset + 3] = byte(v >> 24)",
+				"offset += 4",
+				"}",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 2",
+				"for k := range test {",
+				"size += 6",
+				"size += len(k)",
+				"}",
+				"",
+			},
+			t: types.NewMap(types.Typ[types.String], types.Typ[types.Uint32]),
+		},
+		{
+			name: "map[uint8][]string",
+			want: []string{
+				"if uint64(len(test)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test))`,
+				"}",
+				"buf[offset] = byte(len(test))",
+				"buf[offset + 1] = byte(len(test) >> 8)",
+				"offset += 2",
+				"for k, v := range test {",
+				"buf[offset] = byte(k)",
+				"offset += 1",
+				"if uint64(len(v)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))`,
+				"}",
+				"buf[offset] = byte(len(v))",
+				"buf[offset + 1] = byte(len(v) >> 8)",
+				"offset += 2",
+				"for _, v1 := range v {",
+				"if uint64(len(v1)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v1))`,
+				"}",
+				"buf[offset] = byte(len(v1))",
+				"buf[offset + 1] = byte(len(v1) >> 8)",
+				"offset += 2",
+				"copy(buf[offset:], v1)",
+				"offset += len(v1)",
+				"}",
+				"}",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 2",
+				"for _, v := range test {",
+				"size += 3",
+				"for _, v1 := range v {",
+				"size += 2",
+				"size += len(v1)",
+				"}",
+				"}",
+				"",
+			},
+			t: types.NewMap(types.Typ[types.Uint8], types.NewSlice(types.Typ[types.String])),
+		},
+		{
+			name: "map[uint8]struct{}",
+			want: []string{
+				"if uint64(len(test)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test))`,
+				"}",
+				"buf[offset] = byte(len(test))",
+				"buf[offset + 1] = byte(len(test) >> 8)",
+				"offset += 2",
+				"for k := range test {",
+				"buf[offset] = byte(k)",
+				"offset += 1",
+				"}",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 2",
+				"size += 1 * len(test)",
+				"",
+			},
+			t: types.NewMap(types.Typ[types.Uint8], types.NewStruct(nil, nil)),
+		},
+		{
+			// the field k of the values does not use the key k
+			name: "map[uint32]T",
+			want: []string{
+				"if uint64(len(test)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test))`,
+				"}",
+				"buf[offset] = byte(len(test))",
+				"buf[offset + 1] = byte(len(test) >> 8)",
+				"offset += 2",
+				"for k, v := range test {",
+				"buf[offset] = byte(k)",
+				"buf[offset + 1] = byte(k >> 8)",
+				"buf[offset + 2] = byte(k >> 16)",
+				"buf[offset + 3] = byte(k >> 24)",
+				"offset += 4",
+				"if uint64(len(v.k)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.k))`,
+				"}",
+				"buf[offset] = byte(len(v.k))",
+				"buf[offset + 1] = byte(len(v.k) >> 8)",
+				"offset += 2",
+				"copy(buf[offset:], v.k)",
+				"offset += len(v.k)",
+				"buf[offset] = byte(v.N)",
+				"buf[offset + 1] = byte(v.N >> 8)",
+				"buf[offset + 2] = byte(v.N >> 16)",
+				"buf[offset + 3] = byte(v.N >> 24)",
+				"offset += 4",
+				"}",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 2",
+				"for _, v := range test {",
+				"size += 10",
+				"size += len(v.k)",
+				"}",
+				"",
+			},
+			t: types.NewMap(types.Typ[types.Uint32], named),
+		},
+		{
+			name: "map[struct{}]string",
+			want: []string{
+				"if uint64(len(test)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test))`,
+				"}",
+				"buf[offset] = byte(len(test))",
+				"buf[offset + 1] = byte(len(test) >> 8)",
+				"offset += 2",
+				"for _, v := range test {",
+				"if uint64(len(v)) > math.MaxUint16 {",
+				`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))`,
+				"}",
+				"buf[offset] = byte(len(v))",
+				"buf[offset + 1] = byte(len(v) >> 8)",
+				"offset += 2",
+				"copy(buf[offset:], v)",
+				"offset += len(v)",
+				"}",
+				"",
+			},
+			wantSizeExpr: []string{
+				"size := 2",
+				"for _, v := range test {",
+				"size += 2",
+				"size += len(v)",
+				"}",
+				"",
+			},
+			t: types.NewMap(types.NewStruct(nil, nil), types.Typ[types.String]),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriter(nil)
+			e.WriteField("test", c.t)
+			lines := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, lines); diff != "" {
+				t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+			sizeLines := splitLinesTrim(t, e.SizeExpr())
+			if diff := cmp.Diff(c.wantSizeExpr, sizeLines); diff != "" {
+				t.Errorf("e.SizeExpr(): (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReadField_Map(t *testing.T) {
+	e := encoder.NewWriter(nil)
+	mt := types.NewMap(types.Typ[types.Uint16], types.NewSlice(types.Typ[types.Uint8]))
+	e.ReadField("test", mt)
+	got := parseOutput(t, e)
+	want := []string{
+		"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += 2",
+		"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+		"test = make(map[uint16][]uint8, binenc.MapHint(size))",
+		"si := size",
+		"for i := 0; i < si; i++ {",
+		"var k uint16",
+		"var v []uint8",
+		"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += 2",
+		"k = uint16(buf[0]) | (uint16(buf[1]) << 8)",
+		"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += 2",
+		"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+		"v = make([]uint8, size)",
+		"if nr, err := io.ReadFull(r, v); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += int64(size)",
+		"test[k] = v",
+		"}",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", mt.String(), diff)
+	}
+}
+
+func TestSizeExpr_NestedDynamic(t *testing.T) {
+	e := encoder.NewWriter(nil)
+	st := types.NewSlice(types.NewSlice(types.Typ[types.String]))
+	e.WriteField("test", st)
+	want := []string{
+		"size := 2",
+		"for _, v := range test {",
+		"size += 2",
+		"for _, v1 := range v {",
+		"size += 2",
+		"size += len(v1)",
+		"}",
+		"}",
+		"",
+	}
+	if diff := cmp.Diff(want, splitLinesTrim(t, e.SizeExpr())); diff != "" {
+		t.Errorf("e.SizeExpr(): (-want, +got):\n%s", diff)
+	}
+}
+
+func TestWriteField_Recursive(t *testing.T) {
+	// type Node struct {
+	//	Val  uint8
+	//	Next *Node
+	// }
+	node := types.NewNamed(types.NewTypeName(token.NoPos, nil, "Node", nil), nil, nil)
+	node.SetUnderlying(types.NewStruct([]*types.Var{
+		types.NewVar(token.NoPos, nil, "Val", types.Typ[types.Uint8]),
+		types.NewVar(token.NoPos, nil, "Next", types.NewPointer(node)),
+	}, nil))
+
+	e := encoder.NewWriter(nil)
+	e.WriteField("test", node)
+	got := parseOutput(t, e)
+	want := []string{
+		"buf[offset] = byte(test.Val)",
+		"offset += 1",
+		"if test.Next != nil {",
+		"buf[offset] = byte(0x01)",
+		"} else {",
+		"buf[offset] = byte(0x00)",
+		"}",
+		"offset += 1",
+		"if test.Next != nil {",
+		"offset, err = (*test.Next).writeBinenc(buf, offset)",
+		"if err != nil {",
+		"return 0, err",
+		"}",
+		"}",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", node.String(), diff)
+	}
+	wantSizeExpr := []string{
+		"size := 2",
+		"if test.Next != nil {",
+		"size += (*test.Next).sizeBinenc()",
+		"}",
+		"",
+	}
+	if diff := cmp.Diff(wantSizeExpr, splitLinesTrim(t, e.SizeExpr())); diff != "" {
+		t.Errorf("e.SizeExpr(): (-want, +got):\n%s", diff)
+	}
+	if got := e.Recursive(); len(got) != 1 || got[0] != node {
+		t.Errorf("e.Recursive() = %v, want [%v]", got, node)
+	}
+}
+
+func TestWriteField_RecursiveSlice(t *testing.T) {
+	// type Node struct {
+	//	Children []Node
+	// }
+	node := types.NewNamed(types.NewTypeName(token.NoPos, nil, "Node", nil), nil, nil)
+	node.SetUnderlying(types.NewStruct([]*types.Var{
+		types.NewVar(token.NoPos, nil, "Children", types.NewSlice(node)),
+	}, nil))
+
+	e := encoder.NewWriter(nil)
+	e.WriteField("test", node)
+	got := parseOutput(t, e)
+	// elements are indexed rather than copied into a loop variable
+	want := []string{
+		"if uint64(len(test.Children)) > math.MaxUint16 {",
+		`return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(test.Children))`,
+		"}",
+		"buf[offset] = byte(len(test.Children))",
+		"buf[offset + 1] = byte(len(test.Children) >> 8)",
+		"offset += 2",
+		"for i1 := range test.Children {",
+		"offset, err = test.Children[i1].writeBinenc(buf, offset)",
+		"if err != nil {",
+		"return 0, err",
+		"}",
+		"}",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", node.String(), diff)
+	}
+	wantSizeExpr := []string{
+		"size := 2",
+		"for i1 := range test.Children {",
+		"size += test.Children[i1].sizeBinenc()",
+		"}",
+		"",
+	}
+	if diff := cmp.Diff(wantSizeExpr, splitLinesTrim(t, e.SizeExpr())); diff != "" {
+		t.Errorf("e.SizeExpr(): (-want, +got):\n%s", diff)
+	}
+}
+
+func TestReadField_Recursive(t *testing.T) {
+	// type Node struct {
+	//	Children []Node
+	// }
+	node := types.NewNamed(types.NewTypeName(token.NoPos, nil, "Node", nil), nil, nil)
+	node.SetUnderlying(types.NewStruct([]*types.Var{
+		types.NewVar(token.NoPos, nil, "Children", types.NewSlice(node)),
+	}, nil))
+
+	e := encoder.NewWriter(nil)
+	e.ReadField("test", node)
+	got := parseOutput(t, e)
+	want := []string{
+		"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += 2",
+		"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+		"test.Children = make([]Node, size)",
+		"si := size",
+		"for i := 0; i < si; i++ {",
+		"if n, err = test.Children[i].readBinenc(d, n); err != nil {",
+		"return n, err",
+		"}",
+		"}",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", node.String(), diff)
+	}
+	wantHeaderExpr := []string{"r := d.Reader()", "buf := d.Buffer()", "var size int", ""}
+	if diff := cmp.Diff(wantHeaderExpr, splitLinesTrim(t, e.HeaderExpr())); diff != "" {
+		t.Errorf("e.HeaderExpr(): (-want, +got):\n%s", diff)
+	}
+}
+
+func TestWriteField_ErrorResult(t *testing.T) {
+	e := encoder.NewWriterOptions(nil, encoder.Options{WordSize: 4})
+	e.ErrorResult("nil")
+	e.WriteField("test", types.Typ[types.Int])
+	got := parseOutput(t, e)
+	want := []string{
+		"if test < math.MinInt32 || test > math.MaxInt32 {",
+		"return nil, fmt.Errorf(\"binenc: %d overflows 4 bytes\", test)",
+		"}",
+		"buf[offset] = byte(uint32(test))",
+		"buf[offset + 1] = byte(uint32(test) >> 8)",
+		"buf[offset + 2] = byte(uint32(test) >> 16)",
+		"buf[offset + 3] = byte(uint32(test) >> 24)",
+		"offset += 4",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", "int", diff)
+	}
+}
+
+func TestReadField_FromBytes(t *testing.T) {
+	// type Node struct {
+	//	Children []Node
+	// }
+	node := types.NewNamed(types.NewTypeName(token.NoPos, nil, "Node", nil), nil, nil)
+	node.SetUnderlying(types.NewStruct([]*types.Var{
+		types.NewVar(token.NoPos, nil, "Children", types.NewSlice(node)),
+	}, nil))
+
+	cases := []struct {
+		name string
+		want []string
+		t    types.Type
+	}{
+		{
+			name: "uint16",
+			want: []string{
+				"if len(data)-offset < 2 {",
+				"return offset, io.ErrUnexpectedEOF",
+				"}",
+				"buf = data[offset : offset+2]",
+				"offset += 2",
+				"test = uint16(buf[0]) | (uint16(buf[1]) << 8)",
+				"",
+			},
+			t: types.Typ[types.Uint16],
+		},
+		{
+			name: "[]byte",
+			want: []string{
+				"if len(data)-offset < 2 {",
+				"return offset, io.ErrUnexpectedEOF",
+				"}",
+				"buf = data[offset : offset+2]",
+				"offset += 2",
+				"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"test = make([]uint8, size)",
+				"if len(data)-offset < size {",
+				"return offset, io.ErrUnexpectedEOF",
+				"}",
+				"offset += copy(test, data[offset:])",
+				"",
+			},
+			t: types.NewSlice(types.Typ[types.Byte]),
+		},
+		{
+			name: "recursive",
+			want: []string{
+				"if len(data)-offset < 2 {",
+				"return offset, io.ErrUnexpectedEOF",
+				"}",
+				"buf = data[offset : offset+2]",
+				"offset += 2",
+				"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"test.Children = make([]Node, size)",
+				"si := size",
+				"for i := 0; i < si; i++ {",
+				"if offset, err = test.Children[i].decodeBinenc(data, offset); err != nil {",
+				"return offset, err",
+				"}",
+				"}",
+				"",
+			},
+			t: node,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriter(nil)
+			e.FromBytes()
+			e.ReadField("test", c.t)
+			got := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+			if header := e.HeaderExpr(); !strings.Contains(header, "var buf []byte") {
+				t.Errorf("e.HeaderExpr() = %q, want a buf aliasing data", header)
+			}
+		})
 	}
 }
-func TestWriteField_String(t *testing.T) {
+
+func TestReadField_FromBytesSegments(t *testing.T) {
+	// struct {
+	//	A uint16
+	//	B *uint8
+	//	C uint32
+	// }
+	s := types.NewStruct([]*types.Var{
+		types.NewVar(token.NoPos, nil, "A", types.Typ[types.Uint16]),
+		types.NewVar(token.NoPos, nil, "B", types.NewPointer(types.Typ[types.Uint8])),
+		types.NewVar(token.NoPos, nil, "C", types.Typ[types.Uint32]),
+	}, nil)
+
 	e := encoder.NewWriter(nil)
-	e.WriteField("test", types.Typ[types.String])
+	e.FromBytes()
+	e.ReadField("test", s)
 	got := parseOutput(t, e)
+	// the bounds are checked once for A and the presence byte of B, and
+	// once more within and after the branch of B
 	want := []string{
-		"buf[offset] = byte(len(test))",
-		"buf[offset + 1] = byte(len(test) >> 8)",
+		"if len(data)-offset < 3 {",
+		"return offset, io.ErrUnexpectedEOF",
+		"}",
+		"buf = data[offset : offset+2]",
 		"offset += 2",
-		"copy(buf[offset:], test)",
-		"offset += len(test)",
+		"test.A = uint16(buf[0]) | (uint16(buf[1]) << 8)",
+		"buf = data[offset : offset+1]",
+		"offset += 1",
+		"if buf[0] == byte(0x01) {",
+		"test.B = new(uint8)",
+		"if len(data)-offset < 1 {",
+		"return offset, io.ErrUnexpectedEOF",
+		"}",
+		"buf = data[offset : offset+1]",
+		"offset += 1",
+		"*test.B = uint8(buf[0])",
+		"} else {",
+		"test.B = nil",
+		"}",
+		"if len(data)-offset < 4 {",
+		"return offset, io.ErrUnexpectedEOF",
+		"}",
+		"buf = data[offset : offset+4]",
+		"offset += 4",
+		"test.C = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)",
 		"",
 	}
 	if diff := cmp.Diff(want, got); diff != "" {
-		t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", types.Typ[types.String].String(), diff)
+		t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", s.String(), diff)
 	}
 }
 
-func TestWriteField_Struct(t *testing.T) {
-	e := encoder.NewWriter(nil)
-	st := types.NewStruct(
-		[]*types.Var{
-			types.NewVar(token.NoPos, nil, "x", types.Typ[types.Uint16]),
-			types.NewVar(token.NoPos, nil, "y", types.Typ[types.Uint16]),
+func TestReadField_ZeroCopy(t *testing.T) {
+	length := []string{
+		"if len(data)-offset < 2 {",
+		"return offset, io.ErrUnexpectedEOF",
+		"}",
+		"buf = data[offset : offset+2]",
+		"offset += 2",
+		"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+		"if len(data)-offset < size {",
+		"return offset, io.ErrUnexpectedEOF",
+		"}",
+	}
+	cases := []struct {
+		name string
+		want []string
+		t    types.Type
+	}{
+		{
+			name: "string",
+			want: append(length[:len(length):len(length)],
+				"tmp = data[offset : offset+size]",
+				"test = *(*string)(unsafe.Pointer(&tmp))",
+				"offset += size",
+				"",
+			),
+			t: types.Typ[types.String],
 		},
-		nil,
-	)
-	e.WriteField("test", st)
+		{
+			name: "[]byte",
+			want: append(length[:len(length):len(length)],
+				"test = data[offset : offset+size : offset+size]",
+				"offset += size",
+				"",
+			),
+			t: types.NewSlice(types.Typ[types.Byte]),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriterOptions(nil, encoder.Options{ZeroCopy: true})
+			e.FromBytes()
+			e.ReadField("test", c.t)
+			got := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+			if header := e.HeaderExpr(); strings.Contains(header, "strBuf") {
+				t.Errorf("e.HeaderExpr() = %q, want no string buffer", header)
+			}
+		})
+	}
+}
+
+func TestSafe(t *testing.T) {
+	// struct {
+	//	Name   string
+	//	Deltas []int16
+	//	Small  [2]int8
+	//	Raw    []byte
+	// }
+	s := types.NewStruct([]*types.Var{
+		types.NewVar(token.NoPos, nil, "Name", types.Typ[types.String]),
+		types.NewVar(token.NoPos, nil, "Deltas", types.NewSlice(types.Typ[types.Int16])),
+		types.NewVar(token.NoPos, nil, "Small", types.NewArray(types.Typ[types.Int8], 2)),
+		types.NewVar(token.NoPos, nil, "Raw", types.NewSlice(types.Typ[types.Byte])),
+	}, nil)
+	for _, fromBytes := range []bool{false, true} {
+		e := encoder.NewWriterOptions(nil, encoder.Options{Safe: true, ZeroCopy: true})
+		e.WriteField("test", s)
+		if fromBytes {
+			e = encoder.NewWriterOptions(nil, encoder.Options{Safe: true, ZeroCopy: true})
+			e.FromBytes()
+		}
+		e.ReadField("test", s)
+		out := string(e.Bytes()) + e.HeaderExpr()
+		if strings.Contains(out, "unsafe") {
+			t.Errorf("generated code uses unsafe:\n%s", out)
+		}
+		for _, path := range e.Imports() {
+			if path == "unsafe" {
+				t.Errorf("e.Imports() = %v, want no unsafe", e.Imports())
+			}
+		}
+	}
+}
+
+func TestReadField_SafeString(t *testing.T) {
+	length := []string{
+		"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += 2",
+		"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+	}
+	e := encoder.NewWriterOptions(nil, encoder.Options{Safe: true})
+	e.ReadField("test", types.Typ[types.String])
 	got := parseOutput(t, e)
-	want := []string{
-		"buf[offset] = byte(test.x)",
-		"buf[offset + 1] = byte(test.x >> 8)",
-		"offset += 2",
-		"buf[offset] = byte(test.y)",
-		"buf[offset + 1] = byte(test.y >> 8)",
-		"offset += 2",
+	want := append(length,
+		"tmp = d.Scratch(size)",
+		"if nr, err := io.ReadFull(r, tmp); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += int64(size)",
+		"test = string(tmp)",
 		"",
-	}
+	)
 	if diff := cmp.Diff(want, got); diff != "" {
-		t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", st.String(), diff)
+		t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", "string", diff)
+	}
+	wantHeaderExpr := []string{"r := d.Reader()", "buf := d.Buffer()", "var size int", "var tmp []byte", ""}
+	if diff := cmp.Diff(wantHeaderExpr, splitLinesTrim(t, e.HeaderExpr())); diff != "" {
+		t.Errorf("e.HeaderExpr(): (-want, +got):\n%s", diff)
 	}
 }
 
-func TestWriteField_StructArraysStruct(t *testing.T) {
-	// type Inner struct {
-	//	foo uint32
-	// 	arr []uint8
+func TestReadField_Limits(t *testing.T) {
+	// type Node struct {
+	//	Children []Node
 	// }
+	node := types.NewNamed(types.NewTypeName(token.NoPos, nil, "Node", nil), nil, nil)
+	node.SetUnderlying(types.NewStruct([]*types.Var{
+		types.NewVar(token.NoPos, nil, "Children", types.NewSlice(node)),
+	}, nil))
 
-	innerT := types.NewStruct([]*types.Var{
-		types.NewVar(token.NoPos, nil, "foo", types.Typ[types.Uint32]),
-		types.NewVar(token.NoPos, nil, "arr", types.NewSlice(types.Typ[types.Uint32])),
-	}, nil)
+	length := []string{
+		"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += 2",
+		"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+	}
+	cases := []struct {
+		name      string
+		want      []string
+		t         types.Type
+		fromBytes bool
+	}{
+		{
+			name: "string",
+			want: append(length[:len(length):len(length)],
+				"if opts.MaxStringLen > 0 && size > opts.MaxStringLen {",
+				`return n, &binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}`,
+				"}",
+				"if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {",
+				`return n, &binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}`,
+				"}",
+				"if size > binenc.MaxAlloc {",
+				"return n, binenc.ErrTooLarge",
+				"}",
+				"tmp = d.Scratch(size)",
+				"if nr, err := io.ReadFull(r, tmp); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += int64(size)",
+				"test = string(tmp)",
+				"",
+			),
+			t: types.Typ[types.String],
+		},
+		{
+			name: "recursive",
+			want: []string{
+				"if len(data)-offset < 2 {",
+				"return offset, opts.ShortInput(len(data))",
+				"}",
+				"buf = data[offset : offset+2]",
+				"offset += 2",
+				"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {",
+				`return offset, &binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}`,
+				"}",
+				"if len(data)-offset < size {",
+				"return offset, opts.ShortInput(len(data))",
+				"}",
+				"test.Children = make([]Node, size)",
+				"si := size",
+				"for i := 0; i < si; i++ {",
+				"if offset, err = test.Children[i].decodeBinenc(data, offset, opts, depth+1); err != nil {",
+				"return offset, err",
+				"}",
+				"}",
+				"",
+			},
+			t:         node,
+			fromBytes: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriterOptions(nil, encoder.Options{Safe: true})
+			if c.fromBytes {
+				e.FromBytes()
+			}
+			e.Limits()
+			e.Depth("depth")
+			e.ReadField("test", c.t)
+			got := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+		})
+	}
+}
 
-	//type Outer struct {
-	//	arr1   []uint8
-	//	inners []Inner
-	//	arr2   []uint8
-	//}
+func TestReadField_FieldErrors(t *testing.T) {
+	e := encoder.NewWriter(nil)
+	e.FieldErrors("Outer")
+	mt := types.NewMap(types.Typ[types.Uint16], types.NewPointer(types.Typ[types.Uint8]))
+	e.ReadField("s.Attrs", mt)
+	got := parseOutput(t, e)
+	want := []string{
+		"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+		`return n + int64(nr), binenc.FieldError(err, "Outer", n, "Attrs")`,
+		"}",
+		"n += 2",
+		"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+		"s.Attrs = make(map[uint16]*uint8, binenc.MapHint(size))",
+		"si := size",
+		"for i := 0; i < si; i++ {",
+		"var k uint16",
+		"var v *uint8",
+		// the key stands for the map, and the value for its entry
+		"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+		`return n + int64(nr), binenc.FieldError(err, "Outer", n, "Attrs")`,
+		"}",
+		"n += 2",
+		"k = uint16(buf[0]) | (uint16(buf[1]) << 8)",
+		"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+		`return n + int64(nr), binenc.FieldError(err, "Outer", n, "Attrs[%v]", k)`,
+		"}",
+		"n += 1",
+		"if buf[0] == byte(0x01) {",
+		"v = new(uint8)",
+		"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+		`return n + int64(nr), binenc.FieldError(err, "Outer", n, "Attrs[%v]", k)`,
+		"}",
+		"n += 1",
+		"*v = uint8(buf[0])",
+		"} else {",
+		"v = nil",
+		"}",
+		"s.Attrs[k] = v",
+		"}",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "s.Attrs", mt.String(), diff)
+	}
+}
+
+func TestReadField_Reuse(t *testing.T) {
+	cases := []struct {
+		name string
+		want []string
+		t    types.Type
+	}{
+		{
+			name: "slice",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
+				"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"if cap(test) < size {",
+				"test = make([]bool, size)",
+				"} else {",
+				"test = test[:size]",
+				"}",
+				"si := size",
+				"for i := 0; i < si; i++ {",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
+				"test[i] = true",
+				"} else {",
+				"test[i] = false",
+				"}",
+				"}",
+				"",
+			},
+			t: types.NewSlice(types.Typ[types.Bool]),
+		},
+		{
+			name: "pointer",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
+				"if test == nil {",
+				"test = new(uint8)",
+				"}",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"*test = uint8(buf[0])",
+				"} else {",
+				"test = nil",
+				"}",
+				"",
+			},
+			t: types.NewPointer(types.Typ[types.Uint8]),
+		},
+		{
+			name: "map",
+			want: []string{
+				"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 2",
+				"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+				"if test == nil {",
+				"test = make(map[uint8]bool, binenc.MapHint(size))",
+				"} else {",
+				"for k := range test {",
+				"delete(test, k)",
+				"}",
+				"}",
+				"si := size",
+				"for i := 0; i < si; i++ {",
+				"var k uint8",
+				"var v bool",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"k = uint8(buf[0])",
+				"if nr, err := io.ReadFull(r, buf[:1]); err != nil {",
+				"return n + int64(nr), err",
+				"}",
+				"n += 1",
+				"if buf[0] == byte(0x01) {",
+				"v = true",
+				"} else {",
+				"v = false",
+				"}",
+				"test[k] = v",
+				"}",
+				"",
+			},
+			t: types.NewMap(types.Typ[types.Uint8], types.Typ[types.Bool]),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e := encoder.NewWriterOptions(nil, encoder.Options{Reuse: true})
+			e.ReadField("test", c.t)
+			got := parseOutput(t, e)
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "test", c.t.String(), diff)
+			}
+		})
+	}
+}
 
+func TestResetField(t *testing.T) {
+	// struct {
+	//	A    int16
+	//	B    string
+	//	C    []uint32
+	//	D    *bool
+	//	E    map[string]bool
+	//	F    [2][]byte
+	//	G    [3]uint8
+	//	Skip []byte `binenc:"-"`
+	// }
 	st := types.NewStruct([]*types.Var{
-		types.NewVar(token.NoPos, nil, "arr1", types.NewSlice(types.Typ[types.Uint8])),
-		types.NewVar(token.NoPos, nil, "inners", types.NewSlice(innerT)),
-		types.NewVar(token.NoPos, nil, "arr2", types.NewSlice(types.Typ[types.Uint8])),
-	}, nil)
+		types.NewVar(token.NoPos, nil, "A", types.Typ[types.Int16]),
+		types.NewVar(token.NoPos, nil, "B", types.Typ[types.String]),
+		types.NewVar(token.NoPos, nil, "C", types.NewSlice(types.Typ[types.Uint32])),
+		types.NewVar(token.NoPos, nil, "D", types.NewPointer(types.Typ[types.Bool])),
+		types.NewVar(token.NoPos, nil, "E", types.NewMap(types.Typ[types.String], types.Typ[types.Bool])),
+		types.NewVar(token.NoPos, nil, "F", types.NewArray(types.NewSlice(types.Typ[types.Byte]), 2)),
+		types.NewVar(token.NoPos, nil, "G", types.NewArray(types.Typ[types.Uint8], 3)),
+		types.NewVar(token.NoPos, nil, "Skip", types.NewSlice(types.Typ[types.Byte])),
+	}, []string{"", "", "", "", "", "", "", `binenc:"-"`})
+	e := encoder.NewWriter(nil)
+	e.ResetField("s", st)
+	got := parseOutput(t, e)
+	want := []string{
+		"s.A = 0",
+		`s.B = ""`,
+		"s.C = s.C[:0]",
+		"s.D = nil",
+		"for k := range s.E {",
+		"delete(s.E, k)",
+		"}",
+		"for i := range s.F {",
+		"s.F[i] = s.F[i][:0]",
+		"}",
+		"s.G = [3]uint8{}",
+		"",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("e.ResetField(%q, %q): (-want, +got):\n%s", "s", st.String(), diff)
+	}
+}
+
+func TestReadField_Decoder(t *testing.T) {
+	// type Node struct {
+	//	Name     string
+	//	Children []Node
+	// }
+	node := types.NewNamed(types.NewTypeName(token.NoPos, nil, "Node", nil), nil, nil)
+	node.SetUnderlying(types.NewStruct([]*types.Var{
+		types.NewVar(token.NoPos, nil, "Name", types.Typ[types.String]),
+		types.NewVar(token.NoPos, nil, "Children", types.NewSlice(node)),
+	}, nil))
 
 	e := encoder.NewWriter(nil)
-	e.WriteField("test", st)
+	e.Limits()
+	e.ReadField("s", node)
 	got := parseOutput(t, e)
 	want := []string{
-		"buf[offset] = byte(len(test.arr1))",
-		"buf[offset + 1] = byte(len(test.arr1) >> 8)",
-		"offset += 2",
-		"for _, v := range test.arr1 {",
-		"buf[offset] = byte(v)",
-		"offset += 1",
+		"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+		"return n + int64(nr), err",
 		"}",
-		"buf[offset] = byte(len(test.inners))",
-		"buf[offset + 1] = byte(len(test.inners) >> 8)",
-		"offset += 2",
-		"for _, v := range test.inners {",
-		"buf[offset] = byte(v.foo)",
-		"buf[offset + 1] = byte(v.foo >> 8)",
-		"buf[offset + 2] = byte(v.foo >> 16)",
-		"buf[offset + 3] = byte(v.foo >> 24)",
-		"offset += 4",
-		"buf[offset] = byte(len(v.arr))",
-		"buf[offset + 1] = byte(len(v.arr) >> 8)",
-		"offset += 2",
-		"for _, v1 := range v.arr {",
-		"buf[offset] = byte(v1)",
-		"buf[offset + 1] = byte(v1 >> 8)",
-		"buf[offset + 2] = byte(v1 >> 16)",
-		"buf[offset + 3] = byte(v1 >> 24)",
-		"offset += 4",
+		"n += 2",
+		"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+		"if opts.MaxStringLen > 0 && size > opts.MaxStringLen {",
+		`return n, &binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}`,
 		"}",
+		"if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {",
+		`return n, &binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}`,
+		"}",
+		"if size > binenc.MaxAlloc {",
+		"return n, binenc.ErrTooLarge",
+		"}",
+		"tmp = d.Arena(size)",
+		"if nr, err := io.ReadFull(r, tmp); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += int64(size)",
+		"s.Name = *(*string)(unsafe.Pointer(&tmp))",
+		"if nr, err := io.ReadFull(r, buf[:2]); err != nil {",
+		"return n + int64(nr), err",
+		"}",
+		"n += 2",
+		"size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))",
+		"if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {",
+		`return n, &binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}`,
+		"}",
+		"if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {",
+		`return n, &binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}`,
+		"}",
+		"if size > binenc.MaxAlloc/40 {",
+		"return n, binenc.ErrTooLarge",
+		"}",
+		"s.Children = make([]Node, size)",
+		"si := size",
+		"for i := 0; i < si; i++ {",
+		"if n, err = s.Children[i].readBinenc(d, n, 1); err != nil {",
+		"return n, err",
 		"}",
-		"buf[offset] = byte(len(test.arr2))",
-		"buf[offset + 1] = byte(len(test.arr2) >> 8)",
-		"offset += 2",
-		"for _, v := range test.arr2 {",
-		"buf[offset] = byte(v)",
-		"offset += 1",
 		"}",
 		"",
 	}
-	wantSizeExpr := []string{
-		"size := 6",
-		"size += 1 * len(test.arr1) + 1 * len(test.arr2)",
-		"for _, v := range test.inners {",
-		"size += 6",
-		"size += 4 * len(v.arr)",
-		"}",
-		""}
 	if diff := cmp.Diff(want, got); diff != "" {
-		t.Errorf("e.WriteField(%q, %q): (-want, +got):\n%s", "test", st.String(), diff)
+		t.Errorf("e.ReadField(%q, %q): (-want, +got):\n%s", "s", node.String(), diff)
 	}
-	if diff := cmp.Diff(wantSizeExpr, splitLinesTrim(t, e.SizeExpr())); diff != "" {
-		t.Errorf("e.SizeExpr(): (-want, +got):\n%s", diff)
+	wantHeader := []string{
+		"r := d.Reader()",
+		"buf := d.Buffer()",
+		"opts := d.Options()",
+		"var size int",
+		"var tmp []byte",
+		"",
+	}
+	if diff := cmp.Diff(wantHeader, splitLinesTrim(t, e.HeaderExpr())); diff != "" {
+		t.Errorf("e.HeaderExpr(): (-want, +got):\n%s", diff)
 	}
 }
@@ -0,0 +1,77 @@
+package encoder
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// LenUvarint is the Options.LenSize encoding lengths as unsigned varints,
+// as in encoding/binary.
+const LenUvarint = -1
+
+// ParseLenSize parses the length prefix encoding s, either the number of
+// bytes 1, 2, 4 or 8, or "uvarint".
+func ParseLenSize(s string) (int, error) {
+	if s == "uvarint" {
+		return LenUvarint, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || (n != 1 && n != 2 && n != 4 && n != 8) {
+		return 0, fmt.Errorf("invalid length size %q: must be 1, 2, 4, 8 or uvarint", s)
+	}
+	return n, nil
+}
+
+// writeLength writes the length prefix of the string, slice or map name.
+func (w *Writer) writeLength(name string) {
+	l := length(name)
+	switch w.lenSize {
+	case LenUvarint:
+		w.imports["encoding/binary"] = true
+		w.imports["math/bits"] = true
+		w.Printf("\toffset += binary.PutUvarint(buf[offset:], uint64(%s))\n", l)
+		size := fmt.Sprintf("(bits.Len64(uint64(%s)|1)+6)/7", l)
+		w.levels[w.forLvl].dynamic = append(w.levels[w.forLvl].dynamic, size)
+		return
+	case 8:
+	default:
+		w.imports["fmt"] = true
+		w.imports["math"] = true
+		w.Printf("\tif uint64(%s) > math.MaxUint%d {\n", l, 8*w.lenSize)
+		w.writeErr(fmt.Sprintf("fmt.Errorf(\"binenc: length %%d overflows %d-byte prefix\", %s)", w.lenSize, l))
+		w.Printf("\t}\n")
+	}
+	w.writeNumberN(l, w.lenSize, true)
+}
+
+// readLength reads a length prefix into the size variable.
+func (w *Writer) readLength() {
+	w.usedSize = true
+	switch w.lenSize {
+	case 1, 2:
+		w.readBytes(w.lenSize)
+		w.Printf("\tsize = int(%s)\n", w.numberExpr(0, w.lenSize))
+		return
+	case LenUvarint:
+		w.imports["math"] = true
+		w.readUvarint("length")
+		w.Printf("\tif usize > math.MaxInt {\n")
+		w.readErr("fmt.Errorf(\"binenc: length %d overflows int\", usize)")
+		w.Printf("\t}\n")
+		w.Printf("\tsize = int(usize)\n")
+		return
+	}
+	// 4 and 8 byte lengths may not fit in an int
+	w.imports["fmt"] = true
+	w.imports["math"] = true
+	w.readBytes(w.lenSize)
+	cond := "x > math.MaxInt"
+	if w.lenSize == 4 {
+		cond = "uint64(x) > math.MaxInt"
+	}
+	w.Printf("\tif x := %s; %s {\n", w.numberExpr(0, w.lenSize), cond)
+	w.readErr("fmt.Errorf(\"binenc: length %d overflows int\", x)")
+	w.Printf("\t} else {\n")
+	w.Printf("\tsize = int(x)\n")
+	w.Printf("\t}\n")
+}
@@ -0,0 +1,93 @@
+package encoder
+
+import "fmt"
+
+// RuntimePath is the import path of the package of the types shared by the
+// generated code.
+const RuntimePath = "github.com/cezarguimaraes/go-binenc-gen/binenc"
+
+// limitErr returns the expression of a binenc.LimitError for the limit of
+// opts named limit.
+func limitErr(limit string) string {
+	max := "opts." + limit
+	if limit != "MaxBytes" {
+		max = "int64(" + max + ")"
+	}
+	return fmt.Sprintf("&binenc.LimitError{Limit: %q, Max: %s}", limit, max)
+}
+
+// With limits, the read code checks the lengths it reads against the
+// binenc.DecodeOptions opts of the enclosing function before allocating,
+// and passes them to the helpers of recursive types along with the nesting
+// depth, which the helpers check.
+
+// Limits makes the generated read code check the limits of the
+// binenc.DecodeOptions opts of the enclosing function.
+func (w *Writer) Limits() {
+	w.limits = true
+}
+
+// Depth sets the expression of the nesting depth of the values read by the
+// generated code, 0 by default.
+func (w *Writer) Depth(expr string) {
+	w.depth = expr
+}
+
+// helperArgs returns the limits arguments of a call to a read helper.
+func (w *Writer) helperArgs() string {
+	if !w.limits {
+		return ""
+	}
+	depth := "1"
+	if w.depth != "0" {
+		depth = w.depth + "+1"
+	}
+	if !w.fromBytes {
+		// the limits come with d
+		return ", " + depth
+	}
+	return ", opts, " + depth
+}
+
+// checkLength checks the length in size against the limit of opts named
+// limit. When minBytes is set, each unit of the length takes at least a
+// byte of input, which must then be available.
+func (w *Writer) checkLength(limit string, minBytes bool) {
+	if !w.limits {
+		return
+	}
+	w.imports[RuntimePath] = true
+	w.usedOpts = true
+	w.Printf("\tif opts.%s > 0 && size > opts.%s {\n", limit, limit)
+	w.readErr(limitErr(limit))
+	w.Printf("\t}\n")
+	if !minBytes {
+		return
+	}
+	if w.fromBytes {
+		// data is cut at opts.MaxBytes by the caller
+		w.checkBounds("size")
+		return
+	}
+	w.Printf("\tif opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {\n")
+	w.readErr(limitErr("MaxBytes"))
+	w.Printf("\t}\n")
+}
+
+// checkAlloc checks, reading from r, that size elements of elemSize bytes
+// take at most binenc.MaxAlloc bytes of memory. Unlike data, r can't tell
+// in advance whether it holds the input of size elements, and a corrupt
+// length would otherwise make the allocation panic or exhaust the memory.
+func (w *Writer) checkAlloc(elemSize int64) {
+	if !w.limits || w.fromBytes || elemSize == 0 {
+		return
+	}
+	w.imports[RuntimePath] = true
+	if elemSize == 1 {
+		w.Printf("\tif size > binenc.MaxAlloc {\n")
+	} else {
+		w.Printf("\tif size > binenc.MaxAlloc/%d {\n", elemSize)
+	}
+	w.readErr("binenc.ErrTooLarge")
+	w.Printf("\t}\n")
+}
@@ -0,0 +1,242 @@
+package encoder
+
+import (
+	"fmt"
+	"go/types"
+)
+
+// Endian is the byte order of the encoded numbers.
+type Endian int
+
+const (
+	LittleEndian Endian = iota
+	BigEndian
+)
+
+// ParseEndian parses the byte order s, either "little" or "big".
+func ParseEndian(s string) (Endian, error) {
+	switch s {
+	case "little":
+		return LittleEndian, nil
+	case "big":
+		return BigEndian, nil
+	}
+	return 0, fmt.Errorf("invalid byte order %q: must be little or big", s)
+}
+
+// Encoding is the encoding of integers.
+type Encoding int
+
+const (
+	// Fixed integers take their size in bytes.
+	Fixed Encoding = iota
+	// Varint integers are encoded as LEB128 unsigned varints, as in
+	// encoding/binary, after zigzag encoding signed values.
+	Varint
+)
+
+// ParseEncoding parses the integer encoding s, either "fixed" or "varint".
+func ParseEncoding(s string) (Encoding, error) {
+	switch s {
+	case "fixed":
+		return Fixed, nil
+	case "varint":
+		return Varint, nil
+	}
+	return 0, fmt.Errorf("invalid encoding %q: must be fixed or varint", s)
+}
+
+// endianOf returns the byte order of the values of the named type t.
+func (w *Writer) endianOf(t *types.Named) Endian {
+	if e, ok := w.opts.TypeEndian[t.Obj()]; ok {
+		return e
+	}
+	return w.opts.Endian
+}
+
+// isWordSized reports whether the size of t depends on the architecture.
+func isWordSized(t *types.Basic) bool {
+	switch t.Kind() {
+	case types.Int, types.Uint, types.Uintptr:
+		return true
+	}
+	return false
+}
+
+// fixedKind returns the kind of the size bytes integer.
+func fixedKind(size int, unsigned bool) types.BasicKind {
+	kinds := map[int]types.BasicKind{1: types.Int8, 2: types.Int16, 4: types.Int32, 8: types.Int64}
+	if unsigned {
+		kinds = map[int]types.BasicKind{1: types.Uint8, 2: types.Uint16, 4: types.Uint32, 8: types.Uint64}
+	}
+	return kinds[size]
+}
+
+// convert returns expr, whose type is of the given kind, converted to t.
+func (w *Writer) convert(expr string, t types.Type, kind types.BasicKind) string {
+	if b, ok := t.(*types.Basic); ok && b.Kind() == kind {
+		return expr
+	}
+	return fmt.Sprintf("%s(%s)", w.typeName(t), expr)
+}
+
+// intSize returns the number of bytes of the integer t on the wire.
+func (w *Writer) intSize(t *types.Basic) int {
+	if w.width != 0 {
+		return w.width
+	}
+	return int(w.stdSizes.Sizeof(t))
+}
+
+// sizeRange returns the smallest and largest size in bytes of the integer
+// t on the architectures running the generated code.
+func (w *Writer) sizeRange(t *types.Basic) (min, max int) {
+	if isWordSized(t) {
+		return 4, 8
+	}
+	size := int(w.stdSizes.Sizeof(t))
+	return size, size
+}
+
+// isVarint reports whether the integer t is encoded as a varint. Single
+// byte integers are always fixed, as a varint may only make them larger.
+func (w *Writer) isVarint(t *types.Basic) bool {
+	return w.encoding == Varint && w.width == 0 && w.stdSizes.Sizeof(t) > 1
+}
+
+func (w *Writer) writeInteger(name string, t *types.Basic) {
+	if w.isVarint(t) {
+		w.writeVarint(name, t)
+		return
+	}
+	size := w.intSize(t)
+	unsigned := t.Info()&types.IsUnsigned != 0
+	if _, max := w.sizeRange(t); size < max {
+		w.imports["fmt"] = true
+		w.imports["math"] = true
+		if unsigned {
+			w.Printf("\tif uint64(%s) > math.MaxUint%d {\n", name, 8*size)
+		} else {
+			w.Printf("\tif %s < math.MinInt%d || %s > math.MaxInt%d {\n", name, 8*size, name, 8*size)
+		}
+		w.writeErr(fmt.Sprintf("fmt.Errorf(\"binenc: %%d overflows %d bytes\", %s)", size, name))
+		w.Printf("\t}\n")
+	}
+	w.writeNumberN(name, size, unsigned)
+}
+
+func (w *Writer) readInteger(name string, t types.Type, basic *types.Basic) {
+	if w.isVarint(basic) {
+		w.readVarint(name, t, basic)
+		return
+	}
+	size := w.intSize(basic)
+	unsigned := basic.Info()&types.IsUnsigned != 0
+	w.readBytes(size)
+	kind := fixedKind(size, unsigned)
+	fixed := types.Typ[kind].Name()
+	expr := w.numberExpr(0, size)
+	if !unsigned {
+		expr = fmt.Sprintf("%s(%s)", fixed, expr)
+	}
+	if min, _ := w.sizeRange(basic); size <= min {
+		w.Printf("\t%s = %s\n", name, w.convert(expr, t, kind))
+		return
+	}
+	// wider values, such as word sized ones, may not fit in t
+	w.imports["fmt"] = true
+	w.Printf("\tif x := %s; %s(%s(x)) != x {\n", expr, fixed, basic.Name())
+	w.readErr(fmt.Sprintf("fmt.Errorf(\"binenc: %%d overflows %s\", x)", basic.Name()))
+	w.Printf("\t} else {\n")
+	w.Printf("\t%s = %s\n", name, w.convert("x", t, kind))
+	w.Printf("\t}\n")
+}
+
+func (w *Writer) writeVarint(name string, t *types.Basic) {
+	w.imports["encoding/binary"] = true
+	w.imports["math/bits"] = true
+	var size string
+	if t.Info()&types.IsUnsigned != 0 {
+		w.Printf("\toffset += binary.PutUvarint(buf[offset:], uint64(%s))\n", name)
+		size = fmt.Sprintf("(bits.Len64(uint64(%s)|1)+6)/7", name)
+	} else {
+		w.Printf("\toffset += binary.PutVarint(buf[offset:], int64(%s))\n", name)
+		size = fmt.Sprintf("(bits.Len64(uint64(%s)<<1^uint64(int64(%s)>>63)|1)+6)/7", name, name)
+	}
+	w.levels[w.forLvl].dynamic = append(w.levels[w.forLvl].dynamic, size)
+}
+
+// readUvarint reads an unsigned varint into the usize variable. what names
+// the value in errors.
+func (w *Writer) readUvarint(what string) {
+	w.usedUvarint = true
+	w.imports["fmt"] = true
+	w.Printf("\tusize = 0\n")
+	w.Printf("\tfor shift := 0; ; shift += 7 {\n")
+	w.readBytes(1)
+	w.Printf("\tif shift == 63 && buf[0] > 1 {\n")
+	w.readErr(fmt.Sprintf("fmt.Errorf(\"binenc: %s overflows 64 bits\")", what))
+	w.Printf("\t}\n")
+	w.Printf("\tusize |= uint64(buf[0]&0x7f) << shift\n")
+	w.Printf("\tif buf[0] < 0x80 {\n")
+	w.Printf("\tbreak\n")
+	w.Printf("\t}\n")
+	w.Printf("\t}\n")
+}
+
+func (w *Writer) readVarint(name string, t types.Type, basic *types.Basic) {
+	w.readUvarint("varint")
+	kind, expr := types.Uint64, "usize"
+	if basic.Info()&types.IsUnsigned == 0 {
+		kind, expr = types.Int64, "int64(usize>>1) ^ -int64(usize&1)"
+	}
+	fixed := types.Typ[kind].Name()
+	if w.stdSizes.Sizeof(basic) == 8 && !isWordSized(basic) {
+		w.Printf("\t%s = %s\n", name, w.convert(expr, t, kind))
+		return
+	}
+	w.Printf("\tif x := %s; %s(%s(x)) != x {\n", expr, fixed, basic.Name())
+	w.readErr(fmt.Sprintf("fmt.Errorf(\"binenc: %%d overflows %s\", x)", basic.Name()))
+	w.Printf("\t} else {\n")
+	w.Printf("\t%s = %s\n", name, w.convert("x", t, kind))
+	w.Printf("\t}\n")
+}
+
+// Floats are encoded through their IEEE 754 binary representation, with
+// the byte order of integers.
+
+func (w *Writer) writeFloat(name string, t types.Type, basic *types.Basic) {
+	size := int(w.stdSizes.Sizeof(basic))
+	w.imports["math"] = true
+	if t != types.Type(basic) {
+		name = fmt.Sprintf("%s(%s)", basic.Name(), name)
+	}
+	w.writeNumberN(fmt.Sprintf("math.Float%dbits(%s)", 8*size, name), size, true)
+}
+
+func (w *Writer) readFloat(name string, t types.Type, basic *types.Basic) {
+	size := int(w.stdSizes.Sizeof(basic))
+	w.imports["math"] = true
+	w.readBytes(size)
+	expr := fmt.Sprintf("math.Float%dfrombits(%s)", 8*size, w.numberExpr(0, size))
+	w.Printf("\t%s = %s\n", name, w.convert(expr, t, basic.Kind()))
+}
+
+func (w *Writer) writeComplex(name string, t *types.Basic) {
+	size := int(w.stdSizes.Sizeof(t)) / 2
+	w.imports["math"] = true
+	w.writeNumberN(fmt.Sprintf("math.Float%dbits(real(%s))", 8*size, name), size, true)
+	w.writeNumberN(fmt.Sprintf("math.Float%dbits(imag(%s))", 8*size, name), size, true)
+}
+
+func (w *Writer) readComplex(name string, t types.Type, basic *types.Basic) {
+	size := int(w.stdSizes.Sizeof(basic)) / 2
+	w.imports["math"] = true
+	// the real part is stored in name while the imaginary part is read
+	w.readBytes(size)
+	expr := fmt.Sprintf("complex(math.Float%dfrombits(%s), 0)", 8*size, w.numberExpr(0, size))
+	w.Printf("\t%s = %s\n", name, w.convert(expr, t, basic.Kind()))
+	w.readBytes(size)
+	expr = fmt.Sprintf("complex(real(%s), math.Float%dfrombits(%s))", name, 8*size, w.numberExpr(0, size))
+	w.Printf("\t%s = %s\n", name, w.convert(expr, t, basic.Kind()))
+}
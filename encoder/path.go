@@ -0,0 +1,89 @@
+package encoder
+
+import (
+	"fmt"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// With FieldErrors, the read code returns its errors as *binenc.DecodeError
+// values locating the field being read. Its path is derived from the name
+// passed to ReadField, dropping the receiver and the dereferences, with the
+// index variables of the loops formatted at run time. The key and value
+// variables of maps stand for the path of the map.
+
+// indexVar matches the index variables of a name.
+var indexVar = regexp.MustCompile(`\[(\w+)\]`)
+
+// fieldPath is the path of a field as a format and the expressions of its
+// arguments, as passed to binenc.FieldError.
+type fieldPath struct {
+	format string
+	args   []string
+}
+
+// FieldErrors makes the generated read code return its errors, but an
+// io.EOF before the first byte, as *binenc.DecodeError values of the type
+// named typ, which the receiver passed to ReadField holds.
+func (w *Writer) FieldErrors(typ string) {
+	w.errType = typ
+}
+
+// pathOf returns the path of the field name.
+func (w *Writer) pathOf(name string) fieldPath {
+	name = strings.NewReplacer("(*", "", ")", "").Replace(name)
+	name = strings.TrimPrefix(name, "*")
+	root, rest := name, ""
+	if i := strings.IndexAny(name, ".["); i >= 0 {
+		root, rest = name[:i], name[i:]
+	}
+	var p fieldPath
+	if alias, ok := w.paths[root]; ok {
+		p.format = alias.format
+		p.args = append(p.args, alias.args...)
+	} else {
+		// the receiver
+		rest = strings.TrimPrefix(rest, ".")
+	}
+	p.format += indexVar.ReplaceAllStringFunc(rest, func(m string) string {
+		p.args = append(p.args, m[1:len(m)-1])
+		return "[%d]"
+	})
+	return p
+}
+
+// aliasMapEntry makes the key variable k of the map name stand for the
+// map, and the value variable v for the entry of the key.
+func (w *Writer) aliasMapEntry(name, k, v string, key types.Type) {
+	if w.errType == "" {
+		return
+	}
+	verb := "[%v]"
+	if b, ok := key.Underlying().(*types.Basic); ok && b.Info()&types.IsString != 0 {
+		verb = "[%q]"
+	}
+	p := w.pathOf(name)
+	w.paths[k] = p
+	w.paths[v] = fieldPath{
+		format: p.format + verb,
+		args:   append(p.args[:len(p.args):len(p.args)], k),
+	}
+}
+
+// fieldErr returns the expression of the error expr of the read code, as a
+// *binenc.DecodeError of the current field with FieldErrors.
+func (w *Writer) fieldErr(expr string) string {
+	if w.errType == "" {
+		return expr
+	}
+	w.imports[RuntimePath] = true
+	offset := "n"
+	if w.fromBytes {
+		offset = "int64(offset)"
+	}
+	p := w.pathOf(w.field)
+	args := append([]string{expr, strconv.Quote(w.errType), offset, strconv.Quote(p.format)}, p.args...)
+	return fmt.Sprintf("binenc.FieldError(%s)", strings.Join(args, ", "))
+}
@@ -0,0 +1,124 @@
+package encoder
+
+import (
+	"fmt"
+	"go/types"
+)
+
+// With Options.Reuse, the read code decodes into the memory already held by
+// the value read: slices are resliced when their capacity suffices, maps are
+// cleared, and non-nil pointers are decoded through rather than replaced.
+// ResetField clears a value for later reuse, keeping that memory.
+
+// makeSlice sets the slice name of type slc to size elements.
+func (w *Writer) makeSlice(name string, slc *types.Slice) {
+	if !w.opts.Reuse {
+		w.Printf("\t%s = make(%s, size)\n", name, w.typeName(slc))
+		return
+	}
+	w.Printf("\tif cap(%s) < size {\n", name)
+	w.Printf("\t%s = make(%s, size)\n", name, w.typeName(slc))
+	w.Printf("\t} else {\n")
+	w.Printf("\t%s = %s[:size]\n", name, name)
+	w.Printf("\t}\n")
+}
+
+// makeMap sets the map name of type m to an empty map with room for size
+// entries. Reading from r, the room is capped by binenc.MapHint, and the map
+// grows with the entries actually read.
+func (w *Writer) makeMap(name string, m *types.Map) {
+	hint := "size"
+	if !w.fromBytes {
+		w.imports[RuntimePath] = true
+		hint = "binenc.MapHint(size)"
+	}
+	if !w.opts.Reuse {
+		w.Printf("\t%s = make(%s, %s)\n", name, w.typeName(m), hint)
+		return
+	}
+	w.Printf("\tif %s == nil {\n", name)
+	w.Printf("\t%s = make(%s, %s)\n", name, w.typeName(m), hint)
+	w.Printf("\t} else {\n")
+	w.clearMap(name)
+	w.Printf("\t}\n")
+}
+
+// clearMap deletes the entries of the map name.
+func (w *Writer) clearMap(name string) {
+	k := indexForKey(w.forLvl)
+	w.Printf("\tfor %s := range %s {\n", k, name)
+	w.Printf("\tdelete(%s, %s)\n", name, k)
+	w.Printf("\t}\n")
+}
+
+// newPointer points the pointer name to a value of type elem to decode.
+func (w *Writer) newPointer(name string, elem types.Type) {
+	if !w.opts.Reuse {
+		w.Printf("\t%s = new(%s)\n", name, w.typeName(elem))
+		return
+	}
+	w.Printf("\tif %s == nil {\n", name)
+	w.Printf("\t%s = new(%s)\n", name, w.typeName(elem))
+	w.Printf("\t}\n")
+}
+
+// ResetField sets name of type t to the zero value but for its slices,
+// which are truncated, and its maps, which are cleared, as decoding would
+// reuse them. Only the encoded fields of structs are set, and pointers are
+// set to nil, so that the value encodes as the zero value.
+func (w *Writer) ResetField(name string, t types.Type) {
+	switch u := t.Underlying().(type) {
+	case *types.Slice:
+		w.Printf("\t%s = %s[:0]\n", name, name)
+	case *types.Map:
+		w.clearMap(name)
+	case *types.Struct:
+		fields, _ := structFields(u)
+		for _, f := range fields {
+			w.ResetField(fmt.Sprintf("%s.%s", name, f.v.Name()), f.v.Type())
+		}
+	case *types.Array:
+		if !holdsMemory(u) {
+			w.Printf("\t%s = %s{}\n", name, w.typeName(t))
+			return
+		}
+		w.Printf("\tfor %s := range %s {\n", indexForVar(w.forLvl), name)
+		w.forLvl += 1
+		w.ResetField(fmt.Sprintf("%s[%s]", name, indexForVar(w.forLvl-1)), u.Elem())
+		w.Printf("\t}\n")
+	case *types.Basic:
+		w.Printf("\t%s = %s\n", name, zeroBasic(u))
+	default:
+		w.Printf("\t%s = nil\n", name)
+	}
+}
+
+// holdsMemory reports whether values of type t hold slices or maps, other
+// than through pointers.
+func holdsMemory(t types.Type) bool {
+	switch u := t.Underlying().(type) {
+	case *types.Slice, *types.Map:
+		return true
+	case *types.Struct:
+		fields, _ := structFields(u)
+		for _, f := range fields {
+			if holdsMemory(f.v.Type()) {
+				return true
+			}
+		}
+	case *types.Array:
+		return holdsMemory(u.Elem())
+	}
+	return false
+}
+
+// zeroBasic returns the zero value of values of type t.
+func zeroBasic(t *types.Basic) string {
+	switch info := t.Info(); {
+	case info&types.IsBoolean != 0:
+		return "false"
+	case info&types.IsString != 0:
+		return `""`
+	}
+	return "0"
+}
@@ -0,0 +1,23 @@
+package encoder
+
+// With Options.Safe, the generated code does not import unsafe. Strings are
+// copied out of the input with a conversion, rather than converted in place
+// from an arena, and slices and arrays of numbers other than bytes are copied
+// element by element, see bulkSize. The encoding is the same.
+
+// readStringCopy reads a string of size bytes into name through a string
+// conversion, which copies them. Reading from r goes through the scratch
+// buffer of d.
+func (w *Writer) readStringCopy(name string) {
+	if w.fromBytes {
+		w.endSegment()
+		w.checkBounds("size")
+		w.Printf("\t%s = string(data[offset : offset+size])\n", name)
+		w.Printf("\toffset += size\n")
+		return
+	}
+	w.usedTmp = true
+	w.Printf("\ttmp = d.Scratch(size)\n")
+	w.readFull("tmp", "size")
+	w.Printf("\t%s = string(tmp)\n", name)
+}
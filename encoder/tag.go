@@ -0,0 +1,251 @@
+package encoder
+
+import (
+	"errors"
+	"fmt"
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// fieldTag holds the options of a `binenc:"..."` struct field tag, a comma
+// separated list of key=value options, or "-" to skip the field:
+//
+//	len=N      length prefix encoding, as with Options.LenSize
+//	width=N    number of bytes of integers: 1, 2, 4 or 8
+//	order=N    position of the field on the wire, set on all fields or none
+//	endian=E   byte order of integers and floats: little or big
+//	enc=E      encoding of integers: fixed or varint
+//
+// The options apply to the value of the field, up to nested structs, whose
+// fields have tags of their own.
+type fieldTag struct {
+	skip    bool
+	lenSize int
+	width   int
+	order   int
+	ordered bool
+	endian  *Endian
+	enc     *Encoding
+}
+
+// TagError reports an invalid binenc tag on a struct field.
+type TagError struct {
+	Field *types.Var
+	Err   error
+}
+
+func (e *TagError) Error() string {
+	return fmt.Sprintf("field %s: %s", e.Field.Name(), e.Err)
+}
+
+func (e *TagError) Unwrap() error {
+	return e.Err
+}
+
+// parseTag parses the binenc tag of a struct field.
+func parseTag(tag string) (fieldTag, error) {
+	var ft fieldTag
+	value, ok := reflect.StructTag(tag).Lookup("binenc")
+	if !ok {
+		if strings.Contains(tag, "binenc:") {
+			return ft, fmt.Errorf("malformed tag %q", tag)
+		}
+		return ft, nil
+	}
+	if value == "-" {
+		ft.skip = true
+		return ft, nil
+	}
+	seen := make(map[string]bool)
+	for _, opt := range strings.Split(value, ",") {
+		key, val, ok := strings.Cut(opt, "=")
+		if !ok {
+			return ft, fmt.Errorf("invalid option %q: must be key=value", opt)
+		}
+		if seen[key] {
+			return ft, fmt.Errorf("duplicate option %s", key)
+		}
+		seen[key] = true
+		var err error
+		switch key {
+		case "len":
+			ft.lenSize, err = ParseLenSize(val)
+		case "width":
+			ft.width, err = strconv.Atoi(val)
+			if err != nil || (ft.width != 1 && ft.width != 2 && ft.width != 4 && ft.width != 8) {
+				err = fmt.Errorf("invalid width %q: must be 1, 2, 4 or 8", val)
+			}
+		case "order":
+			ft.order, err = strconv.Atoi(val)
+			if err != nil || ft.order < 0 {
+				err = fmt.Errorf("invalid order %q: must be a non-negative integer", val)
+			}
+			ft.ordered = true
+		case "endian":
+			var e Endian
+			e, err = ParseEndian(val)
+			ft.endian = &e
+		case "enc":
+			var e Encoding
+			e, err = ParseEncoding(val)
+			ft.enc = &e
+		default:
+			err = fmt.Errorf("unknown option %q", key)
+		}
+		if err != nil {
+			return ft, err
+		}
+	}
+	if ft.width != 0 && ft.enc != nil && *ft.enc == Varint {
+		return ft, errors.New("width option on varint integers")
+	}
+	return ft, nil
+}
+
+// valueKinds is a set of the kinds of values encoded for a type.
+type valueKinds int
+
+const (
+	integerValues valueKinds = 1 << iota
+	floatValues
+	lengthValues
+)
+
+// kindsOf returns the kinds of values encoded for t, up to nested structs.
+func kindsOf(t types.Type, seen map[*types.Named]bool) valueKinds {
+	if named, ok := t.(*types.Named); ok {
+		if seen[named] {
+			return 0
+		}
+		seen[named] = true
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		info := u.Info()
+		if info&types.IsInteger != 0 {
+			return integerValues
+		} else if info&(types.IsFloat|types.IsComplex) != 0 {
+			return floatValues
+		} else if info&types.IsString != 0 {
+			return lengthValues
+		}
+	case *types.Pointer:
+		return kindsOf(u.Elem(), seen)
+	case *types.Array:
+		return kindsOf(u.Elem(), seen)
+	case *types.Slice:
+		return lengthValues | kindsOf(u.Elem(), seen)
+	case *types.Map:
+		return lengthValues | kindsOf(u.Key(), seen) | kindsOf(u.Elem(), seen)
+	}
+	return 0
+}
+
+// check reports options of the tag that do not apply to values of type t.
+func (ft fieldTag) check(t types.Type) error {
+	kinds := kindsOf(t, make(map[*types.Named]bool))
+	name := types.TypeString(t, nil)
+	if ft.lenSize != 0 && kinds&lengthValues == 0 {
+		return fmt.Errorf("len option on %s, which has no length prefix", name)
+	}
+	if ft.width != 0 && kinds&integerValues == 0 {
+		return fmt.Errorf("width option on %s, which has no integers", name)
+	}
+	if ft.enc != nil && kinds&integerValues == 0 {
+		return fmt.Errorf("enc option on %s, which has no integers", name)
+	}
+	if ft.endian != nil && kinds&(integerValues|floatValues) == 0 {
+		return fmt.Errorf("endian option on %s, which has no numbers", name)
+	}
+	return nil
+}
+
+// field is an encoded struct field along with its tag.
+type field struct {
+	v   *types.Var
+	tag fieldTag
+}
+
+// structFields returns the encoded fields of s in wire order, along with
+// the *TagError of each invalid tag.
+func structFields(s *types.Struct) ([]field, []error) {
+	var fields []field
+	var errs []error
+	ordered := 0
+	for i := 0; i < s.NumFields(); i++ {
+		v := s.Field(i)
+		tag, err := parseTag(s.Tag(i))
+		if err == nil {
+			err = tag.check(v.Type())
+		}
+		if err != nil {
+			errs = append(errs, &TagError{Field: v, Err: err})
+			continue
+		}
+		if v.Name() == "_" || tag.skip {
+			continue
+		}
+		if tag.ordered {
+			ordered++
+		}
+		fields = append(fields, field{v: v, tag: tag})
+	}
+	if ordered == 0 {
+		return fields, errs
+	}
+	if ordered < len(fields) {
+		for _, f := range fields {
+			if !f.tag.ordered {
+				errs = append(errs, &TagError{Field: f.v, Err: errors.New("missing order option, which is set on other fields")})
+			}
+		}
+		return fields, errs
+	}
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].tag.order < fields[j].tag.order
+	})
+	for i := 1; i < len(fields); i++ {
+		if fields[i].tag.order == fields[i-1].tag.order {
+			errs = append(errs, &TagError{Field: fields[i].v, Err: fmt.Errorf("duplicate order %d, also set on field %s", fields[i].tag.order, fields[i-1].v.Name())})
+		}
+	}
+	return fields, errs
+}
+
+// structFields returns the encoded fields of s in wire order, recording
+// the errors of their tags.
+func (w *Writer) structFields(s *types.Struct) []field {
+	fields, errs := structFields(s)
+	w.errs = append(w.errs, errs...)
+	return fields
+}
+
+// enterField applies the tag options of a struct field to its value. The
+// options of the enclosing value must be restored with the returned func.
+func (w *Writer) enterField(tag fieldTag) (exit func()) {
+	lenSize, encoding, width, fieldEndian, bigEndian := w.lenSize, w.encoding, w.width, w.fieldEndian, w.bigEndian
+	w.lenSize = w.opts.LenSize
+	if tag.lenSize != 0 {
+		w.lenSize = tag.lenSize
+	}
+	w.encoding = w.opts.Encoding
+	if tag.enc != nil {
+		w.encoding = *tag.enc
+	}
+	w.width = tag.width
+	w.fieldEndian = tag.endian
+	if tag.endian != nil {
+		w.bigEndian = *tag.endian == BigEndian
+	}
+	return func() {
+		w.lenSize, w.encoding, w.width, w.fieldEndian, w.bigEndian = lenSize, encoding, width, fieldEndian, bigEndian
+	}
+}
+
+// Errors returns the errors found in the struct tags of the encoded types.
+func (w *Writer) Errors() []error {
+	return w.errs
+}
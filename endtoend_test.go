@@ -72,6 +72,190 @@ func TestEndToEnd(t *testing.T) {
 	}
 }
 
+// TestTags generates a package whose struct is behind a build constraint. The
+// package must build both with and without the tag, which requires the output
+// to be stamped with a matching //go:build line.
+func TestTags(t *testing.T) {
+	dir, binenc := buildBinenc(t)
+	defer os.RemoveAll(dir)
+	pkg := filepath.Join(dir, "tags")
+	if err := os.Mkdir(pkg, 0755); err != nil {
+		t.Fatal(err)
+	}
+	root, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		// the generated code imports the binenc package of this module
+		"go.mod":  runtimeGoMod("tags", root),
+		"main.go": "package main\n\nfunc main() {}\n",
+		"tagged.go": `//go:build binenc
+
+package main
+
+import "bytes"
+
+type Tagged struct {
+	Name string
+}
+
+func init() {
+	var buf bytes.Buffer
+	(&Tagged{Name: "tagged"}).WriteTo(&buf)
+	var o Tagged
+	o.ReadFrom(&buf)
+	if o.Name != "tagged" {
+		panic("tags: unexpected " + o.Name)
+	}
+}
+`,
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(pkg, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := runInDir(pkg, binenc, "-tags", "binenc", "-gobuild", "."); err != nil {
+		t.Fatal(err)
+	}
+	if err := runInDir(pkg, "go", "run", "."); err != nil {
+		t.Fatal(err)
+	}
+	if err := runInDir(pkg, "go", "run", "-tags", "binenc", "."); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestTagErrors checks that invalid struct tags are reported with their
+// position, without writing any output.
+func TestTagErrors(t *testing.T) {
+	dir, binenc := buildBinenc(t)
+	defer os.RemoveAll(dir)
+	pkg := filepath.Join(dir, "bad")
+	if err := os.Mkdir(pkg, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"go.mod": "module bad\n\ngo 1.19\n",
+		"bad.go": "package bad\n\ntype Bad struct {\n\tName  string `binenc:\"lenn=1\"`\n\tCount int    `binenc:\"len=1\"`\n}\n",
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(pkg, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cmd := exec.Command(binenc, ".")
+	cmd.Dir = pkg
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("binenc succeeded on invalid tags:\n%s", out)
+	}
+	for _, want := range []string{
+		`bad.go:4:2: field Name: unknown option "lenn"`,
+		"bad.go:5:2: field Count: len option on int, which has no length prefix",
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("binenc output does not contain %q:\n%s", want, out)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(pkg, "bad_encoding.go")); !os.IsNotExist(err) {
+		t.Errorf("output written despite invalid tags: %v", err)
+	}
+}
+
+func TestTypeErrors(t *testing.T) {
+	dir, binenc := buildBinenc(t)
+	defer os.RemoveAll(dir)
+	pkg := filepath.Join(dir, "bad")
+	if err := os.Mkdir(pkg, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"go.mod": "module bad\n\ngo 1.19\n",
+		"bad.go": "package bad\n\ntype Root struct {\n\tID uint32\n}\n\ntype Other struct {\n\tName string\n}\n\ntype Count int\n",
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(pkg, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, c := range []struct {
+		types string
+		want  []string
+	}{
+		{"Missing", []string{"no struct type Missing in package bad"}},
+		// every missing name is reported, including those of non-struct types
+		{"Root,Missing,Other,Count", []string{
+			"no struct type Missing in package bad",
+			"no struct type Count in package bad",
+			"2 of the -type names not found",
+		}},
+	} {
+		cmd := exec.Command(binenc, "-type", c.types, ".")
+		cmd.Dir = pkg
+		out, err := cmd.CombinedOutput()
+		if err == nil {
+			t.Fatalf("binenc -type %s succeeded:\n%s", c.types, out)
+		}
+		for _, want := range c.want {
+			if !strings.Contains(string(out), want) {
+				t.Errorf("binenc -type %s output does not contain %q:\n%s", c.types, want, out)
+			}
+		}
+		if strings.Contains(string(out), "type Root") || strings.Contains(string(out), "type Other") {
+			t.Errorf("binenc -type %s reports existing types:\n%s", c.types, out)
+		}
+		if _, err := os.Stat(filepath.Join(pkg, "bad_encoding.go")); !os.IsNotExist(err) {
+			t.Errorf("output written despite missing types: %v", err)
+		}
+	}
+}
+
+func TestDirectiveErrors(t *testing.T) {
+	dir, binenc := buildBinenc(t)
+	defer os.RemoveAll(dir)
+	pkg := filepath.Join(dir, "bad")
+	if err := os.Mkdir(pkg, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"go.mod": "module bad\n\ngo 1.19\n",
+		"bad.go": "package bad\n\n//binenc:endian=middle\ntype Addr uint32\n\n//binenc:order=1\ntype Bad struct {\n\tAddr Addr\n}\n",
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(pkg, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cmd := exec.Command(binenc, ".")
+	cmd.Dir = pkg
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("binenc succeeded on invalid directives:\n%s", out)
+	}
+	for _, want := range []string{
+		`bad.go:3:1: type Addr: invalid byte order "middle": must be little or big`,
+		"bad.go:6:1: type Bad: unknown directive //binenc:order=1",
+		"2 invalid directives",
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("binenc output does not contain %q:\n%s", want, out)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(pkg, "bad_encoding.go")); !os.IsNotExist(err) {
+		t.Errorf("output written despite invalid directives: %v", err)
+	}
+}
+
+// runtimeGoMod returns the go.mod file of module, which requires the
+// module at root.
+func runtimeGoMod(module, root string) string {
+	return "module " + module + "\n\ngo 1.19\n\n" +
+		"require github.com/cezarguimaraes/go-binenc-gen v0.0.0\n\n" +
+		"replace github.com/cezarguimaraes/go-binenc-gen => " + root + "\n"
+}
+
 // buildBinenc creates a temporary directory and installs binenc there.
 func buildBinenc(t *testing.T) (dir string, binenc string) {
 	t.Helper()
@@ -99,9 +283,17 @@ func binencCompileAndRun(t *testing.T, dir, binenc, fileName string) {
 	if err != nil {
 		t.Fatalf("copying file to temporary directory: %s", err)
 	}
-	encodeSource := filepath.Join(dir, "main_encoding.go")
+	encodeSource := filepath.Join(dir, strings.TrimSuffix(fileName, ".go")+"_encoding.go")
+	flags := generateFlags(t, fileName)
+	for i := 0; i+1 < len(flags); i++ {
+		// output files are created in the temporary directory
+		if flags[i] == "-output" {
+			flags[i+1] = filepath.Join(dir, flags[i+1])
+			encodeSource = flags[i+1]
+		}
+	}
 	// Run binenc in temporary directory.
-	err = run(binenc, source)
+	err = run(binenc, append(flags, source)...)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -112,6 +304,26 @@ func binencCompileAndRun(t *testing.T, dir, binenc, fileName string) {
 	}
 }
 
+// generateFlags returns the flags passed to go-binenc-gen by the go:generate
+// directive of the named testdata file.
+func generateFlags(t *testing.T, fileName string) []string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", fileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	const prefix = "//go:generate go-binenc-gen "
+	for _, line := range strings.Split(string(data), "\n") {
+		if !strings.HasPrefix(line, prefix) {
+			continue
+		}
+		args := strings.Fields(strings.TrimPrefix(line, prefix))
+		// the last argument names the file itself
+		return args[:len(args)-1]
+	}
+	return nil
+}
+
 // copy copies the from file to the to file.
 func copy(to, from string) error {
 	toFd, err := os.Create(to)
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type Blob []byte
+
+type Level int8
+
+//go:generate go-binenc-gen bulk.go
+type Bulk struct {
+	Data    []byte
+	Blob    Blob
+	Hash    [4]byte
+	Levels  []Level
+	Samples []uint16
+	Floats  []float64
+	Matrix  [2][2]int32
+	Points  []complex64
+	Big     []uint32 `binenc:"endian=big"`
+	Chunks  map[string][]int64
+	Empty   []uint16
+}
+
+func main() {
+	s := &Bulk{
+		Data:    []byte("data"),
+		Blob:    Blob{1, 2, 3},
+		Hash:    [4]byte{0xde, 0xad, 0xbe, 0xef},
+		Levels:  []Level{-1, 1},
+		Samples: []uint16{0x0102, 0xfffe},
+		Floats:  []float64{math.Pi, math.Inf(-1)},
+		Matrix:  [2][2]int32{{1, -1}, {math.MaxInt32, math.MinInt32}},
+		Points:  []complex64{complex(1, -1)},
+		Big:     []uint32{0x01020304},
+		Chunks:  map[string][]int64{"a": {math.MinInt64, 0, math.MaxInt64}},
+	}
+
+	var buf bytes.Buffer
+	if _, err := s.WriteTo(&buf); err != nil {
+		panic("bulk.go: " + err.Error())
+	}
+	encoded := append([]byte(nil), buf.Bytes()...)
+
+	o := new(Bulk)
+	if _, err := o.ReadFrom(&buf); err != nil {
+		panic("bulk.go: " + err.Error())
+	}
+	if diff := cmp.Diff(s, o, cmpopts.EquateEmpty()); diff != "" {
+		panic("bulk.go: \n" + diff)
+	}
+
+	// the wire order does not depend on the host
+	samples := []byte{0x02, 0x00, 0x02, 0x01, 0xfe, 0xff}
+	if !bytes.Contains(encoded, samples) {
+		panic("bulk.go: samples are not little endian")
+	}
+	big := []byte{0x00, 0x01, 0x01, 0x02, 0x03, 0x04}
+	if !bytes.Contains(encoded, big) {
+		panic("bulk.go: tagged slice is not big endian")
+	}
+
+	// a truncated bulk read reports the bytes consumed
+	n, err := new(Bulk).ReadFrom(bytes.NewReader(encoded[:10]))
+	if err == nil || n != 10 {
+		panic("bulk.go: expected truncated read")
+	}
+}
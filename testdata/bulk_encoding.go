@@ -0,0 +1,1024 @@
+// Code generated by "gobinenc bulk.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Bulk) WriteTo(w io.Writer) (n int64, err error) {
+	size := 38
+	size += len(s.Data) + len(s.Blob) + len(s.Levels) + 2*len(s.Samples) + 8*len(s.Floats) + 8*len(s.Points) + 4*len(s.Big) + 2*len(s.Empty)
+	for k, v := range s.Chunks {
+		size += 4
+		size += len(k) + 8*len(v)
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Data)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Data))
+	}
+	buf[offset] = byte(len(s.Data))
+	buf[offset+1] = byte(len(s.Data) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Data)
+	offset += len(s.Data)
+	if uint64(len(s.Blob)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Blob))
+	}
+	buf[offset] = byte(len(s.Blob))
+	buf[offset+1] = byte(len(s.Blob) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Blob)
+	offset += len(s.Blob)
+	copy(buf[offset:], s.Hash[:])
+	offset += 4
+	if uint64(len(s.Levels)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Levels))
+	}
+	buf[offset] = byte(len(s.Levels))
+	buf[offset+1] = byte(len(s.Levels) >> 8)
+	offset += 2
+	if len(s.Levels) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Levels[0])), len(s.Levels)))
+	}
+	offset += len(s.Levels)
+	if uint64(len(s.Samples)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Samples))
+	}
+	buf[offset] = byte(len(s.Samples))
+	buf[offset+1] = byte(len(s.Samples) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Samples) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Samples[0])), 2*len(s.Samples)))
+		offset += 2 * len(s.Samples)
+	} else {
+		for _, v := range s.Samples {
+			buf[offset] = byte(v)
+			buf[offset+1] = byte(v >> 8)
+			offset += 2
+		}
+	}
+	if uint64(len(s.Floats)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Floats))
+	}
+	buf[offset] = byte(len(s.Floats))
+	buf[offset+1] = byte(len(s.Floats) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Floats) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Floats[0])), 8*len(s.Floats)))
+		offset += 8 * len(s.Floats)
+	} else {
+		for _, v := range s.Floats {
+			buf[offset] = byte(math.Float64bits(v))
+			buf[offset+1] = byte(math.Float64bits(v) >> 8)
+			buf[offset+2] = byte(math.Float64bits(v) >> 16)
+			buf[offset+3] = byte(math.Float64bits(v) >> 24)
+			buf[offset+4] = byte(math.Float64bits(v) >> 32)
+			buf[offset+5] = byte(math.Float64bits(v) >> 40)
+			buf[offset+6] = byte(math.Float64bits(v) >> 48)
+			buf[offset+7] = byte(math.Float64bits(v) >> 56)
+			offset += 8
+		}
+	}
+	for i1 := 0; i1 < 2; i1++ {
+		if binenc.LittleEndian {
+			copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Matrix[i1][0])), 8))
+			offset += 8
+		} else {
+			for i2 := 0; i2 < 2; i2++ {
+				buf[offset] = byte(uint32(s.Matrix[i1][i2]))
+				buf[offset+1] = byte(uint32(s.Matrix[i1][i2]) >> 8)
+				buf[offset+2] = byte(uint32(s.Matrix[i1][i2]) >> 16)
+				buf[offset+3] = byte(uint32(s.Matrix[i1][i2]) >> 24)
+				offset += 4
+			}
+		}
+	}
+	if uint64(len(s.Points)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Points))
+	}
+	buf[offset] = byte(len(s.Points))
+	buf[offset+1] = byte(len(s.Points) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Points) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Points[0])), 8*len(s.Points)))
+		offset += 8 * len(s.Points)
+	} else {
+		for _, v := range s.Points {
+			buf[offset] = byte(math.Float32bits(real(v)))
+			buf[offset+1] = byte(math.Float32bits(real(v)) >> 8)
+			buf[offset+2] = byte(math.Float32bits(real(v)) >> 16)
+			buf[offset+3] = byte(math.Float32bits(real(v)) >> 24)
+			offset += 4
+			buf[offset] = byte(math.Float32bits(imag(v)))
+			buf[offset+1] = byte(math.Float32bits(imag(v)) >> 8)
+			buf[offset+2] = byte(math.Float32bits(imag(v)) >> 16)
+			buf[offset+3] = byte(math.Float32bits(imag(v)) >> 24)
+			offset += 4
+		}
+	}
+	if uint64(len(s.Big)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Big))
+	}
+	buf[offset] = byte(len(s.Big) >> 8)
+	buf[offset+1] = byte(len(s.Big))
+	offset += 2
+	for _, v := range s.Big {
+		buf[offset] = byte(v >> 24)
+		buf[offset+1] = byte(v >> 16)
+		buf[offset+2] = byte(v >> 8)
+		buf[offset+3] = byte(v)
+		offset += 4
+	}
+	if uint64(len(s.Chunks)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Chunks))
+	}
+	buf[offset] = byte(len(s.Chunks))
+	buf[offset+1] = byte(len(s.Chunks) >> 8)
+	offset += 2
+	for k, v := range s.Chunks {
+		if uint64(len(k)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if uint64(len(v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		if binenc.LittleEndian && len(v) > 0 {
+			copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&v[0])), 8*len(v)))
+			offset += 8 * len(v)
+		} else {
+			for _, v1 := range v {
+				buf[offset] = byte(uint64(v1))
+				buf[offset+1] = byte(uint64(v1) >> 8)
+				buf[offset+2] = byte(uint64(v1) >> 16)
+				buf[offset+3] = byte(uint64(v1) >> 24)
+				buf[offset+4] = byte(uint64(v1) >> 32)
+				buf[offset+5] = byte(uint64(v1) >> 40)
+				buf[offset+6] = byte(uint64(v1) >> 48)
+				buf[offset+7] = byte(uint64(v1) >> 56)
+				offset += 8
+			}
+		}
+	}
+	if uint64(len(s.Empty)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Empty))
+	}
+	buf[offset] = byte(len(s.Empty))
+	buf[offset+1] = byte(len(s.Empty) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Empty) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Empty[0])), 2*len(s.Empty)))
+		offset += 2 * len(s.Empty)
+	} else {
+		for _, v := range s.Empty {
+			buf[offset] = byte(v)
+			buf[offset+1] = byte(v >> 8)
+			offset += 2
+		}
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Bulk) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Bulk) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Bulk) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Data")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", n, "Data")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Bulk", n, "Data")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Bulk", n, "Data")
+	}
+	s.Data = make([]byte, size)
+	if nr, err := io.ReadFull(r, s.Data); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Data")
+	}
+	n += int64(size)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Blob")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", n, "Blob")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Bulk", n, "Blob")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Bulk", n, "Blob")
+	}
+	s.Blob = make([]byte, size)
+	if nr, err := io.ReadFull(r, s.Blob); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Blob")
+	}
+	n += int64(size)
+	if nr, err := io.ReadFull(r, s.Hash[:]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Hash")
+	}
+	n += 4
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Levels")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", n, "Levels")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Bulk", n, "Levels")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Bulk", n, "Levels")
+	}
+	s.Levels = make([]Level, size)
+	if size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Levels[0])), size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Levels")
+		}
+		n += int64(size)
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Samples")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", n, "Samples")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Bulk", n, "Samples")
+	}
+	if size > binenc.MaxAlloc/2 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Bulk", n, "Samples")
+	}
+	s.Samples = make([]uint16, size)
+	if binenc.LittleEndian && size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Samples[0])), 2*size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Samples")
+		}
+		n += int64(2 * size)
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Samples[%d]", i)
+			}
+			n += 2
+			s.Samples[i] = uint16(buf[0]) | (uint16(buf[1]) << 8)
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Floats")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", n, "Floats")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Bulk", n, "Floats")
+	}
+	if size > binenc.MaxAlloc/8 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Bulk", n, "Floats")
+	}
+	s.Floats = make([]float64, size)
+	if binenc.LittleEndian && size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Floats[0])), 8*size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Floats")
+		}
+		n += int64(8 * size)
+	} else {
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Floats[%d]", i1)
+			}
+			n += 8
+			s.Floats[i1] = math.Float64frombits(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
+		}
+	}
+	for i2 := 0; i2 < 2; i2++ {
+		if binenc.LittleEndian {
+			if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Matrix[i2][0])), 8)); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Matrix[%d]", i2)
+			}
+			n += 8
+		} else {
+			for i3 := 0; i3 < 2; i3++ {
+				if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+					return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Matrix[%d][%d]", i2, i3)
+				}
+				n += 4
+				s.Matrix[i2][i3] = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+			}
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Points")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", n, "Points")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Bulk", n, "Points")
+	}
+	if size > binenc.MaxAlloc/8 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Bulk", n, "Points")
+	}
+	s.Points = make([]complex64, size)
+	if binenc.LittleEndian && size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Points[0])), 8*size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Points")
+		}
+		n += int64(8 * size)
+	} else {
+		si4 := size
+		for i4 := 0; i4 < si4; i4++ {
+			if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Points[%d]", i4)
+			}
+			n += 4
+			s.Points[i4] = complex(math.Float32frombits(uint32(buf[0])|(uint32(buf[1])<<8)|(uint32(buf[2])<<16)|(uint32(buf[3])<<24)), 0)
+			if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Points[%d]", i4)
+			}
+			n += 4
+			s.Points[i4] = complex(real(s.Points[i4]), math.Float32frombits(uint32(buf[0])|(uint32(buf[1])<<8)|(uint32(buf[2])<<16)|(uint32(buf[3])<<24)))
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Big")
+	}
+	n += 2
+	size = int((uint16(buf[0]) << 8) | uint16(buf[1]))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", n, "Big")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Bulk", n, "Big")
+	}
+	if size > binenc.MaxAlloc/4 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Bulk", n, "Big")
+	}
+	s.Big = make([]uint32, size)
+	si5 := size
+	for i5 := 0; i5 < si5; i5++ {
+		if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Big[%d]", i5)
+		}
+		n += 4
+		s.Big[i5] = (uint32(buf[0]) << 24) | (uint32(buf[1]) << 16) | (uint32(buf[2]) << 8) | uint32(buf[3])
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Chunks")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", n, "Chunks")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Bulk", n, "Chunks")
+	}
+	s.Chunks = make(map[string][]int64, binenc.MapHint(size))
+	si6 := size
+	for i6 := 0; i6 < si6; i6++ {
+		var k6 string
+		var v6 []int64
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Chunks")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Bulk", n, "Chunks")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Bulk", n, "Chunks")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Bulk", n, "Chunks")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Chunks")
+		}
+		n += int64(size)
+		k6 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Chunks[%q]", k6)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", n, "Chunks[%q]", k6)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Bulk", n, "Chunks[%q]", k6)
+		}
+		if size > binenc.MaxAlloc/8 {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Bulk", n, "Chunks[%q]", k6)
+		}
+		v6 = make([]int64, size)
+		if binenc.LittleEndian && size > 0 {
+			if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&v6[0])), 8*size)); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Chunks[%q]", k6)
+			}
+			n += int64(8 * size)
+		} else {
+			si7 := size
+			for i7 := 0; i7 < si7; i7++ {
+				if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+					return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Chunks[%q][%d]", k6, i7)
+				}
+				n += 8
+				v6[i7] = int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
+			}
+		}
+		s.Chunks[k6] = v6
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Empty")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", n, "Empty")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Bulk", n, "Empty")
+	}
+	if size > binenc.MaxAlloc/2 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Bulk", n, "Empty")
+	}
+	s.Empty = make([]uint16, size)
+	if binenc.LittleEndian && size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Empty[0])), 2*size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Empty")
+		}
+		n += int64(2 * size)
+	} else {
+		si8 := size
+		for i8 := 0; i8 < si8; i8++ {
+			if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Bulk", n, "Empty[%d]", i8)
+			}
+			n += 2
+			s.Empty[i8] = uint16(buf[0]) | (uint16(buf[1]) << 8)
+		}
+	}
+	return n, nil
+}
+
+func (s *Bulk) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 38
+	size += len(s.Data) + len(s.Blob) + len(s.Levels) + 2*len(s.Samples) + 8*len(s.Floats) + 8*len(s.Points) + 4*len(s.Big) + 2*len(s.Empty)
+	for k, v := range s.Chunks {
+		size += 4
+		size += len(k) + 8*len(v)
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Data)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Data))
+	}
+	buf[offset] = byte(len(s.Data))
+	buf[offset+1] = byte(len(s.Data) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Data)
+	offset += len(s.Data)
+	if uint64(len(s.Blob)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Blob))
+	}
+	buf[offset] = byte(len(s.Blob))
+	buf[offset+1] = byte(len(s.Blob) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Blob)
+	offset += len(s.Blob)
+	copy(buf[offset:], s.Hash[:])
+	offset += 4
+	if uint64(len(s.Levels)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Levels))
+	}
+	buf[offset] = byte(len(s.Levels))
+	buf[offset+1] = byte(len(s.Levels) >> 8)
+	offset += 2
+	if len(s.Levels) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Levels[0])), len(s.Levels)))
+	}
+	offset += len(s.Levels)
+	if uint64(len(s.Samples)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Samples))
+	}
+	buf[offset] = byte(len(s.Samples))
+	buf[offset+1] = byte(len(s.Samples) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Samples) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Samples[0])), 2*len(s.Samples)))
+		offset += 2 * len(s.Samples)
+	} else {
+		for _, v := range s.Samples {
+			buf[offset] = byte(v)
+			buf[offset+1] = byte(v >> 8)
+			offset += 2
+		}
+	}
+	if uint64(len(s.Floats)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Floats))
+	}
+	buf[offset] = byte(len(s.Floats))
+	buf[offset+1] = byte(len(s.Floats) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Floats) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Floats[0])), 8*len(s.Floats)))
+		offset += 8 * len(s.Floats)
+	} else {
+		for _, v := range s.Floats {
+			buf[offset] = byte(math.Float64bits(v))
+			buf[offset+1] = byte(math.Float64bits(v) >> 8)
+			buf[offset+2] = byte(math.Float64bits(v) >> 16)
+			buf[offset+3] = byte(math.Float64bits(v) >> 24)
+			buf[offset+4] = byte(math.Float64bits(v) >> 32)
+			buf[offset+5] = byte(math.Float64bits(v) >> 40)
+			buf[offset+6] = byte(math.Float64bits(v) >> 48)
+			buf[offset+7] = byte(math.Float64bits(v) >> 56)
+			offset += 8
+		}
+	}
+	for i1 := 0; i1 < 2; i1++ {
+		if binenc.LittleEndian {
+			copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Matrix[i1][0])), 8))
+			offset += 8
+		} else {
+			for i2 := 0; i2 < 2; i2++ {
+				buf[offset] = byte(uint32(s.Matrix[i1][i2]))
+				buf[offset+1] = byte(uint32(s.Matrix[i1][i2]) >> 8)
+				buf[offset+2] = byte(uint32(s.Matrix[i1][i2]) >> 16)
+				buf[offset+3] = byte(uint32(s.Matrix[i1][i2]) >> 24)
+				offset += 4
+			}
+		}
+	}
+	if uint64(len(s.Points)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Points))
+	}
+	buf[offset] = byte(len(s.Points))
+	buf[offset+1] = byte(len(s.Points) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Points) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Points[0])), 8*len(s.Points)))
+		offset += 8 * len(s.Points)
+	} else {
+		for _, v := range s.Points {
+			buf[offset] = byte(math.Float32bits(real(v)))
+			buf[offset+1] = byte(math.Float32bits(real(v)) >> 8)
+			buf[offset+2] = byte(math.Float32bits(real(v)) >> 16)
+			buf[offset+3] = byte(math.Float32bits(real(v)) >> 24)
+			offset += 4
+			buf[offset] = byte(math.Float32bits(imag(v)))
+			buf[offset+1] = byte(math.Float32bits(imag(v)) >> 8)
+			buf[offset+2] = byte(math.Float32bits(imag(v)) >> 16)
+			buf[offset+3] = byte(math.Float32bits(imag(v)) >> 24)
+			offset += 4
+		}
+	}
+	if uint64(len(s.Big)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Big))
+	}
+	buf[offset] = byte(len(s.Big) >> 8)
+	buf[offset+1] = byte(len(s.Big))
+	offset += 2
+	for _, v := range s.Big {
+		buf[offset] = byte(v >> 24)
+		buf[offset+1] = byte(v >> 16)
+		buf[offset+2] = byte(v >> 8)
+		buf[offset+3] = byte(v)
+		offset += 4
+	}
+	if uint64(len(s.Chunks)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Chunks))
+	}
+	buf[offset] = byte(len(s.Chunks))
+	buf[offset+1] = byte(len(s.Chunks) >> 8)
+	offset += 2
+	for k, v := range s.Chunks {
+		if uint64(len(k)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if uint64(len(v)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		if binenc.LittleEndian && len(v) > 0 {
+			copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&v[0])), 8*len(v)))
+			offset += 8 * len(v)
+		} else {
+			for _, v1 := range v {
+				buf[offset] = byte(uint64(v1))
+				buf[offset+1] = byte(uint64(v1) >> 8)
+				buf[offset+2] = byte(uint64(v1) >> 16)
+				buf[offset+3] = byte(uint64(v1) >> 24)
+				buf[offset+4] = byte(uint64(v1) >> 32)
+				buf[offset+5] = byte(uint64(v1) >> 40)
+				buf[offset+6] = byte(uint64(v1) >> 48)
+				buf[offset+7] = byte(uint64(v1) >> 56)
+				offset += 8
+			}
+		}
+	}
+	if uint64(len(s.Empty)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Empty))
+	}
+	buf[offset] = byte(len(s.Empty))
+	buf[offset+1] = byte(len(s.Empty) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Empty) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Empty[0])), 2*len(s.Empty)))
+		offset += 2 * len(s.Empty)
+	} else {
+		for _, v := range s.Empty {
+			buf[offset] = byte(v)
+			buf[offset+1] = byte(v >> 8)
+			offset += 2
+		}
+	}
+	return buf, nil
+}
+
+func (s *Bulk) EncodedSize() int {
+	size := 38
+	size += len(s.Data) + len(s.Blob) + len(s.Levels) + 2*len(s.Samples) + 8*len(s.Floats) + 8*len(s.Points) + 4*len(s.Big) + 2*len(s.Empty)
+	for k, v := range s.Chunks {
+		size += 4
+		size += len(k) + 8*len(v)
+	}
+	return size
+}
+
+func (s *Bulk) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Bulk) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Bulk) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Data")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", int64(offset), "Data")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Data")
+	}
+	s.Data = make([]byte, size)
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Data")
+	}
+	offset += copy(s.Data, data[offset:])
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Blob")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", int64(offset), "Blob")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Blob")
+	}
+	s.Blob = make([]byte, size)
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Blob")
+	}
+	offset += copy(s.Blob, data[offset:])
+	if len(data)-offset < 4 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Hash")
+	}
+	offset += copy(s.Hash[:], data[offset:])
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Levels")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", int64(offset), "Levels")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Levels")
+	}
+	s.Levels = make([]Level, size)
+	if size > 0 {
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Levels")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Levels[0])), size), data[offset:])
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Samples")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", int64(offset), "Samples")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Samples")
+	}
+	s.Samples = make([]uint16, size)
+	if binenc.LittleEndian && size > 0 {
+		if len(data)-offset < 2*size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Samples")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Samples[0])), 2*size), data[offset:])
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if len(data)-offset < 2 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Samples[%d]", i)
+			}
+			buf = data[offset : offset+2]
+			offset += 2
+			s.Samples[i] = uint16(buf[0]) | (uint16(buf[1]) << 8)
+		}
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Floats")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", int64(offset), "Floats")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Floats")
+	}
+	s.Floats = make([]float64, size)
+	if binenc.LittleEndian && size > 0 {
+		if len(data)-offset < 8*size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Floats")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Floats[0])), 8*size), data[offset:])
+	} else {
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if len(data)-offset < 8 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Floats[%d]", i1)
+			}
+			buf = data[offset : offset+8]
+			offset += 8
+			s.Floats[i1] = math.Float64frombits(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
+		}
+	}
+	for i2 := 0; i2 < 2; i2++ {
+		if binenc.LittleEndian {
+			if len(data)-offset < 8 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Matrix[%d]", i2)
+			}
+			offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Matrix[i2][0])), 8), data[offset:])
+		} else {
+			for i3 := 0; i3 < 2; i3++ {
+				if len(data)-offset < 4 {
+					return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Matrix[%d][%d]", i2, i3)
+				}
+				buf = data[offset : offset+4]
+				offset += 4
+				s.Matrix[i2][i3] = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+			}
+		}
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Points")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", int64(offset), "Points")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Points")
+	}
+	s.Points = make([]complex64, size)
+	if binenc.LittleEndian && size > 0 {
+		if len(data)-offset < 8*size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Points")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Points[0])), 8*size), data[offset:])
+	} else {
+		si4 := size
+		for i4 := 0; i4 < si4; i4++ {
+			if len(data)-offset < 8 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Points[%d]", i4)
+			}
+			buf = data[offset : offset+4]
+			offset += 4
+			s.Points[i4] = complex(math.Float32frombits(uint32(buf[0])|(uint32(buf[1])<<8)|(uint32(buf[2])<<16)|(uint32(buf[3])<<24)), 0)
+			buf = data[offset : offset+4]
+			offset += 4
+			s.Points[i4] = complex(real(s.Points[i4]), math.Float32frombits(uint32(buf[0])|(uint32(buf[1])<<8)|(uint32(buf[2])<<16)|(uint32(buf[3])<<24)))
+		}
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Big")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int((uint16(buf[0]) << 8) | uint16(buf[1]))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", int64(offset), "Big")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Big")
+	}
+	s.Big = make([]uint32, size)
+	si5 := size
+	for i5 := 0; i5 < si5; i5++ {
+		if len(data)-offset < 4 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Big[%d]", i5)
+		}
+		buf = data[offset : offset+4]
+		offset += 4
+		s.Big[i5] = (uint32(buf[0]) << 24) | (uint32(buf[1]) << 16) | (uint32(buf[2]) << 8) | uint32(buf[3])
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Chunks")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", int64(offset), "Chunks")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Chunks")
+	}
+	s.Chunks = make(map[string][]int64, size)
+	si6 := size
+	for i6 := 0; i6 < si6; i6++ {
+		var k6 string
+		var v6 []int64
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Chunks")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Bulk", int64(offset), "Chunks")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Chunks")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Chunks")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		k6 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Chunks[%q]", k6)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", int64(offset), "Chunks[%q]", k6)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Chunks[%q]", k6)
+		}
+		v6 = make([]int64, size)
+		if binenc.LittleEndian && size > 0 {
+			if len(data)-offset < 8*size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Chunks[%q]", k6)
+			}
+			offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&v6[0])), 8*size), data[offset:])
+		} else {
+			si7 := size
+			for i7 := 0; i7 < si7; i7++ {
+				if len(data)-offset < 8 {
+					return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Chunks[%q][%d]", k6, i7)
+				}
+				buf = data[offset : offset+8]
+				offset += 8
+				v6[i7] = int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
+			}
+		}
+		s.Chunks[k6] = v6
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Empty")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Bulk", int64(offset), "Empty")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Empty")
+	}
+	s.Empty = make([]uint16, size)
+	if binenc.LittleEndian && size > 0 {
+		if len(data)-offset < 2*size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Empty")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Empty[0])), 2*size), data[offset:])
+	} else {
+		si8 := size
+		for i8 := 0; i8 < si8; i8++ {
+			if len(data)-offset < 2 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Bulk", int64(offset), "Empty[%d]", i8)
+			}
+			buf = data[offset : offset+2]
+			offset += 2
+			s.Empty[i8] = uint16(buf[0]) | (uint16(buf[1]) << 8)
+		}
+	}
+	return offset, nil
+}
+
+func (s *Bulk) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
@@ -3,23 +3,164 @@
 package main
 
 import (
+	"fmt"
 	"io"
-	"unsafe"
+	"math"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
 )
 
-func (s *Complex) WriteTo(w io.Writer) (n int, err error) {
+func (s *Complex) WriteTo(w io.Writer) (n int64, err error) {
 	size := 24
 	buf := make([]byte, size)
 	offset := 0
-	copy(buf[offset:], (*(*[8]byte)(unsafe.Pointer(&(s.Complex64))))[:])
+	buf[offset] = byte(math.Float32bits(real(s.Complex64)))
+	buf[offset+1] = byte(math.Float32bits(real(s.Complex64)) >> 8)
+	buf[offset+2] = byte(math.Float32bits(real(s.Complex64)) >> 16)
+	buf[offset+3] = byte(math.Float32bits(real(s.Complex64)) >> 24)
+	offset += 4
+	buf[offset] = byte(math.Float32bits(imag(s.Complex64)))
+	buf[offset+1] = byte(math.Float32bits(imag(s.Complex64)) >> 8)
+	buf[offset+2] = byte(math.Float32bits(imag(s.Complex64)) >> 16)
+	buf[offset+3] = byte(math.Float32bits(imag(s.Complex64)) >> 24)
+	offset += 4
+	buf[offset] = byte(math.Float64bits(real(s.Complex128)))
+	buf[offset+1] = byte(math.Float64bits(real(s.Complex128)) >> 8)
+	buf[offset+2] = byte(math.Float64bits(real(s.Complex128)) >> 16)
+	buf[offset+3] = byte(math.Float64bits(real(s.Complex128)) >> 24)
+	buf[offset+4] = byte(math.Float64bits(real(s.Complex128)) >> 32)
+	buf[offset+5] = byte(math.Float64bits(real(s.Complex128)) >> 40)
+	buf[offset+6] = byte(math.Float64bits(real(s.Complex128)) >> 48)
+	buf[offset+7] = byte(math.Float64bits(real(s.Complex128)) >> 56)
+	offset += 8
+	buf[offset] = byte(math.Float64bits(imag(s.Complex128)))
+	buf[offset+1] = byte(math.Float64bits(imag(s.Complex128)) >> 8)
+	buf[offset+2] = byte(math.Float64bits(imag(s.Complex128)) >> 16)
+	buf[offset+3] = byte(math.Float64bits(imag(s.Complex128)) >> 24)
+	buf[offset+4] = byte(math.Float64bits(imag(s.Complex128)) >> 32)
+	buf[offset+5] = byte(math.Float64bits(imag(s.Complex128)) >> 40)
+	buf[offset+6] = byte(math.Float64bits(imag(s.Complex128)) >> 48)
+	buf[offset+7] = byte(math.Float64bits(imag(s.Complex128)) >> 56)
+	offset += 8
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Complex) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Complex) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Complex) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Complex", n, "Complex64")
+	}
+	n += 4
+	s.Complex64 = complex(math.Float32frombits(uint32(buf[0])|(uint32(buf[1])<<8)|(uint32(buf[2])<<16)|(uint32(buf[3])<<24)), 0)
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Complex", n, "Complex64")
+	}
+	n += 4
+	s.Complex64 = complex(real(s.Complex64), math.Float32frombits(uint32(buf[0])|(uint32(buf[1])<<8)|(uint32(buf[2])<<16)|(uint32(buf[3])<<24)))
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Complex", n, "Complex128")
+	}
+	n += 8
+	s.Complex128 = complex(math.Float64frombits(uint64(buf[0])|(uint64(buf[1])<<8)|(uint64(buf[2])<<16)|(uint64(buf[3])<<24)|(uint64(buf[4])<<32)|(uint64(buf[5])<<40)|(uint64(buf[6])<<48)|(uint64(buf[7])<<56)), 0)
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Complex", n, "Complex128")
+	}
+	n += 8
+	s.Complex128 = complex(real(s.Complex128), math.Float64frombits(uint64(buf[0])|(uint64(buf[1])<<8)|(uint64(buf[2])<<16)|(uint64(buf[3])<<24)|(uint64(buf[4])<<32)|(uint64(buf[5])<<40)|(uint64(buf[6])<<48)|(uint64(buf[7])<<56)))
+	return n, nil
+}
+
+func (s *Complex) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 24
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(math.Float32bits(real(s.Complex64)))
+	buf[offset+1] = byte(math.Float32bits(real(s.Complex64)) >> 8)
+	buf[offset+2] = byte(math.Float32bits(real(s.Complex64)) >> 16)
+	buf[offset+3] = byte(math.Float32bits(real(s.Complex64)) >> 24)
+	offset += 4
+	buf[offset] = byte(math.Float32bits(imag(s.Complex64)))
+	buf[offset+1] = byte(math.Float32bits(imag(s.Complex64)) >> 8)
+	buf[offset+2] = byte(math.Float32bits(imag(s.Complex64)) >> 16)
+	buf[offset+3] = byte(math.Float32bits(imag(s.Complex64)) >> 24)
+	offset += 4
+	buf[offset] = byte(math.Float64bits(real(s.Complex128)))
+	buf[offset+1] = byte(math.Float64bits(real(s.Complex128)) >> 8)
+	buf[offset+2] = byte(math.Float64bits(real(s.Complex128)) >> 16)
+	buf[offset+3] = byte(math.Float64bits(real(s.Complex128)) >> 24)
+	buf[offset+4] = byte(math.Float64bits(real(s.Complex128)) >> 32)
+	buf[offset+5] = byte(math.Float64bits(real(s.Complex128)) >> 40)
+	buf[offset+6] = byte(math.Float64bits(real(s.Complex128)) >> 48)
+	buf[offset+7] = byte(math.Float64bits(real(s.Complex128)) >> 56)
+	offset += 8
+	buf[offset] = byte(math.Float64bits(imag(s.Complex128)))
+	buf[offset+1] = byte(math.Float64bits(imag(s.Complex128)) >> 8)
+	buf[offset+2] = byte(math.Float64bits(imag(s.Complex128)) >> 16)
+	buf[offset+3] = byte(math.Float64bits(imag(s.Complex128)) >> 24)
+	buf[offset+4] = byte(math.Float64bits(imag(s.Complex128)) >> 32)
+	buf[offset+5] = byte(math.Float64bits(imag(s.Complex128)) >> 40)
+	buf[offset+6] = byte(math.Float64bits(imag(s.Complex128)) >> 48)
+	buf[offset+7] = byte(math.Float64bits(imag(s.Complex128)) >> 56)
+	offset += 8
+	return buf, nil
+}
+
+func (s *Complex) EncodedSize() int {
+	size := 24
+	return size
+}
+
+func (s *Complex) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Complex) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Complex) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	if len(data)-offset < 24 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Complex", int64(offset), "Complex64")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Complex64 = complex(math.Float32frombits(uint32(buf[0])|(uint32(buf[1])<<8)|(uint32(buf[2])<<16)|(uint32(buf[3])<<24)), 0)
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Complex64 = complex(real(s.Complex64), math.Float32frombits(uint32(buf[0])|(uint32(buf[1])<<8)|(uint32(buf[2])<<16)|(uint32(buf[3])<<24)))
+	buf = data[offset : offset+8]
+	offset += 8
+	s.Complex128 = complex(math.Float64frombits(uint64(buf[0])|(uint64(buf[1])<<8)|(uint64(buf[2])<<16)|(uint64(buf[3])<<24)|(uint64(buf[4])<<32)|(uint64(buf[5])<<40)|(uint64(buf[6])<<48)|(uint64(buf[7])<<56)), 0)
+	buf = data[offset : offset+8]
 	offset += 8
-	copy(buf[offset:], (*(*[16]byte)(unsafe.Pointer(&(s.Complex128))))[:])
-	offset += 16
-	return w.Write(buf)
+	s.Complex128 = complex(real(s.Complex128), math.Float64frombits(uint64(buf[0])|(uint64(buf[1])<<8)|(uint64(buf[2])<<16)|(uint64(buf[3])<<24)|(uint64(buf[4])<<32)|(uint64(buf[5])<<40)|(uint64(buf[6])<<48)|(uint64(buf[7])<<56)))
+	return offset, nil
 }
 
-func (s *Complex) ReadFrom(r io.Reader) error {
-	r.Read((*(*[8]byte)(unsafe.Pointer(&(s.Complex64))))[:])
-	r.Read((*(*[16]byte)(unsafe.Pointer(&(s.Complex128))))[:])
+func (s *Complex) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
 	return nil
 }
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+//go:generate go-binenc-gen -lensize 8 corrupt.go
+type Corrupt struct {
+	Values []uint64
+	Name   string
+	Attrs  map[uint32]uint64
+	Names  []string
+	Counts map[uint64]uint64
+}
+
+// lengths returns the encoding of the given lengths, each in 8 bytes.
+func lengths(l ...uint64) []byte {
+	var data []byte
+	for _, n := range l {
+		data = binary.LittleEndian.AppendUint64(data, n)
+	}
+	return data
+}
+
+func main() {
+	// plain ReadFrom reports corrupt lengths rather than panicking or
+	// exhausting the memory
+	for _, c := range []struct {
+		data  []byte
+		field string
+		want  error
+	}{
+		{lengths(math.MaxUint64), "Values", nil},
+		{lengths(1 << 62), "Values", binenc.ErrTooLarge},
+		{lengths(binenc.MaxAlloc/8 + 1), "Values", binenc.ErrTooLarge},
+		{lengths(1000, 1), "Values", io.ErrUnexpectedEOF},
+		{lengths(0, 1<<40), "Name", binenc.ErrTooLarge},
+		{lengths(0, 1000), "Name", io.ErrUnexpectedEOF},
+		{lengths(0, 0, 1<<40), "Attrs", io.ErrUnexpectedEOF},
+		{lengths(0, 0, 0, 1<<30), "Names", binenc.ErrTooLarge},
+		{lengths(0, 0, 0, 1, 1<<40), "Names[0]", binenc.ErrTooLarge},
+		// a map takes more memory than its entries, and grows with the
+		// entries read instead
+		{append(lengths(0, 0, 0, 0, 1<<26), 1), "Counts", io.ErrUnexpectedEOF},
+	} {
+		_, err := new(Corrupt).ReadFrom(bytes.NewReader(c.data))
+		var decodeErr *binenc.DecodeError
+		if !errors.As(err, &decodeErr) || decodeErr.Field != c.field {
+			panic(fmt.Sprintf("corrupt.go: ReadFrom(%x) = %v; want an error at %s", c.data, err, c.field))
+		}
+		if c.want != nil && !errors.Is(err, c.want) {
+			panic(fmt.Sprintf("corrupt.go: ReadFrom(%x) = %v; want %v", c.data, err, c.want))
+		}
+	}
+}
@@ -0,0 +1,670 @@
+// Code generated by "gobinenc -lensize 8 corrupt.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Corrupt) WriteTo(w io.Writer) (n int64, err error) {
+	size := 40
+	size += 8*len(s.Values) + len(s.Name) + 12*len(s.Attrs) + 16*len(s.Counts)
+	for _, v := range s.Names {
+		size += 8
+		size += len(v)
+	}
+	buf := make([]byte, size)
+	offset := 0
+	buf[offset] = byte(len(s.Values))
+	buf[offset+1] = byte(len(s.Values) >> 8)
+	buf[offset+2] = byte(len(s.Values) >> 16)
+	buf[offset+3] = byte(len(s.Values) >> 24)
+	buf[offset+4] = byte(len(s.Values) >> 32)
+	buf[offset+5] = byte(len(s.Values) >> 40)
+	buf[offset+6] = byte(len(s.Values) >> 48)
+	buf[offset+7] = byte(len(s.Values) >> 56)
+	offset += 8
+	if binenc.LittleEndian && len(s.Values) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 8*len(s.Values)))
+		offset += 8 * len(s.Values)
+	} else {
+		for _, v := range s.Values {
+			buf[offset] = byte(v)
+			buf[offset+1] = byte(v >> 8)
+			buf[offset+2] = byte(v >> 16)
+			buf[offset+3] = byte(v >> 24)
+			buf[offset+4] = byte(v >> 32)
+			buf[offset+5] = byte(v >> 40)
+			buf[offset+6] = byte(v >> 48)
+			buf[offset+7] = byte(v >> 56)
+			offset += 8
+		}
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	buf[offset+2] = byte(len(s.Name) >> 16)
+	buf[offset+3] = byte(len(s.Name) >> 24)
+	buf[offset+4] = byte(len(s.Name) >> 32)
+	buf[offset+5] = byte(len(s.Name) >> 40)
+	buf[offset+6] = byte(len(s.Name) >> 48)
+	buf[offset+7] = byte(len(s.Name) >> 56)
+	offset += 8
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	buf[offset] = byte(len(s.Attrs))
+	buf[offset+1] = byte(len(s.Attrs) >> 8)
+	buf[offset+2] = byte(len(s.Attrs) >> 16)
+	buf[offset+3] = byte(len(s.Attrs) >> 24)
+	buf[offset+4] = byte(len(s.Attrs) >> 32)
+	buf[offset+5] = byte(len(s.Attrs) >> 40)
+	buf[offset+6] = byte(len(s.Attrs) >> 48)
+	buf[offset+7] = byte(len(s.Attrs) >> 56)
+	offset += 8
+	for k, v := range s.Attrs {
+		buf[offset] = byte(k)
+		buf[offset+1] = byte(k >> 8)
+		buf[offset+2] = byte(k >> 16)
+		buf[offset+3] = byte(k >> 24)
+		offset += 4
+		buf[offset] = byte(v)
+		buf[offset+1] = byte(v >> 8)
+		buf[offset+2] = byte(v >> 16)
+		buf[offset+3] = byte(v >> 24)
+		buf[offset+4] = byte(v >> 32)
+		buf[offset+5] = byte(v >> 40)
+		buf[offset+6] = byte(v >> 48)
+		buf[offset+7] = byte(v >> 56)
+		offset += 8
+	}
+	buf[offset] = byte(len(s.Names))
+	buf[offset+1] = byte(len(s.Names) >> 8)
+	buf[offset+2] = byte(len(s.Names) >> 16)
+	buf[offset+3] = byte(len(s.Names) >> 24)
+	buf[offset+4] = byte(len(s.Names) >> 32)
+	buf[offset+5] = byte(len(s.Names) >> 40)
+	buf[offset+6] = byte(len(s.Names) >> 48)
+	buf[offset+7] = byte(len(s.Names) >> 56)
+	offset += 8
+	for _, v := range s.Names {
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		buf[offset+2] = byte(len(v) >> 16)
+		buf[offset+3] = byte(len(v) >> 24)
+		buf[offset+4] = byte(len(v) >> 32)
+		buf[offset+5] = byte(len(v) >> 40)
+		buf[offset+6] = byte(len(v) >> 48)
+		buf[offset+7] = byte(len(v) >> 56)
+		offset += 8
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	buf[offset] = byte(len(s.Counts))
+	buf[offset+1] = byte(len(s.Counts) >> 8)
+	buf[offset+2] = byte(len(s.Counts) >> 16)
+	buf[offset+3] = byte(len(s.Counts) >> 24)
+	buf[offset+4] = byte(len(s.Counts) >> 32)
+	buf[offset+5] = byte(len(s.Counts) >> 40)
+	buf[offset+6] = byte(len(s.Counts) >> 48)
+	buf[offset+7] = byte(len(s.Counts) >> 56)
+	offset += 8
+	for k, v := range s.Counts {
+		buf[offset] = byte(k)
+		buf[offset+1] = byte(k >> 8)
+		buf[offset+2] = byte(k >> 16)
+		buf[offset+3] = byte(k >> 24)
+		buf[offset+4] = byte(k >> 32)
+		buf[offset+5] = byte(k >> 40)
+		buf[offset+6] = byte(k >> 48)
+		buf[offset+7] = byte(k >> 56)
+		offset += 8
+		buf[offset] = byte(v)
+		buf[offset+1] = byte(v >> 8)
+		buf[offset+2] = byte(v >> 16)
+		buf[offset+3] = byte(v >> 24)
+		buf[offset+4] = byte(v >> 32)
+		buf[offset+5] = byte(v >> 40)
+		buf[offset+6] = byte(v >> 48)
+		buf[offset+7] = byte(v >> 56)
+		offset += 8
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Corrupt) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Corrupt) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Corrupt) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Corrupt", n, "Values")
+	}
+	n += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Corrupt", n, "Values")
+	} else {
+		size = int(x)
+	}
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Corrupt", n, "Values")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Corrupt", n, "Values")
+	}
+	if size > binenc.MaxAlloc/8 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Corrupt", n, "Values")
+	}
+	s.Values = make([]uint64, size)
+	if binenc.LittleEndian && size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 8*size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Corrupt", n, "Values")
+		}
+		n += int64(8 * size)
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Corrupt", n, "Values[%d]", i)
+			}
+			n += 8
+			s.Values[i] = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Corrupt", n, "Name")
+	}
+	n += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Corrupt", n, "Name")
+	} else {
+		size = int(x)
+	}
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Corrupt", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Corrupt", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Corrupt", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Corrupt", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Corrupt", n, "Attrs")
+	}
+	n += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Corrupt", n, "Attrs")
+	} else {
+		size = int(x)
+	}
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Corrupt", n, "Attrs")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Corrupt", n, "Attrs")
+	}
+	s.Attrs = make(map[uint32]uint64, binenc.MapHint(size))
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 uint32
+		var v1 uint64
+		if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Corrupt", n, "Attrs")
+		}
+		n += 4
+		k1 = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Corrupt", n, "Attrs[%v]", k1)
+		}
+		n += 8
+		v1 = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		s.Attrs[k1] = v1
+	}
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Corrupt", n, "Names")
+	}
+	n += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Corrupt", n, "Names")
+	} else {
+		size = int(x)
+	}
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Corrupt", n, "Names")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Corrupt", n, "Names")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Corrupt", n, "Names")
+	}
+	s.Names = make([]string, size)
+	si2 := size
+	for i2 := 0; i2 < si2; i2++ {
+		if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Corrupt", n, "Names[%d]", i2)
+		}
+		n += 8
+		if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Corrupt", n, "Names[%d]", i2)
+		} else {
+			size = int(x)
+		}
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Corrupt", n, "Names[%d]", i2)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Corrupt", n, "Names[%d]", i2)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Corrupt", n, "Names[%d]", i2)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Corrupt", n, "Names[%d]", i2)
+		}
+		n += int64(size)
+		s.Names[i2] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Corrupt", n, "Counts")
+	}
+	n += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Corrupt", n, "Counts")
+	} else {
+		size = int(x)
+	}
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Corrupt", n, "Counts")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Corrupt", n, "Counts")
+	}
+	s.Counts = make(map[uint64]uint64, binenc.MapHint(size))
+	si3 := size
+	for i3 := 0; i3 < si3; i3++ {
+		var k3 uint64
+		var v3 uint64
+		if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Corrupt", n, "Counts")
+		}
+		n += 8
+		k3 = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Corrupt", n, "Counts[%v]", k3)
+		}
+		n += 8
+		v3 = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		s.Counts[k3] = v3
+	}
+	return n, nil
+}
+
+func (s *Corrupt) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 40
+	size += 8*len(s.Values) + len(s.Name) + 12*len(s.Attrs) + 16*len(s.Counts)
+	for _, v := range s.Names {
+		size += 8
+		size += len(v)
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(len(s.Values))
+	buf[offset+1] = byte(len(s.Values) >> 8)
+	buf[offset+2] = byte(len(s.Values) >> 16)
+	buf[offset+3] = byte(len(s.Values) >> 24)
+	buf[offset+4] = byte(len(s.Values) >> 32)
+	buf[offset+5] = byte(len(s.Values) >> 40)
+	buf[offset+6] = byte(len(s.Values) >> 48)
+	buf[offset+7] = byte(len(s.Values) >> 56)
+	offset += 8
+	if binenc.LittleEndian && len(s.Values) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 8*len(s.Values)))
+		offset += 8 * len(s.Values)
+	} else {
+		for _, v := range s.Values {
+			buf[offset] = byte(v)
+			buf[offset+1] = byte(v >> 8)
+			buf[offset+2] = byte(v >> 16)
+			buf[offset+3] = byte(v >> 24)
+			buf[offset+4] = byte(v >> 32)
+			buf[offset+5] = byte(v >> 40)
+			buf[offset+6] = byte(v >> 48)
+			buf[offset+7] = byte(v >> 56)
+			offset += 8
+		}
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	buf[offset+2] = byte(len(s.Name) >> 16)
+	buf[offset+3] = byte(len(s.Name) >> 24)
+	buf[offset+4] = byte(len(s.Name) >> 32)
+	buf[offset+5] = byte(len(s.Name) >> 40)
+	buf[offset+6] = byte(len(s.Name) >> 48)
+	buf[offset+7] = byte(len(s.Name) >> 56)
+	offset += 8
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	buf[offset] = byte(len(s.Attrs))
+	buf[offset+1] = byte(len(s.Attrs) >> 8)
+	buf[offset+2] = byte(len(s.Attrs) >> 16)
+	buf[offset+3] = byte(len(s.Attrs) >> 24)
+	buf[offset+4] = byte(len(s.Attrs) >> 32)
+	buf[offset+5] = byte(len(s.Attrs) >> 40)
+	buf[offset+6] = byte(len(s.Attrs) >> 48)
+	buf[offset+7] = byte(len(s.Attrs) >> 56)
+	offset += 8
+	for k, v := range s.Attrs {
+		buf[offset] = byte(k)
+		buf[offset+1] = byte(k >> 8)
+		buf[offset+2] = byte(k >> 16)
+		buf[offset+3] = byte(k >> 24)
+		offset += 4
+		buf[offset] = byte(v)
+		buf[offset+1] = byte(v >> 8)
+		buf[offset+2] = byte(v >> 16)
+		buf[offset+3] = byte(v >> 24)
+		buf[offset+4] = byte(v >> 32)
+		buf[offset+5] = byte(v >> 40)
+		buf[offset+6] = byte(v >> 48)
+		buf[offset+7] = byte(v >> 56)
+		offset += 8
+	}
+	buf[offset] = byte(len(s.Names))
+	buf[offset+1] = byte(len(s.Names) >> 8)
+	buf[offset+2] = byte(len(s.Names) >> 16)
+	buf[offset+3] = byte(len(s.Names) >> 24)
+	buf[offset+4] = byte(len(s.Names) >> 32)
+	buf[offset+5] = byte(len(s.Names) >> 40)
+	buf[offset+6] = byte(len(s.Names) >> 48)
+	buf[offset+7] = byte(len(s.Names) >> 56)
+	offset += 8
+	for _, v := range s.Names {
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		buf[offset+2] = byte(len(v) >> 16)
+		buf[offset+3] = byte(len(v) >> 24)
+		buf[offset+4] = byte(len(v) >> 32)
+		buf[offset+5] = byte(len(v) >> 40)
+		buf[offset+6] = byte(len(v) >> 48)
+		buf[offset+7] = byte(len(v) >> 56)
+		offset += 8
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	buf[offset] = byte(len(s.Counts))
+	buf[offset+1] = byte(len(s.Counts) >> 8)
+	buf[offset+2] = byte(len(s.Counts) >> 16)
+	buf[offset+3] = byte(len(s.Counts) >> 24)
+	buf[offset+4] = byte(len(s.Counts) >> 32)
+	buf[offset+5] = byte(len(s.Counts) >> 40)
+	buf[offset+6] = byte(len(s.Counts) >> 48)
+	buf[offset+7] = byte(len(s.Counts) >> 56)
+	offset += 8
+	for k, v := range s.Counts {
+		buf[offset] = byte(k)
+		buf[offset+1] = byte(k >> 8)
+		buf[offset+2] = byte(k >> 16)
+		buf[offset+3] = byte(k >> 24)
+		buf[offset+4] = byte(k >> 32)
+		buf[offset+5] = byte(k >> 40)
+		buf[offset+6] = byte(k >> 48)
+		buf[offset+7] = byte(k >> 56)
+		offset += 8
+		buf[offset] = byte(v)
+		buf[offset+1] = byte(v >> 8)
+		buf[offset+2] = byte(v >> 16)
+		buf[offset+3] = byte(v >> 24)
+		buf[offset+4] = byte(v >> 32)
+		buf[offset+5] = byte(v >> 40)
+		buf[offset+6] = byte(v >> 48)
+		buf[offset+7] = byte(v >> 56)
+		offset += 8
+	}
+	return buf, nil
+}
+
+func (s *Corrupt) EncodedSize() int {
+	size := 40
+	size += 8*len(s.Values) + len(s.Name) + 12*len(s.Attrs) + 16*len(s.Counts)
+	for _, v := range s.Names {
+		size += 8
+		size += len(v)
+	}
+	return size
+}
+
+func (s *Corrupt) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Corrupt) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Corrupt) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 8 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Values")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Corrupt", int64(offset), "Values")
+	} else {
+		size = int(x)
+	}
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Corrupt", int64(offset), "Values")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Values")
+	}
+	s.Values = make([]uint64, size)
+	if binenc.LittleEndian && size > 0 {
+		if len(data)-offset < 8*size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Values")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 8*size), data[offset:])
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if len(data)-offset < 8 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Values[%d]", i)
+			}
+			buf = data[offset : offset+8]
+			offset += 8
+			s.Values[i] = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		}
+	}
+	if len(data)-offset < 8 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Name")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Corrupt", int64(offset), "Name")
+	} else {
+		size = int(x)
+	}
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Corrupt", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 8 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Attrs")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Corrupt", int64(offset), "Attrs")
+	} else {
+		size = int(x)
+	}
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Corrupt", int64(offset), "Attrs")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Attrs")
+	}
+	s.Attrs = make(map[uint32]uint64, size)
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 uint32
+		var v1 uint64
+		if len(data)-offset < 12 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Attrs")
+		}
+		buf = data[offset : offset+4]
+		offset += 4
+		k1 = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		buf = data[offset : offset+8]
+		offset += 8
+		v1 = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		s.Attrs[k1] = v1
+	}
+	if len(data)-offset < 8 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Names")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Corrupt", int64(offset), "Names")
+	} else {
+		size = int(x)
+	}
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Corrupt", int64(offset), "Names")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Names")
+	}
+	s.Names = make([]string, size)
+	si2 := size
+	for i2 := 0; i2 < si2; i2++ {
+		if len(data)-offset < 8 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Names[%d]", i2)
+		}
+		buf = data[offset : offset+8]
+		offset += 8
+		if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Corrupt", int64(offset), "Names[%d]", i2)
+		} else {
+			size = int(x)
+		}
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Corrupt", int64(offset), "Names[%d]", i2)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Names[%d]", i2)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Names[%d]", i2)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Names[i2] = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	}
+	if len(data)-offset < 8 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Counts")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Corrupt", int64(offset), "Counts")
+	} else {
+		size = int(x)
+	}
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Corrupt", int64(offset), "Counts")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Counts")
+	}
+	s.Counts = make(map[uint64]uint64, size)
+	si3 := size
+	for i3 := 0; i3 < si3; i3++ {
+		var k3 uint64
+		var v3 uint64
+		if len(data)-offset < 16 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Corrupt", int64(offset), "Counts")
+		}
+		buf = data[offset : offset+8]
+		offset += 8
+		k3 = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		buf = data[offset : offset+8]
+		offset += 8
+		v3 = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		s.Counts[k3] = v3
+	}
+	return offset, nil
+}
+
+func (s *Corrupt) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+//go:generate go-binenc-gen -lensize uvarint corrupt_varint.go
+type CorruptVarint struct {
+	Values []uint64
+	Name   string
+	Attrs  map[uint32]uint64
+	Names  []string
+}
+
+// uvarints returns the encoding of the given lengths as uvarints.
+func uvarints(l ...uint64) []byte {
+	var data []byte
+	for _, n := range l {
+		data = binary.AppendUvarint(data, n)
+	}
+	return data
+}
+
+func main() {
+	// plain ReadFrom reports corrupt lengths rather than panicking or
+	// exhausting the memory
+	for _, c := range []struct {
+		data  []byte
+		field string
+		want  error
+	}{
+		{uvarints(math.MaxUint64), "Values", nil},
+		{uvarints(math.MaxInt64), "Values", binenc.ErrTooLarge},
+		{uvarints(binenc.MaxAlloc/8 + 1), "Values", binenc.ErrTooLarge},
+		{uvarints(1000, 1), "Values", io.ErrUnexpectedEOF},
+		{[]byte{0xff, 0xff}, "Values", io.ErrUnexpectedEOF},
+		{uvarints(0, 1<<40), "Name", binenc.ErrTooLarge},
+		{uvarints(0, 1000), "Name", io.ErrUnexpectedEOF},
+		{uvarints(0, 0, 1<<40), "Attrs", io.ErrUnexpectedEOF},
+		{uvarints(0, 0, 0, 1<<30), "Names", binenc.ErrTooLarge},
+		{uvarints(0, 0, 0, 1, 1<<40), "Names[0]", binenc.ErrTooLarge},
+	} {
+		_, err := new(CorruptVarint).ReadFrom(bytes.NewReader(c.data))
+		var decodeErr *binenc.DecodeError
+		if !errors.As(err, &decodeErr) || decodeErr.Field != c.field {
+			panic(fmt.Sprintf("corrupt_varint.go: ReadFrom(%x) = %v; want an error at %s", c.data, err, c.field))
+		}
+		if c.want != nil && !errors.Is(err, c.want) {
+			panic(fmt.Sprintf("corrupt_varint.go: ReadFrom(%x) = %v; want %v", c.data, err, c.want))
+		}
+	}
+}
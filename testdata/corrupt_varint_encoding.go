@@ -0,0 +1,559 @@
+// Code generated by "gobinenc -lensize uvarint corrupt_varint.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *CorruptVarint) WriteTo(w io.Writer) (n int64, err error) {
+	size := 0
+	size += (bits.Len64(uint64(len(s.Values))|1)+6)/7 + 8*len(s.Values) + (bits.Len64(uint64(len(s.Name))|1)+6)/7 + len(s.Name) + (bits.Len64(uint64(len(s.Attrs))|1)+6)/7 + 12*len(s.Attrs) + (bits.Len64(uint64(len(s.Names))|1)+6)/7
+	for _, v := range s.Names {
+		size += (bits.Len64(uint64(len(v))|1)+6)/7 + len(v)
+	}
+	buf := make([]byte, size)
+	offset := 0
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Values)))
+	if binenc.LittleEndian && len(s.Values) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 8*len(s.Values)))
+		offset += 8 * len(s.Values)
+	} else {
+		for _, v := range s.Values {
+			buf[offset] = byte(v)
+			buf[offset+1] = byte(v >> 8)
+			buf[offset+2] = byte(v >> 16)
+			buf[offset+3] = byte(v >> 24)
+			buf[offset+4] = byte(v >> 32)
+			buf[offset+5] = byte(v >> 40)
+			buf[offset+6] = byte(v >> 48)
+			buf[offset+7] = byte(v >> 56)
+			offset += 8
+		}
+	}
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Name)))
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Attrs)))
+	for k, v := range s.Attrs {
+		buf[offset] = byte(k)
+		buf[offset+1] = byte(k >> 8)
+		buf[offset+2] = byte(k >> 16)
+		buf[offset+3] = byte(k >> 24)
+		offset += 4
+		buf[offset] = byte(v)
+		buf[offset+1] = byte(v >> 8)
+		buf[offset+2] = byte(v >> 16)
+		buf[offset+3] = byte(v >> 24)
+		buf[offset+4] = byte(v >> 32)
+		buf[offset+5] = byte(v >> 40)
+		buf[offset+6] = byte(v >> 48)
+		buf[offset+7] = byte(v >> 56)
+		offset += 8
+	}
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Names)))
+	for _, v := range s.Names {
+		offset += binary.PutUvarint(buf[offset:], uint64(len(v)))
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *CorruptVarint) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *CorruptVarint) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *CorruptVarint) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var usize uint64
+	var tmp []byte
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "CorruptVarint", n, "Values")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "CorruptVarint", n, "Values")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "CorruptVarint", n, "Values")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "CorruptVarint", n, "Values")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "CorruptVarint", n, "Values")
+	}
+	if size > binenc.MaxAlloc/8 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "CorruptVarint", n, "Values")
+	}
+	s.Values = make([]uint64, size)
+	if binenc.LittleEndian && size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 8*size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "CorruptVarint", n, "Values")
+		}
+		n += int64(8 * size)
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "CorruptVarint", n, "Values[%d]", i)
+			}
+			n += 8
+			s.Values[i] = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		}
+	}
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "CorruptVarint", n, "Name")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "CorruptVarint", n, "Name")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "CorruptVarint", n, "Name")
+	}
+	size = int(usize)
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "CorruptVarint", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "CorruptVarint", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "CorruptVarint", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "CorruptVarint", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "CorruptVarint", n, "Attrs")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "CorruptVarint", n, "Attrs")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "CorruptVarint", n, "Attrs")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "CorruptVarint", n, "Attrs")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "CorruptVarint", n, "Attrs")
+	}
+	s.Attrs = make(map[uint32]uint64, binenc.MapHint(size))
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 uint32
+		var v1 uint64
+		if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "CorruptVarint", n, "Attrs")
+		}
+		n += 4
+		k1 = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "CorruptVarint", n, "Attrs[%v]", k1)
+		}
+		n += 8
+		v1 = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		s.Attrs[k1] = v1
+	}
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "CorruptVarint", n, "Names")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "CorruptVarint", n, "Names")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "CorruptVarint", n, "Names")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "CorruptVarint", n, "Names")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "CorruptVarint", n, "Names")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "CorruptVarint", n, "Names")
+	}
+	s.Names = make([]string, size)
+	si2 := size
+	for i2 := 0; i2 < si2; i2++ {
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "CorruptVarint", n, "Names[%d]", i2)
+			}
+			n += 1
+			if shift == 63 && buf[0] > 1 {
+				return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "CorruptVarint", n, "Names[%d]", i2)
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if usize > math.MaxInt {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "CorruptVarint", n, "Names[%d]", i2)
+		}
+		size = int(usize)
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "CorruptVarint", n, "Names[%d]", i2)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "CorruptVarint", n, "Names[%d]", i2)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "CorruptVarint", n, "Names[%d]", i2)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "CorruptVarint", n, "Names[%d]", i2)
+		}
+		n += int64(size)
+		s.Names[i2] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	return n, nil
+}
+
+func (s *CorruptVarint) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 0
+	size += (bits.Len64(uint64(len(s.Values))|1)+6)/7 + 8*len(s.Values) + (bits.Len64(uint64(len(s.Name))|1)+6)/7 + len(s.Name) + (bits.Len64(uint64(len(s.Attrs))|1)+6)/7 + 12*len(s.Attrs) + (bits.Len64(uint64(len(s.Names))|1)+6)/7
+	for _, v := range s.Names {
+		size += (bits.Len64(uint64(len(v))|1)+6)/7 + len(v)
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Values)))
+	if binenc.LittleEndian && len(s.Values) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 8*len(s.Values)))
+		offset += 8 * len(s.Values)
+	} else {
+		for _, v := range s.Values {
+			buf[offset] = byte(v)
+			buf[offset+1] = byte(v >> 8)
+			buf[offset+2] = byte(v >> 16)
+			buf[offset+3] = byte(v >> 24)
+			buf[offset+4] = byte(v >> 32)
+			buf[offset+5] = byte(v >> 40)
+			buf[offset+6] = byte(v >> 48)
+			buf[offset+7] = byte(v >> 56)
+			offset += 8
+		}
+	}
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Name)))
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Attrs)))
+	for k, v := range s.Attrs {
+		buf[offset] = byte(k)
+		buf[offset+1] = byte(k >> 8)
+		buf[offset+2] = byte(k >> 16)
+		buf[offset+3] = byte(k >> 24)
+		offset += 4
+		buf[offset] = byte(v)
+		buf[offset+1] = byte(v >> 8)
+		buf[offset+2] = byte(v >> 16)
+		buf[offset+3] = byte(v >> 24)
+		buf[offset+4] = byte(v >> 32)
+		buf[offset+5] = byte(v >> 40)
+		buf[offset+6] = byte(v >> 48)
+		buf[offset+7] = byte(v >> 56)
+		offset += 8
+	}
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Names)))
+	for _, v := range s.Names {
+		offset += binary.PutUvarint(buf[offset:], uint64(len(v)))
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	return buf, nil
+}
+
+func (s *CorruptVarint) EncodedSize() int {
+	size := 0
+	size += (bits.Len64(uint64(len(s.Values))|1)+6)/7 + 8*len(s.Values) + (bits.Len64(uint64(len(s.Name))|1)+6)/7 + len(s.Name) + (bits.Len64(uint64(len(s.Attrs))|1)+6)/7 + 12*len(s.Attrs) + (bits.Len64(uint64(len(s.Names))|1)+6)/7
+	for _, v := range s.Names {
+		size += (bits.Len64(uint64(len(v))|1)+6)/7 + len(v)
+	}
+	return size
+}
+
+func (s *CorruptVarint) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *CorruptVarint) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *CorruptVarint) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var usize uint64
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "CorruptVarint", int64(offset), "Values")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "CorruptVarint", int64(offset), "Values")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "CorruptVarint", int64(offset), "Values")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "CorruptVarint", int64(offset), "Values")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "CorruptVarint", int64(offset), "Values")
+	}
+	s.Values = make([]uint64, size)
+	if binenc.LittleEndian && size > 0 {
+		if len(data)-offset < 8*size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "CorruptVarint", int64(offset), "Values")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 8*size), data[offset:])
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if len(data)-offset < 8 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "CorruptVarint", int64(offset), "Values[%d]", i)
+			}
+			buf = data[offset : offset+8]
+			offset += 8
+			s.Values[i] = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		}
+	}
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "CorruptVarint", int64(offset), "Name")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "CorruptVarint", int64(offset), "Name")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "CorruptVarint", int64(offset), "Name")
+	}
+	size = int(usize)
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "CorruptVarint", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "CorruptVarint", int64(offset), "Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "CorruptVarint", int64(offset), "Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "CorruptVarint", int64(offset), "Attrs")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "CorruptVarint", int64(offset), "Attrs")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "CorruptVarint", int64(offset), "Attrs")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "CorruptVarint", int64(offset), "Attrs")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "CorruptVarint", int64(offset), "Attrs")
+	}
+	s.Attrs = make(map[uint32]uint64, size)
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 uint32
+		var v1 uint64
+		if len(data)-offset < 12 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "CorruptVarint", int64(offset), "Attrs")
+		}
+		buf = data[offset : offset+4]
+		offset += 4
+		k1 = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		buf = data[offset : offset+8]
+		offset += 8
+		v1 = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+		s.Attrs[k1] = v1
+	}
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "CorruptVarint", int64(offset), "Names")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "CorruptVarint", int64(offset), "Names")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "CorruptVarint", int64(offset), "Names")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "CorruptVarint", int64(offset), "Names")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "CorruptVarint", int64(offset), "Names")
+	}
+	s.Names = make([]string, size)
+	si2 := size
+	for i2 := 0; i2 < si2; i2++ {
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if len(data)-offset < 1 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "CorruptVarint", int64(offset), "Names[%d]", i2)
+			}
+			buf = data[offset : offset+1]
+			offset += 1
+			if shift == 63 && buf[0] > 1 {
+				return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "CorruptVarint", int64(offset), "Names[%d]", i2)
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if usize > math.MaxInt {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "CorruptVarint", int64(offset), "Names[%d]", i2)
+		}
+		size = int(usize)
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "CorruptVarint", int64(offset), "Names[%d]", i2)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "CorruptVarint", int64(offset), "Names[%d]", i2)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "CorruptVarint", int64(offset), "Names[%d]", i2)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Names[i2] = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	}
+	return offset, nil
+}
+
+func (s *CorruptVarint) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+type Inner struct {
+	Str  string
+	Arr4 [4]string
+}
+
+type Node struct {
+	Name     string
+	Children []Node
+}
+
+//go:generate go-binenc-gen decodeerror.go
+type Outer struct {
+	ID     uint32
+	Inners []Inner
+	Attrs  map[string]*Inner
+	Root   Node
+}
+
+// decodeErrors decodes data with ReadFrom and DecodeFrom, and returns
+// their errors.
+func decodeErrors(data []byte) (rerr, derr *binenc.DecodeError) {
+	_, err := new(Outer).ReadFrom(bytes.NewReader(data))
+	if !errors.As(err, &rerr) || !errors.Is(err, io.ErrUnexpectedEOF) {
+		panic(fmt.Sprintf("decodeerror.go: ReadFrom = %v, want an unexpected EOF DecodeError", err))
+	}
+	_, err = new(Outer).DecodeFrom(data)
+	if !errors.As(err, &derr) || !errors.Is(err, io.ErrUnexpectedEOF) {
+		panic(fmt.Sprintf("decodeerror.go: DecodeFrom = %v, want an unexpected EOF DecodeError", err))
+	}
+	return rerr, derr
+}
+
+func main() {
+	s := &Outer{
+		ID: 1,
+		Inners: []Inner{
+			{Str: "a"}, {Str: "b"}, {Str: "c"},
+			{Str: "d", Arr4: [4]string{"w", "x", "y", "z"}},
+		},
+		Attrs: map[string]*Inner{"key": {Str: "e"}},
+		Root: Node{
+			Name:     "root",
+			Children: []Node{{Name: "leaf", Children: []Node{{Name: "deep"}}}},
+		},
+	}
+	data, err := s.MarshalBinary()
+	if err != nil {
+		panic("decodeerror.go: " + err.Error())
+	}
+
+	// an empty input is still a clean end of stream
+	if _, err := new(Outer).ReadFrom(bytes.NewReader(nil)); err != io.EOF {
+		panic(fmt.Sprintf("decodeerror.go: ReadFrom(empty) = %v, want EOF", err))
+	}
+
+	cases := []struct {
+		n      int
+		path   string
+		offset int64
+		// fixed size reads from data are checked by run, whose first
+		// field is reported when it differs
+		runPath   string
+		runOffset int64
+	}{
+		{n: 2, path: "Outer.ID", offset: 0},
+		{n: 5, path: "Outer.Inners", offset: 4, runPath: "Outer.ID", runOffset: 0},
+		{n: 46, path: "Outer.Inners[3].Arr4[1]", offset: 45},
+		{n: 55, path: "Outer.Attrs", offset: 54},
+		{n: 58, path: "Outer.Attrs", offset: 58},
+		{n: 63, path: `Outer.Attrs["key"].Str`, offset: 62},
+		{n: 80, path: "Outer.Root.Children", offset: 79},
+		{n: 90, path: "Outer.Root.Children[0].Children[0].Name", offset: 89},
+	}
+	for _, c := range cases {
+		rerr, derr := decodeErrors(data[:c.n])
+		if rerr.Path() != c.path || rerr.Offset != c.offset {
+			panic(fmt.Sprintf("decodeerror.go: ReadFrom(data[:%d]) = %v; want %s at offset %d", c.n, rerr, c.path, c.offset))
+		}
+		if c.runPath != "" {
+			c.path, c.offset = c.runPath, c.runOffset
+		}
+		if derr.Path() != c.path || derr.Offset != c.offset {
+			panic(fmt.Sprintf("decodeerror.go: DecodeFrom(data[:%d]) = %v; want %s at offset %d", c.n, derr, c.path, c.offset))
+		}
+	}
+
+	// as are limits
+	var derr *binenc.DecodeError
+	var limitErr *binenc.LimitError
+	_, err = new(Outer).DecodeFromOptions(data, binenc.DecodeOptions{MaxStringLen: 3})
+	if !errors.As(err, &derr) || !errors.As(err, &limitErr) || derr.Path() != "Outer.Root.Name" {
+		panic(fmt.Sprintf("decodeerror.go: MaxStringLen: %v", err))
+	}
+	_, err = new(Outer).ReadFromOptions(bytes.NewReader(data), binenc.DecodeOptions{MaxDepth: 1})
+	if !errors.As(err, &derr) || !errors.As(err, &limitErr) || derr.Path() != "Outer.Root.Children[0].Children[0]" {
+		panic(fmt.Sprintf("decodeerror.go: MaxDepth: %v", err))
+	}
+}
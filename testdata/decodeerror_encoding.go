@@ -0,0 +1,1347 @@
+// Code generated by "gobinenc decodeerror.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Inner) WriteTo(w io.Writer) (n int64, err error) {
+	size := 2
+	size += len(s.Str)
+	for i1 := 0; i1 < 4; i1++ {
+		size += 2
+		size += len(s.Arr4[i1])
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Str)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Str))
+	}
+	buf[offset] = byte(len(s.Str))
+	buf[offset+1] = byte(len(s.Str) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Str)
+	offset += len(s.Str)
+	for i1 := 0; i1 < 4; i1++ {
+		if uint64(len(s.Arr4[i1])) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Arr4[i1]))
+		}
+		buf[offset] = byte(len(s.Arr4[i1]))
+		buf[offset+1] = byte(len(s.Arr4[i1]) >> 8)
+		offset += 2
+		copy(buf[offset:], s.Arr4[i1])
+		offset += len(s.Arr4[i1])
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Inner) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Inner) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Inner) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Inner", n, "Str")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Inner", n, "Str")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Inner", n, "Str")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Inner", n, "Str")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Inner", n, "Str")
+	}
+	n += int64(size)
+	s.Str = *(*string)(unsafe.Pointer(&tmp))
+	for i := 0; i < 4; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Inner", n, "Arr4[%d]", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Inner", n, "Arr4[%d]", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Inner", n, "Arr4[%d]", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Inner", n, "Arr4[%d]", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Inner", n, "Arr4[%d]", i)
+		}
+		n += int64(size)
+		s.Arr4[i] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	return n, nil
+}
+
+func (s *Inner) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 2
+	size += len(s.Str)
+	for i1 := 0; i1 < 4; i1++ {
+		size += 2
+		size += len(s.Arr4[i1])
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Str)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Str))
+	}
+	buf[offset] = byte(len(s.Str))
+	buf[offset+1] = byte(len(s.Str) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Str)
+	offset += len(s.Str)
+	for i1 := 0; i1 < 4; i1++ {
+		if uint64(len(s.Arr4[i1])) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Arr4[i1]))
+		}
+		buf[offset] = byte(len(s.Arr4[i1]))
+		buf[offset+1] = byte(len(s.Arr4[i1]) >> 8)
+		offset += 2
+		copy(buf[offset:], s.Arr4[i1])
+		offset += len(s.Arr4[i1])
+	}
+	return buf, nil
+}
+
+func (s *Inner) EncodedSize() int {
+	size := 2
+	size += len(s.Str)
+	for i1 := 0; i1 < 4; i1++ {
+		size += 2
+		size += len(s.Arr4[i1])
+	}
+	return size
+}
+
+func (s *Inner) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Inner) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Inner) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Str")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Inner", int64(offset), "Str")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Str")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Str")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Str = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	for i := 0; i < 4; i++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Arr4[%d]", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Inner", int64(offset), "Arr4[%d]", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Arr4[%d]", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Arr4[%d]", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Arr4[i] = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	}
+	return offset, nil
+}
+
+func (s *Inner) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Node) WriteTo(w io.Writer) (n int64, err error) {
+	size := 4
+	size += len(s.Name)
+	for i1 := range s.Children {
+		size += s.Children[i1].sizeBinenc()
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if uint64(len(s.Children)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Children))
+	}
+	buf[offset] = byte(len(s.Children))
+	buf[offset+1] = byte(len(s.Children) >> 8)
+	offset += 2
+	for i1 := range s.Children {
+		offset, err = s.Children[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Node) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Node) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Node) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Name")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Node", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Node", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Node", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Children")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Node", n, "Children")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Node", n, "Children")
+	}
+	if size > binenc.MaxAlloc/40 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Node", n, "Children")
+	}
+	s.Children = make([]Node, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if n, err = s.Children[i].readBinenc(d, n, 1); err != nil {
+			return n, binenc.FieldError(err, "Node", n, "Children[%d]", i)
+		}
+	}
+	return n, nil
+}
+
+func (s *Node) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 4
+	size += len(s.Name)
+	for i1 := range s.Children {
+		size += s.Children[i1].sizeBinenc()
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if uint64(len(s.Children)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Children))
+	}
+	buf[offset] = byte(len(s.Children))
+	buf[offset+1] = byte(len(s.Children) >> 8)
+	offset += 2
+	for i1 := range s.Children {
+		offset, err = s.Children[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return dst, err
+		}
+	}
+	return buf, nil
+}
+
+func (s *Node) EncodedSize() int {
+	size := 4
+	size += len(s.Name)
+	for i1 := range s.Children {
+		size += s.Children[i1].sizeBinenc()
+	}
+	return size
+}
+
+func (s *Node) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Node) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Node) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Name")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Node", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Children")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Node", int64(offset), "Children")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Children")
+	}
+	s.Children = make([]Node, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if offset, err = s.Children[i].decodeBinenc(data, offset, opts, 1); err != nil {
+			return offset, binenc.FieldError(err, "Node", int64(offset), "Children[%d]", i)
+		}
+	}
+	return offset, nil
+}
+
+func (s *Node) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Outer) WriteTo(w io.Writer) (n int64, err error) {
+	size := 12
+	size += len(s.Root.Name)
+	for _, v := range s.Inners {
+		size += 2
+		size += len(v.Str)
+		for i2 := 0; i2 < 4; i2++ {
+			size += 2
+			size += len(v.Arr4[i2])
+		}
+	}
+	for k, v := range s.Attrs {
+		size += 3
+		size += len(k)
+		if v != nil {
+			size += 2
+			size += len((*v).Str)
+			for i3 := 0; i3 < 4; i3++ {
+				size += 2
+				size += len((*v).Arr4[i3])
+			}
+		}
+	}
+	for i1 := range s.Root.Children {
+		size += s.Root.Children[i1].sizeBinenc()
+	}
+	buf := make([]byte, size)
+	offset := 0
+	buf[offset] = byte(s.ID)
+	buf[offset+1] = byte(s.ID >> 8)
+	buf[offset+2] = byte(s.ID >> 16)
+	buf[offset+3] = byte(s.ID >> 24)
+	offset += 4
+	if uint64(len(s.Inners)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Inners))
+	}
+	buf[offset] = byte(len(s.Inners))
+	buf[offset+1] = byte(len(s.Inners) >> 8)
+	offset += 2
+	for _, v := range s.Inners {
+		if uint64(len(v.Str)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Str))
+		}
+		buf[offset] = byte(len(v.Str))
+		buf[offset+1] = byte(len(v.Str) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Str)
+		offset += len(v.Str)
+		for i2 := 0; i2 < 4; i2++ {
+			if uint64(len(v.Arr4[i2])) > math.MaxUint16 {
+				return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Arr4[i2]))
+			}
+			buf[offset] = byte(len(v.Arr4[i2]))
+			buf[offset+1] = byte(len(v.Arr4[i2]) >> 8)
+			offset += 2
+			copy(buf[offset:], v.Arr4[i2])
+			offset += len(v.Arr4[i2])
+		}
+	}
+	if uint64(len(s.Attrs)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Attrs))
+	}
+	buf[offset] = byte(len(s.Attrs))
+	buf[offset+1] = byte(len(s.Attrs) >> 8)
+	offset += 2
+	for k, v := range s.Attrs {
+		if uint64(len(k)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if v != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if v != nil {
+			if uint64(len((*v).Str)) > math.MaxUint16 {
+				return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*v).Str))
+			}
+			buf[offset] = byte(len((*v).Str))
+			buf[offset+1] = byte(len((*v).Str) >> 8)
+			offset += 2
+			copy(buf[offset:], (*v).Str)
+			offset += len((*v).Str)
+			for i3 := 0; i3 < 4; i3++ {
+				if uint64(len((*v).Arr4[i3])) > math.MaxUint16 {
+					return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*v).Arr4[i3]))
+				}
+				buf[offset] = byte(len((*v).Arr4[i3]))
+				buf[offset+1] = byte(len((*v).Arr4[i3]) >> 8)
+				offset += 2
+				copy(buf[offset:], (*v).Arr4[i3])
+				offset += len((*v).Arr4[i3])
+			}
+		}
+	}
+	if uint64(len(s.Root.Name)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Root.Name))
+	}
+	buf[offset] = byte(len(s.Root.Name))
+	buf[offset+1] = byte(len(s.Root.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Root.Name)
+	offset += len(s.Root.Name)
+	if uint64(len(s.Root.Children)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Root.Children))
+	}
+	buf[offset] = byte(len(s.Root.Children))
+	buf[offset+1] = byte(len(s.Root.Children) >> 8)
+	offset += 2
+	for i1 := range s.Root.Children {
+		offset, err = s.Root.Children[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Outer) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Outer) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Outer) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Outer", n, "ID")
+	}
+	n += 4
+	s.ID = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inners")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", n, "Inners")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Inners")
+	}
+	if size > binenc.MaxAlloc/80 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Inners")
+	}
+	s.Inners = make([]Inner, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inners[%d].Str", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", n, "Inners[%d].Str", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Inners[%d].Str", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Inners[%d].Str", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inners[%d].Str", i)
+		}
+		n += int64(size)
+		s.Inners[i].Str = *(*string)(unsafe.Pointer(&tmp))
+		for i1 := 0; i1 < 4; i1++ {
+			if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inners[%d].Arr4[%d]", i, i1)
+			}
+			n += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", n, "Inners[%d].Arr4[%d]", i, i1)
+			}
+			if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Inners[%d].Arr4[%d]", i, i1)
+			}
+			if size > binenc.MaxAlloc {
+				return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Inners[%d].Arr4[%d]", i, i1)
+			}
+			tmp = d.Arena(size)
+			if nr, err := io.ReadFull(r, tmp); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inners[%d].Arr4[%d]", i, i1)
+			}
+			n += int64(size)
+			s.Inners[i].Arr4[i1] = *(*string)(unsafe.Pointer(&tmp))
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Outer", n, "Attrs")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", n, "Attrs")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Attrs")
+	}
+	s.Attrs = make(map[string]*Inner, binenc.MapHint(size))
+	si2 := size
+	for i2 := 0; i2 < si2; i2++ {
+		var k2 string
+		var v2 *Inner
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Outer", n, "Attrs")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", n, "Attrs")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Attrs")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Attrs")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Outer", n, "Attrs")
+		}
+		n += int64(size)
+		k2 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Outer", n, "Attrs[%q]", k2)
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			v2 = new(Inner)
+			if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Outer", n, "Attrs[%q].Str", k2)
+			}
+			n += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", n, "Attrs[%q].Str", k2)
+			}
+			if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Attrs[%q].Str", k2)
+			}
+			if size > binenc.MaxAlloc {
+				return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Attrs[%q].Str", k2)
+			}
+			tmp = d.Arena(size)
+			if nr, err := io.ReadFull(r, tmp); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Outer", n, "Attrs[%q].Str", k2)
+			}
+			n += int64(size)
+			(*v2).Str = *(*string)(unsafe.Pointer(&tmp))
+			for i3 := 0; i3 < 4; i3++ {
+				if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+					return n + int64(nr), binenc.FieldError(err, "Outer", n, "Attrs[%q].Arr4[%d]", k2, i3)
+				}
+				n += 2
+				size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+				if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+					return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", n, "Attrs[%q].Arr4[%d]", k2, i3)
+				}
+				if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+					return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Attrs[%q].Arr4[%d]", k2, i3)
+				}
+				if size > binenc.MaxAlloc {
+					return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Attrs[%q].Arr4[%d]", k2, i3)
+				}
+				tmp = d.Arena(size)
+				if nr, err := io.ReadFull(r, tmp); err != nil {
+					return n + int64(nr), binenc.FieldError(err, "Outer", n, "Attrs[%q].Arr4[%d]", k2, i3)
+				}
+				n += int64(size)
+				(*v2).Arr4[i3] = *(*string)(unsafe.Pointer(&tmp))
+			}
+		} else {
+			v2 = nil
+		}
+		s.Attrs[k2] = v2
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Outer", n, "Root.Name")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", n, "Root.Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Root.Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Root.Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Outer", n, "Root.Name")
+	}
+	n += int64(size)
+	s.Root.Name = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Outer", n, "Root.Children")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", n, "Root.Children")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Root.Children")
+	}
+	if size > binenc.MaxAlloc/40 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Root.Children")
+	}
+	s.Root.Children = make([]Node, size)
+	si4 := size
+	for i4 := 0; i4 < si4; i4++ {
+		if n, err = s.Root.Children[i4].readBinenc(d, n, 1); err != nil {
+			return n, binenc.FieldError(err, "Outer", n, "Root.Children[%d]", i4)
+		}
+	}
+	return n, nil
+}
+
+func (s *Outer) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 12
+	size += len(s.Root.Name)
+	for _, v := range s.Inners {
+		size += 2
+		size += len(v.Str)
+		for i2 := 0; i2 < 4; i2++ {
+			size += 2
+			size += len(v.Arr4[i2])
+		}
+	}
+	for k, v := range s.Attrs {
+		size += 3
+		size += len(k)
+		if v != nil {
+			size += 2
+			size += len((*v).Str)
+			for i3 := 0; i3 < 4; i3++ {
+				size += 2
+				size += len((*v).Arr4[i3])
+			}
+		}
+	}
+	for i1 := range s.Root.Children {
+		size += s.Root.Children[i1].sizeBinenc()
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(s.ID)
+	buf[offset+1] = byte(s.ID >> 8)
+	buf[offset+2] = byte(s.ID >> 16)
+	buf[offset+3] = byte(s.ID >> 24)
+	offset += 4
+	if uint64(len(s.Inners)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Inners))
+	}
+	buf[offset] = byte(len(s.Inners))
+	buf[offset+1] = byte(len(s.Inners) >> 8)
+	offset += 2
+	for _, v := range s.Inners {
+		if uint64(len(v.Str)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Str))
+		}
+		buf[offset] = byte(len(v.Str))
+		buf[offset+1] = byte(len(v.Str) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Str)
+		offset += len(v.Str)
+		for i2 := 0; i2 < 4; i2++ {
+			if uint64(len(v.Arr4[i2])) > math.MaxUint16 {
+				return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Arr4[i2]))
+			}
+			buf[offset] = byte(len(v.Arr4[i2]))
+			buf[offset+1] = byte(len(v.Arr4[i2]) >> 8)
+			offset += 2
+			copy(buf[offset:], v.Arr4[i2])
+			offset += len(v.Arr4[i2])
+		}
+	}
+	if uint64(len(s.Attrs)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Attrs))
+	}
+	buf[offset] = byte(len(s.Attrs))
+	buf[offset+1] = byte(len(s.Attrs) >> 8)
+	offset += 2
+	for k, v := range s.Attrs {
+		if uint64(len(k)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if v != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if v != nil {
+			if uint64(len((*v).Str)) > math.MaxUint16 {
+				return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*v).Str))
+			}
+			buf[offset] = byte(len((*v).Str))
+			buf[offset+1] = byte(len((*v).Str) >> 8)
+			offset += 2
+			copy(buf[offset:], (*v).Str)
+			offset += len((*v).Str)
+			for i3 := 0; i3 < 4; i3++ {
+				if uint64(len((*v).Arr4[i3])) > math.MaxUint16 {
+					return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*v).Arr4[i3]))
+				}
+				buf[offset] = byte(len((*v).Arr4[i3]))
+				buf[offset+1] = byte(len((*v).Arr4[i3]) >> 8)
+				offset += 2
+				copy(buf[offset:], (*v).Arr4[i3])
+				offset += len((*v).Arr4[i3])
+			}
+		}
+	}
+	if uint64(len(s.Root.Name)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Root.Name))
+	}
+	buf[offset] = byte(len(s.Root.Name))
+	buf[offset+1] = byte(len(s.Root.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Root.Name)
+	offset += len(s.Root.Name)
+	if uint64(len(s.Root.Children)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Root.Children))
+	}
+	buf[offset] = byte(len(s.Root.Children))
+	buf[offset+1] = byte(len(s.Root.Children) >> 8)
+	offset += 2
+	for i1 := range s.Root.Children {
+		offset, err = s.Root.Children[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return dst, err
+		}
+	}
+	return buf, nil
+}
+
+func (s *Outer) EncodedSize() int {
+	size := 12
+	size += len(s.Root.Name)
+	for _, v := range s.Inners {
+		size += 2
+		size += len(v.Str)
+		for i2 := 0; i2 < 4; i2++ {
+			size += 2
+			size += len(v.Arr4[i2])
+		}
+	}
+	for k, v := range s.Attrs {
+		size += 3
+		size += len(k)
+		if v != nil {
+			size += 2
+			size += len((*v).Str)
+			for i3 := 0; i3 < 4; i3++ {
+				size += 2
+				size += len((*v).Arr4[i3])
+			}
+		}
+	}
+	for i1 := range s.Root.Children {
+		size += s.Root.Children[i1].sizeBinenc()
+	}
+	return size
+}
+
+func (s *Outer) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Outer) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Outer) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 6 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "ID")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.ID = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", int64(offset), "Inners")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners")
+	}
+	s.Inners = make([]Inner, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Str", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", int64(offset), "Inners[%d].Str", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Str", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Str", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Inners[i].Str = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		for i1 := 0; i1 < 4; i1++ {
+			if len(data)-offset < 2 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Arr4[%d]", i, i1)
+			}
+			buf = data[offset : offset+2]
+			offset += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", int64(offset), "Inners[%d].Arr4[%d]", i, i1)
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Arr4[%d]", i, i1)
+			}
+			if c-m < size {
+				c = size
+				if c < 2*cap(strBuf) {
+					c = 2 * cap(strBuf)
+				}
+				strBuf = append([]byte(nil), make([]byte, c)...)
+				m = 0
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Arr4[%d]", i, i1)
+			}
+			offset += copy(strBuf[m:m+size], data[offset:])
+			tmp = strBuf[m : m+size]
+			s.Inners[i].Arr4[i1] = *(*string)(unsafe.Pointer(&tmp))
+			m += size
+		}
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Attrs")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", int64(offset), "Attrs")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Attrs")
+	}
+	s.Attrs = make(map[string]*Inner, size)
+	si2 := size
+	for i2 := 0; i2 < si2; i2++ {
+		var k2 string
+		var v2 *Inner
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Attrs")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", int64(offset), "Attrs")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Attrs")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Attrs")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		k2 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Attrs[%q]", k2)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			v2 = new(Inner)
+			if len(data)-offset < 2 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Attrs[%q].Str", k2)
+			}
+			buf = data[offset : offset+2]
+			offset += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", int64(offset), "Attrs[%q].Str", k2)
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Attrs[%q].Str", k2)
+			}
+			if c-m < size {
+				c = size
+				if c < 2*cap(strBuf) {
+					c = 2 * cap(strBuf)
+				}
+				strBuf = append([]byte(nil), make([]byte, c)...)
+				m = 0
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Attrs[%q].Str", k2)
+			}
+			offset += copy(strBuf[m:m+size], data[offset:])
+			tmp = strBuf[m : m+size]
+			(*v2).Str = *(*string)(unsafe.Pointer(&tmp))
+			m += size
+			for i3 := 0; i3 < 4; i3++ {
+				if len(data)-offset < 2 {
+					return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Attrs[%q].Arr4[%d]", k2, i3)
+				}
+				buf = data[offset : offset+2]
+				offset += 2
+				size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+				if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+					return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", int64(offset), "Attrs[%q].Arr4[%d]", k2, i3)
+				}
+				if len(data)-offset < size {
+					return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Attrs[%q].Arr4[%d]", k2, i3)
+				}
+				if c-m < size {
+					c = size
+					if c < 2*cap(strBuf) {
+						c = 2 * cap(strBuf)
+					}
+					strBuf = append([]byte(nil), make([]byte, c)...)
+					m = 0
+				}
+				if len(data)-offset < size {
+					return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Attrs[%q].Arr4[%d]", k2, i3)
+				}
+				offset += copy(strBuf[m:m+size], data[offset:])
+				tmp = strBuf[m : m+size]
+				(*v2).Arr4[i3] = *(*string)(unsafe.Pointer(&tmp))
+				m += size
+			}
+		} else {
+			v2 = nil
+		}
+		s.Attrs[k2] = v2
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Root.Name")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", int64(offset), "Root.Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Root.Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Root.Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Root.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Root.Children")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", int64(offset), "Root.Children")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Root.Children")
+	}
+	s.Root.Children = make([]Node, size)
+	si4 := size
+	for i4 := 0; i4 < si4; i4++ {
+		if offset, err = s.Root.Children[i4].decodeBinenc(data, offset, opts, 1); err != nil {
+			return offset, binenc.FieldError(err, "Outer", int64(offset), "Root.Children[%d]", i4)
+		}
+	}
+	return offset, nil
+}
+
+func (s *Outer) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Node) sizeBinenc() int {
+	size := 4
+	size += len(s.Name)
+	for i1 := range s.Children {
+		size += s.Children[i1].sizeBinenc()
+	}
+	return size
+}
+
+func (s *Node) writeBinenc(buf []byte, offset int) (_ int, err error) {
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if uint64(len(s.Children)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Children))
+	}
+	buf[offset] = byte(len(s.Children))
+	buf[offset+1] = byte(len(s.Children) >> 8)
+	offset += 2
+	for i1 := range s.Children {
+		offset, err = s.Children[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return offset, nil
+}
+
+func (s *Node) readBinenc(d *binenc.Decoder, n int64, depth int) (_ int64, err error) {
+	if limit := d.Options().MaxDepth; limit > 0 && depth > limit {
+		return n, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Name")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Node", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Node", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Node", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Children")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Node", n, "Children")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Node", n, "Children")
+	}
+	if size > binenc.MaxAlloc/40 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Node", n, "Children")
+	}
+	s.Children = make([]Node, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if n, err = s.Children[i].readBinenc(d, n, depth+1); err != nil {
+			return n, binenc.FieldError(err, "Node", n, "Children[%d]", i)
+		}
+	}
+	return n, nil
+}
+
+func (s *Node) decodeBinenc(data []byte, offset int, opts binenc.DecodeOptions, depth int) (_ int, err error) {
+	if limit := opts.MaxDepth; limit > 0 && depth > limit {
+		return offset, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Name")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Node", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Children")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Node", int64(offset), "Children")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Children")
+	}
+	s.Children = make([]Node, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if offset, err = s.Children[i].decodeBinenc(data, offset, opts, depth+1); err != nil {
+			return offset, binenc.FieldError(err, "Node", int64(offset), "Children[%d]", i)
+		}
+	}
+	return offset, nil
+}
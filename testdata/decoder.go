@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+	"github.com/google/go-cmp/cmp"
+)
+
+//go:generate go-binenc-gen -reuse decoder.go
+type Event struct {
+	ID     uint64
+	Kind   string
+	Tags   []string
+	Values []float32
+	Parent *Event
+}
+
+var _ binenc.Decodable = (*Event)(nil)
+
+// repeat reads data over and over.
+type repeat struct {
+	data []byte
+	off  int
+}
+
+func (r *repeat) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		c := copy(p[n:], r.data[r.off:])
+		n += c
+		r.off = (r.off + c) % len(r.data)
+	}
+	return n, nil
+}
+
+func main() {
+	events := []*Event{
+		{ID: 1, Kind: "start", Tags: []string{"a", "b"}, Values: []float32{1, 2}},
+		{ID: 2, Kind: "stop", Tags: []string{"c"}, Values: []float32{3}},
+		{ID: 3, Kind: "step", Parent: &Event{ID: 1, Kind: "start"}},
+	}
+	var buf bytes.Buffer
+	for _, e := range events {
+		if _, err := e.WriteTo(&buf); err != nil {
+			panic("decoder.go: " + err.Error())
+		}
+	}
+	data := buf.Bytes()
+
+	// a stream is decoded value by value, up to io.EOF
+	d := binenc.NewDecoder(bytes.NewReader(data))
+	for i := 0; ; i++ {
+		o := new(Event)
+		err := d.Decode(o)
+		if err == io.EOF && i == len(events) {
+			break
+		}
+		if err != nil {
+			panic(fmt.Sprintf("decoder.go: Decode #%d: %v", i, err))
+		}
+		if diff := cmp.Diff(events[i], o); diff != "" {
+			panic("decoder.go: \n" + diff)
+		}
+	}
+
+	// the strings of previous values are kept intact
+	d = binenc.NewDecoder(bytes.NewReader(data))
+	first, second := new(Event), new(Event)
+	if err := d.Decode(first); err != nil {
+		panic("decoder.go: " + err.Error())
+	}
+	if err := d.Decode(second); err != nil {
+		panic("decoder.go: " + err.Error())
+	}
+	if diff := cmp.Diff(events[0], first); diff != "" {
+		panic("decoder.go: \n" + diff)
+	}
+
+	// limits apply to each value
+	d = binenc.NewDecoderOptions(bytes.NewReader(data), binenc.DecodeOptions{MaxBytes: int64(events[0].EncodedSize())})
+	if err := d.Decode(new(Event)); err != nil {
+		panic("decoder.go: " + err.Error())
+	}
+	if err := d.Decode(new(Event)); err != nil {
+		panic("decoder.go: " + err.Error())
+	}
+	var limitErr *binenc.LimitError
+	if err := d.Decode(new(Event)); !errors.As(err, &limitErr) || limitErr.Limit != "MaxBytes" {
+		panic(fmt.Sprintf("decoder.go: Decode = %v, want a MaxBytes LimitError", err))
+	}
+
+	// decoding into the same value only allocates the chunks of the string
+	// arena of the decoder, whose cost is shared by many values
+	start, _ := events[0].MarshalBinary()
+	d = binenc.NewDecoder(&repeat{data: start})
+	o := new(Event)
+	if err := d.Decode(o); err != nil {
+		panic("decoder.go: " + err.Error())
+	}
+	allocs := testing.AllocsPerRun(1000, func() {
+		if err := d.Decode(o); err != nil {
+			panic("decoder.go: " + err.Error())
+		}
+	})
+	if allocs != 0 {
+		panic(fmt.Sprintf("decoder.go: Decode allocates %v times", allocs))
+	}
+	if diff := cmp.Diff(events[0], o); diff != "" {
+		panic("decoder.go: \n" + diff)
+	}
+}
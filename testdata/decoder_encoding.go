@@ -0,0 +1,999 @@
+// Code generated by "gobinenc -reuse decoder.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Event) WriteTo(w io.Writer) (n int64, err error) {
+	size := 15
+	size += len(s.Kind) + 4*len(s.Values)
+	for _, v := range s.Tags {
+		size += 2
+		size += len(v)
+	}
+	if s.Parent != nil {
+		size += (*s.Parent).sizeBinenc()
+	}
+	buf := make([]byte, size)
+	offset := 0
+	buf[offset] = byte(s.ID)
+	buf[offset+1] = byte(s.ID >> 8)
+	buf[offset+2] = byte(s.ID >> 16)
+	buf[offset+3] = byte(s.ID >> 24)
+	buf[offset+4] = byte(s.ID >> 32)
+	buf[offset+5] = byte(s.ID >> 40)
+	buf[offset+6] = byte(s.ID >> 48)
+	buf[offset+7] = byte(s.ID >> 56)
+	offset += 8
+	if uint64(len(s.Kind)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Kind))
+	}
+	buf[offset] = byte(len(s.Kind))
+	buf[offset+1] = byte(len(s.Kind) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Kind)
+	offset += len(s.Kind)
+	if uint64(len(s.Tags)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Tags))
+	}
+	buf[offset] = byte(len(s.Tags))
+	buf[offset+1] = byte(len(s.Tags) >> 8)
+	offset += 2
+	for _, v := range s.Tags {
+		if uint64(len(v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	if uint64(len(s.Values)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Values))
+	}
+	buf[offset] = byte(len(s.Values))
+	buf[offset+1] = byte(len(s.Values) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Values) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 4*len(s.Values)))
+		offset += 4 * len(s.Values)
+	} else {
+		for _, v := range s.Values {
+			buf[offset] = byte(math.Float32bits(v))
+			buf[offset+1] = byte(math.Float32bits(v) >> 8)
+			buf[offset+2] = byte(math.Float32bits(v) >> 16)
+			buf[offset+3] = byte(math.Float32bits(v) >> 24)
+			offset += 4
+		}
+	}
+	if s.Parent != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Parent != nil {
+		offset, err = (*s.Parent).writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Event) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Event) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Event) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Event", n, "ID")
+	}
+	n += 8
+	s.ID = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Event", n, "Kind")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Event", n, "Kind")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Event", n, "Kind")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Event", n, "Kind")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Event", n, "Kind")
+	}
+	n += int64(size)
+	s.Kind = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Event", n, "Tags")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Event", n, "Tags")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Event", n, "Tags")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Event", n, "Tags")
+	}
+	if cap(s.Tags) < size {
+		s.Tags = make([]string, size)
+	} else {
+		s.Tags = s.Tags[:size]
+	}
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Event", n, "Tags[%d]", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Event", n, "Tags[%d]", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Event", n, "Tags[%d]", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Event", n, "Tags[%d]", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Event", n, "Tags[%d]", i)
+		}
+		n += int64(size)
+		s.Tags[i] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Event", n, "Values")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Event", n, "Values")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Event", n, "Values")
+	}
+	if size > binenc.MaxAlloc/4 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Event", n, "Values")
+	}
+	if cap(s.Values) < size {
+		s.Values = make([]float32, size)
+	} else {
+		s.Values = s.Values[:size]
+	}
+	if binenc.LittleEndian && size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 4*size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Event", n, "Values")
+		}
+		n += int64(4 * size)
+	} else {
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Event", n, "Values[%d]", i1)
+			}
+			n += 4
+			s.Values[i1] = math.Float32frombits(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Event", n, "Parent")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		if s.Parent == nil {
+			s.Parent = new(Event)
+		}
+		if n, err = (*s.Parent).readBinenc(d, n, 1); err != nil {
+			return n, binenc.FieldError(err, "Event", n, "Parent")
+		}
+	} else {
+		s.Parent = nil
+	}
+	return n, nil
+}
+
+func (s *Event) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 15
+	size += len(s.Kind) + 4*len(s.Values)
+	for _, v := range s.Tags {
+		size += 2
+		size += len(v)
+	}
+	if s.Parent != nil {
+		size += (*s.Parent).sizeBinenc()
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(s.ID)
+	buf[offset+1] = byte(s.ID >> 8)
+	buf[offset+2] = byte(s.ID >> 16)
+	buf[offset+3] = byte(s.ID >> 24)
+	buf[offset+4] = byte(s.ID >> 32)
+	buf[offset+5] = byte(s.ID >> 40)
+	buf[offset+6] = byte(s.ID >> 48)
+	buf[offset+7] = byte(s.ID >> 56)
+	offset += 8
+	if uint64(len(s.Kind)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Kind))
+	}
+	buf[offset] = byte(len(s.Kind))
+	buf[offset+1] = byte(len(s.Kind) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Kind)
+	offset += len(s.Kind)
+	if uint64(len(s.Tags)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Tags))
+	}
+	buf[offset] = byte(len(s.Tags))
+	buf[offset+1] = byte(len(s.Tags) >> 8)
+	offset += 2
+	for _, v := range s.Tags {
+		if uint64(len(v)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	if uint64(len(s.Values)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Values))
+	}
+	buf[offset] = byte(len(s.Values))
+	buf[offset+1] = byte(len(s.Values) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Values) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 4*len(s.Values)))
+		offset += 4 * len(s.Values)
+	} else {
+		for _, v := range s.Values {
+			buf[offset] = byte(math.Float32bits(v))
+			buf[offset+1] = byte(math.Float32bits(v) >> 8)
+			buf[offset+2] = byte(math.Float32bits(v) >> 16)
+			buf[offset+3] = byte(math.Float32bits(v) >> 24)
+			offset += 4
+		}
+	}
+	if s.Parent != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Parent != nil {
+		offset, err = (*s.Parent).writeBinenc(buf, offset)
+		if err != nil {
+			return dst, err
+		}
+	}
+	return buf, nil
+}
+
+func (s *Event) EncodedSize() int {
+	size := 15
+	size += len(s.Kind) + 4*len(s.Values)
+	for _, v := range s.Tags {
+		size += 2
+		size += len(v)
+	}
+	if s.Parent != nil {
+		size += (*s.Parent).sizeBinenc()
+	}
+	return size
+}
+
+func (s *Event) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Event) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Event) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 10 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "ID")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	s.ID = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Event", int64(offset), "Kind")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Kind")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Kind")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Kind = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Tags")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Event", int64(offset), "Tags")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Tags")
+	}
+	if cap(s.Tags) < size {
+		s.Tags = make([]string, size)
+	} else {
+		s.Tags = s.Tags[:size]
+	}
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Tags[%d]", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Event", int64(offset), "Tags[%d]", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Tags[%d]", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Tags[%d]", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Tags[i] = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Values")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Event", int64(offset), "Values")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Values")
+	}
+	if cap(s.Values) < size {
+		s.Values = make([]float32, size)
+	} else {
+		s.Values = s.Values[:size]
+	}
+	if binenc.LittleEndian && size > 0 {
+		if len(data)-offset < 4*size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Values")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 4*size), data[offset:])
+	} else {
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if len(data)-offset < 4 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Values[%d]", i1)
+			}
+			buf = data[offset : offset+4]
+			offset += 4
+			s.Values[i1] = math.Float32frombits(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+		}
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Parent")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		if s.Parent == nil {
+			s.Parent = new(Event)
+		}
+		if offset, err = (*s.Parent).decodeBinenc(data, offset, opts, 1); err != nil {
+			return offset, binenc.FieldError(err, "Event", int64(offset), "Parent")
+		}
+	} else {
+		s.Parent = nil
+	}
+	return offset, nil
+}
+
+func (s *Event) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Event) Reset() {
+	s.ID = 0
+	s.Kind = ""
+	s.Tags = s.Tags[:0]
+	s.Values = s.Values[:0]
+	s.Parent = nil
+}
+
+func (s *repeat) WriteTo(w io.Writer) (n int64, err error) {
+	size := 10
+	size += len(s.data)
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.data)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.data))
+	}
+	buf[offset] = byte(len(s.data))
+	buf[offset+1] = byte(len(s.data) >> 8)
+	offset += 2
+	copy(buf[offset:], s.data)
+	offset += len(s.data)
+	buf[offset] = byte(uint64(s.off))
+	buf[offset+1] = byte(uint64(s.off) >> 8)
+	buf[offset+2] = byte(uint64(s.off) >> 16)
+	buf[offset+3] = byte(uint64(s.off) >> 24)
+	buf[offset+4] = byte(uint64(s.off) >> 32)
+	buf[offset+5] = byte(uint64(s.off) >> 40)
+	buf[offset+6] = byte(uint64(s.off) >> 48)
+	buf[offset+7] = byte(uint64(s.off) >> 56)
+	offset += 8
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *repeat) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *repeat) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *repeat) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "repeat", n, "data")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "repeat", n, "data")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "repeat", n, "data")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "repeat", n, "data")
+	}
+	if cap(s.data) < size {
+		s.data = make([]byte, size)
+	} else {
+		s.data = s.data[:size]
+	}
+	if nr, err := io.ReadFull(r, s.data); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "repeat", n, "data")
+	}
+	n += int64(size)
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "repeat", n, "off")
+	}
+	n += 8
+	if x := int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)); int64(int(x)) != x {
+		return n, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "repeat", n, "off")
+	} else {
+		s.off = int(x)
+	}
+	return n, nil
+}
+
+func (s *repeat) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 10
+	size += len(s.data)
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.data)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.data))
+	}
+	buf[offset] = byte(len(s.data))
+	buf[offset+1] = byte(len(s.data) >> 8)
+	offset += 2
+	copy(buf[offset:], s.data)
+	offset += len(s.data)
+	buf[offset] = byte(uint64(s.off))
+	buf[offset+1] = byte(uint64(s.off) >> 8)
+	buf[offset+2] = byte(uint64(s.off) >> 16)
+	buf[offset+3] = byte(uint64(s.off) >> 24)
+	buf[offset+4] = byte(uint64(s.off) >> 32)
+	buf[offset+5] = byte(uint64(s.off) >> 40)
+	buf[offset+6] = byte(uint64(s.off) >> 48)
+	buf[offset+7] = byte(uint64(s.off) >> 56)
+	offset += 8
+	return buf, nil
+}
+
+func (s *repeat) EncodedSize() int {
+	size := 10
+	size += len(s.data)
+	return size
+}
+
+func (s *repeat) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *repeat) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *repeat) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "repeat", int64(offset), "data")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "repeat", int64(offset), "data")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "repeat", int64(offset), "data")
+	}
+	if cap(s.data) < size {
+		s.data = make([]byte, size)
+	} else {
+		s.data = s.data[:size]
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "repeat", int64(offset), "data")
+	}
+	offset += copy(s.data, data[offset:])
+	if len(data)-offset < 8 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "repeat", int64(offset), "off")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	if x := int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)); int64(int(x)) != x {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "repeat", int64(offset), "off")
+	} else {
+		s.off = int(x)
+	}
+	return offset, nil
+}
+
+func (s *repeat) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *repeat) Reset() {
+	s.data = s.data[:0]
+	s.off = 0
+}
+
+func (s *Event) sizeBinenc() int {
+	size := 15
+	size += len(s.Kind) + 4*len(s.Values)
+	for _, v := range s.Tags {
+		size += 2
+		size += len(v)
+	}
+	if s.Parent != nil {
+		size += (*s.Parent).sizeBinenc()
+	}
+	return size
+}
+
+func (s *Event) writeBinenc(buf []byte, offset int) (_ int, err error) {
+	buf[offset] = byte(s.ID)
+	buf[offset+1] = byte(s.ID >> 8)
+	buf[offset+2] = byte(s.ID >> 16)
+	buf[offset+3] = byte(s.ID >> 24)
+	buf[offset+4] = byte(s.ID >> 32)
+	buf[offset+5] = byte(s.ID >> 40)
+	buf[offset+6] = byte(s.ID >> 48)
+	buf[offset+7] = byte(s.ID >> 56)
+	offset += 8
+	if uint64(len(s.Kind)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Kind))
+	}
+	buf[offset] = byte(len(s.Kind))
+	buf[offset+1] = byte(len(s.Kind) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Kind)
+	offset += len(s.Kind)
+	if uint64(len(s.Tags)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Tags))
+	}
+	buf[offset] = byte(len(s.Tags))
+	buf[offset+1] = byte(len(s.Tags) >> 8)
+	offset += 2
+	for _, v := range s.Tags {
+		if uint64(len(v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	if uint64(len(s.Values)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Values))
+	}
+	buf[offset] = byte(len(s.Values))
+	buf[offset+1] = byte(len(s.Values) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Values) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 4*len(s.Values)))
+		offset += 4 * len(s.Values)
+	} else {
+		for _, v := range s.Values {
+			buf[offset] = byte(math.Float32bits(v))
+			buf[offset+1] = byte(math.Float32bits(v) >> 8)
+			buf[offset+2] = byte(math.Float32bits(v) >> 16)
+			buf[offset+3] = byte(math.Float32bits(v) >> 24)
+			offset += 4
+		}
+	}
+	if s.Parent != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Parent != nil {
+		offset, err = (*s.Parent).writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return offset, nil
+}
+
+func (s *Event) readBinenc(d *binenc.Decoder, n int64, depth int) (_ int64, err error) {
+	if limit := d.Options().MaxDepth; limit > 0 && depth > limit {
+		return n, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Event", n, "ID")
+	}
+	n += 8
+	s.ID = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Event", n, "Kind")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Event", n, "Kind")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Event", n, "Kind")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Event", n, "Kind")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Event", n, "Kind")
+	}
+	n += int64(size)
+	s.Kind = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Event", n, "Tags")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Event", n, "Tags")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Event", n, "Tags")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Event", n, "Tags")
+	}
+	if cap(s.Tags) < size {
+		s.Tags = make([]string, size)
+	} else {
+		s.Tags = s.Tags[:size]
+	}
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Event", n, "Tags[%d]", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Event", n, "Tags[%d]", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Event", n, "Tags[%d]", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Event", n, "Tags[%d]", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Event", n, "Tags[%d]", i)
+		}
+		n += int64(size)
+		s.Tags[i] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Event", n, "Values")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Event", n, "Values")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Event", n, "Values")
+	}
+	if size > binenc.MaxAlloc/4 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Event", n, "Values")
+	}
+	if cap(s.Values) < size {
+		s.Values = make([]float32, size)
+	} else {
+		s.Values = s.Values[:size]
+	}
+	if binenc.LittleEndian && size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 4*size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Event", n, "Values")
+		}
+		n += int64(4 * size)
+	} else {
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Event", n, "Values[%d]", i1)
+			}
+			n += 4
+			s.Values[i1] = math.Float32frombits(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Event", n, "Parent")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		if s.Parent == nil {
+			s.Parent = new(Event)
+		}
+		if n, err = (*s.Parent).readBinenc(d, n, depth+1); err != nil {
+			return n, binenc.FieldError(err, "Event", n, "Parent")
+		}
+	} else {
+		s.Parent = nil
+	}
+	return n, nil
+}
+
+func (s *Event) decodeBinenc(data []byte, offset int, opts binenc.DecodeOptions, depth int) (_ int, err error) {
+	if limit := opts.MaxDepth; limit > 0 && depth > limit {
+		return offset, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 10 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "ID")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	s.ID = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Event", int64(offset), "Kind")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Kind")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Kind")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Kind = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Tags")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Event", int64(offset), "Tags")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Tags")
+	}
+	if cap(s.Tags) < size {
+		s.Tags = make([]string, size)
+	} else {
+		s.Tags = s.Tags[:size]
+	}
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Tags[%d]", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Event", int64(offset), "Tags[%d]", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Tags[%d]", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Tags[%d]", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Tags[i] = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Values")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Event", int64(offset), "Values")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Values")
+	}
+	if cap(s.Values) < size {
+		s.Values = make([]float32, size)
+	} else {
+		s.Values = s.Values[:size]
+	}
+	if binenc.LittleEndian && size > 0 {
+		if len(data)-offset < 4*size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Values")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 4*size), data[offset:])
+	} else {
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if len(data)-offset < 4 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Values[%d]", i1)
+			}
+			buf = data[offset : offset+4]
+			offset += 4
+			s.Values[i1] = math.Float32frombits(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+		}
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Event", int64(offset), "Parent")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		if s.Parent == nil {
+			s.Parent = new(Event)
+		}
+		if offset, err = (*s.Parent).decodeBinenc(data, offset, opts, depth+1); err != nil {
+			return offset, binenc.FieldError(err, "Event", int64(offset), "Parent")
+		}
+	} else {
+		s.Parent = nil
+	}
+	return offset, nil
+}
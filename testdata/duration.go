@@ -0,0 +1,38 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+//go:generate go-binenc-gen duration.go
+type Duration struct {
+	Timeout  time.Duration
+	Backoffs []time.Duration
+	Months   map[time.Month]*time.Weekday
+	Status   [2]http.ConnState
+}
+
+func main() {
+	sunday := time.Sunday
+	s := &Duration{
+		Timeout:  3 * time.Second,
+		Backoffs: []time.Duration{time.Millisecond, -time.Minute},
+		Months:   map[time.Month]*time.Weekday{time.March: &sunday},
+		Status:   [2]http.ConnState{http.StateActive, http.StateClosed},
+	}
+
+	data, err := s.MarshalBinary()
+	if err != nil {
+		panic("duration.go: " + err.Error())
+	}
+	o := new(Duration)
+	if err := o.UnmarshalBinary(data); err != nil {
+		panic("duration.go: " + err.Error())
+	}
+	if diff := cmp.Diff(s, o); diff != "" {
+		panic("duration.go: \n" + diff)
+	}
+}
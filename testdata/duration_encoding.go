@@ -0,0 +1,435 @@
+// Code generated by "gobinenc duration.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"time"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Duration) WriteTo(w io.Writer) (n int64, err error) {
+	size := 28
+	size += 8 * len(s.Backoffs)
+	for _, v := range s.Months {
+		size += 9
+		if v != nil {
+			size += 8
+		}
+	}
+	buf := make([]byte, size)
+	offset := 0
+	buf[offset] = byte(uint64(s.Timeout))
+	buf[offset+1] = byte(uint64(s.Timeout) >> 8)
+	buf[offset+2] = byte(uint64(s.Timeout) >> 16)
+	buf[offset+3] = byte(uint64(s.Timeout) >> 24)
+	buf[offset+4] = byte(uint64(s.Timeout) >> 32)
+	buf[offset+5] = byte(uint64(s.Timeout) >> 40)
+	buf[offset+6] = byte(uint64(s.Timeout) >> 48)
+	buf[offset+7] = byte(uint64(s.Timeout) >> 56)
+	offset += 8
+	if uint64(len(s.Backoffs)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Backoffs))
+	}
+	buf[offset] = byte(len(s.Backoffs))
+	buf[offset+1] = byte(len(s.Backoffs) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Backoffs) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Backoffs[0])), 8*len(s.Backoffs)))
+		offset += 8 * len(s.Backoffs)
+	} else {
+		for _, v := range s.Backoffs {
+			buf[offset] = byte(uint64(v))
+			buf[offset+1] = byte(uint64(v) >> 8)
+			buf[offset+2] = byte(uint64(v) >> 16)
+			buf[offset+3] = byte(uint64(v) >> 24)
+			buf[offset+4] = byte(uint64(v) >> 32)
+			buf[offset+5] = byte(uint64(v) >> 40)
+			buf[offset+6] = byte(uint64(v) >> 48)
+			buf[offset+7] = byte(uint64(v) >> 56)
+			offset += 8
+		}
+	}
+	if uint64(len(s.Months)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Months))
+	}
+	buf[offset] = byte(len(s.Months))
+	buf[offset+1] = byte(len(s.Months) >> 8)
+	offset += 2
+	for k, v := range s.Months {
+		buf[offset] = byte(uint64(k))
+		buf[offset+1] = byte(uint64(k) >> 8)
+		buf[offset+2] = byte(uint64(k) >> 16)
+		buf[offset+3] = byte(uint64(k) >> 24)
+		buf[offset+4] = byte(uint64(k) >> 32)
+		buf[offset+5] = byte(uint64(k) >> 40)
+		buf[offset+6] = byte(uint64(k) >> 48)
+		buf[offset+7] = byte(uint64(k) >> 56)
+		offset += 8
+		if v != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if v != nil {
+			buf[offset] = byte(uint64(*v))
+			buf[offset+1] = byte(uint64(*v) >> 8)
+			buf[offset+2] = byte(uint64(*v) >> 16)
+			buf[offset+3] = byte(uint64(*v) >> 24)
+			buf[offset+4] = byte(uint64(*v) >> 32)
+			buf[offset+5] = byte(uint64(*v) >> 40)
+			buf[offset+6] = byte(uint64(*v) >> 48)
+			buf[offset+7] = byte(uint64(*v) >> 56)
+			offset += 8
+		}
+	}
+	for i1 := 0; i1 < 2; i1++ {
+		buf[offset] = byte(uint64(s.Status[i1]))
+		buf[offset+1] = byte(uint64(s.Status[i1]) >> 8)
+		buf[offset+2] = byte(uint64(s.Status[i1]) >> 16)
+		buf[offset+3] = byte(uint64(s.Status[i1]) >> 24)
+		buf[offset+4] = byte(uint64(s.Status[i1]) >> 32)
+		buf[offset+5] = byte(uint64(s.Status[i1]) >> 40)
+		buf[offset+6] = byte(uint64(s.Status[i1]) >> 48)
+		buf[offset+7] = byte(uint64(s.Status[i1]) >> 56)
+		offset += 8
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Duration) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Duration) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Duration) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Duration", n, "Timeout")
+	}
+	n += 8
+	s.Timeout = time.Duration(int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Duration", n, "Backoffs")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Duration", n, "Backoffs")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Duration", n, "Backoffs")
+	}
+	if size > binenc.MaxAlloc/8 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Duration", n, "Backoffs")
+	}
+	s.Backoffs = make([]time.Duration, size)
+	if binenc.LittleEndian && size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Backoffs[0])), 8*size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Duration", n, "Backoffs")
+		}
+		n += int64(8 * size)
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Duration", n, "Backoffs[%d]", i)
+			}
+			n += 8
+			s.Backoffs[i] = time.Duration(int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)))
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Duration", n, "Months")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Duration", n, "Months")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Duration", n, "Months")
+	}
+	s.Months = make(map[time.Month]*time.Weekday, binenc.MapHint(size))
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 time.Month
+		var v1 *time.Weekday
+		if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Duration", n, "Months")
+		}
+		n += 8
+		if x := int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)); int64(int(x)) != x {
+			return n, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "Duration", n, "Months")
+		} else {
+			k1 = time.Month(x)
+		}
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Duration", n, "Months[%v]", k1)
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			v1 = new(time.Weekday)
+			if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Duration", n, "Months[%v]", k1)
+			}
+			n += 8
+			if x := int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)); int64(int(x)) != x {
+				return n, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "Duration", n, "Months[%v]", k1)
+			} else {
+				*v1 = time.Weekday(x)
+			}
+		} else {
+			v1 = nil
+		}
+		s.Months[k1] = v1
+	}
+	for i2 := 0; i2 < 2; i2++ {
+		if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Duration", n, "Status[%d]", i2)
+		}
+		n += 8
+		if x := int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)); int64(int(x)) != x {
+			return n, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "Duration", n, "Status[%d]", i2)
+		} else {
+			s.Status[i2] = http.ConnState(x)
+		}
+	}
+	return n, nil
+}
+
+func (s *Duration) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 28
+	size += 8 * len(s.Backoffs)
+	for _, v := range s.Months {
+		size += 9
+		if v != nil {
+			size += 8
+		}
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(uint64(s.Timeout))
+	buf[offset+1] = byte(uint64(s.Timeout) >> 8)
+	buf[offset+2] = byte(uint64(s.Timeout) >> 16)
+	buf[offset+3] = byte(uint64(s.Timeout) >> 24)
+	buf[offset+4] = byte(uint64(s.Timeout) >> 32)
+	buf[offset+5] = byte(uint64(s.Timeout) >> 40)
+	buf[offset+6] = byte(uint64(s.Timeout) >> 48)
+	buf[offset+7] = byte(uint64(s.Timeout) >> 56)
+	offset += 8
+	if uint64(len(s.Backoffs)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Backoffs))
+	}
+	buf[offset] = byte(len(s.Backoffs))
+	buf[offset+1] = byte(len(s.Backoffs) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Backoffs) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Backoffs[0])), 8*len(s.Backoffs)))
+		offset += 8 * len(s.Backoffs)
+	} else {
+		for _, v := range s.Backoffs {
+			buf[offset] = byte(uint64(v))
+			buf[offset+1] = byte(uint64(v) >> 8)
+			buf[offset+2] = byte(uint64(v) >> 16)
+			buf[offset+3] = byte(uint64(v) >> 24)
+			buf[offset+4] = byte(uint64(v) >> 32)
+			buf[offset+5] = byte(uint64(v) >> 40)
+			buf[offset+6] = byte(uint64(v) >> 48)
+			buf[offset+7] = byte(uint64(v) >> 56)
+			offset += 8
+		}
+	}
+	if uint64(len(s.Months)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Months))
+	}
+	buf[offset] = byte(len(s.Months))
+	buf[offset+1] = byte(len(s.Months) >> 8)
+	offset += 2
+	for k, v := range s.Months {
+		buf[offset] = byte(uint64(k))
+		buf[offset+1] = byte(uint64(k) >> 8)
+		buf[offset+2] = byte(uint64(k) >> 16)
+		buf[offset+3] = byte(uint64(k) >> 24)
+		buf[offset+4] = byte(uint64(k) >> 32)
+		buf[offset+5] = byte(uint64(k) >> 40)
+		buf[offset+6] = byte(uint64(k) >> 48)
+		buf[offset+7] = byte(uint64(k) >> 56)
+		offset += 8
+		if v != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if v != nil {
+			buf[offset] = byte(uint64(*v))
+			buf[offset+1] = byte(uint64(*v) >> 8)
+			buf[offset+2] = byte(uint64(*v) >> 16)
+			buf[offset+3] = byte(uint64(*v) >> 24)
+			buf[offset+4] = byte(uint64(*v) >> 32)
+			buf[offset+5] = byte(uint64(*v) >> 40)
+			buf[offset+6] = byte(uint64(*v) >> 48)
+			buf[offset+7] = byte(uint64(*v) >> 56)
+			offset += 8
+		}
+	}
+	for i1 := 0; i1 < 2; i1++ {
+		buf[offset] = byte(uint64(s.Status[i1]))
+		buf[offset+1] = byte(uint64(s.Status[i1]) >> 8)
+		buf[offset+2] = byte(uint64(s.Status[i1]) >> 16)
+		buf[offset+3] = byte(uint64(s.Status[i1]) >> 24)
+		buf[offset+4] = byte(uint64(s.Status[i1]) >> 32)
+		buf[offset+5] = byte(uint64(s.Status[i1]) >> 40)
+		buf[offset+6] = byte(uint64(s.Status[i1]) >> 48)
+		buf[offset+7] = byte(uint64(s.Status[i1]) >> 56)
+		offset += 8
+	}
+	return buf, nil
+}
+
+func (s *Duration) EncodedSize() int {
+	size := 28
+	size += 8 * len(s.Backoffs)
+	for _, v := range s.Months {
+		size += 9
+		if v != nil {
+			size += 8
+		}
+	}
+	return size
+}
+
+func (s *Duration) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Duration) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Duration) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	if len(data)-offset < 10 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Duration", int64(offset), "Timeout")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	s.Timeout = time.Duration(int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)))
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Duration", int64(offset), "Backoffs")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Duration", int64(offset), "Backoffs")
+	}
+	s.Backoffs = make([]time.Duration, size)
+	if binenc.LittleEndian && size > 0 {
+		if len(data)-offset < 8*size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Duration", int64(offset), "Backoffs")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Backoffs[0])), 8*size), data[offset:])
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if len(data)-offset < 8 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Duration", int64(offset), "Backoffs[%d]", i)
+			}
+			buf = data[offset : offset+8]
+			offset += 8
+			s.Backoffs[i] = time.Duration(int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)))
+		}
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Duration", int64(offset), "Months")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Duration", int64(offset), "Months")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Duration", int64(offset), "Months")
+	}
+	s.Months = make(map[time.Month]*time.Weekday, size)
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 time.Month
+		var v1 *time.Weekday
+		if len(data)-offset < 9 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Duration", int64(offset), "Months")
+		}
+		buf = data[offset : offset+8]
+		offset += 8
+		if x := int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)); int64(int(x)) != x {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "Duration", int64(offset), "Months")
+		} else {
+			k1 = time.Month(x)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			v1 = new(time.Weekday)
+			if len(data)-offset < 8 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Duration", int64(offset), "Months[%v]", k1)
+			}
+			buf = data[offset : offset+8]
+			offset += 8
+			if x := int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)); int64(int(x)) != x {
+				return offset, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "Duration", int64(offset), "Months[%v]", k1)
+			} else {
+				*v1 = time.Weekday(x)
+			}
+		} else {
+			v1 = nil
+		}
+		s.Months[k1] = v1
+	}
+	for i2 := 0; i2 < 2; i2++ {
+		if len(data)-offset < 8 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Duration", int64(offset), "Status[%d]", i2)
+		}
+		buf = data[offset : offset+8]
+		offset += 8
+		if x := int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)); int64(int(x)) != x {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "Duration", int64(offset), "Status[%d]", i2)
+		} else {
+			s.Status[i2] = http.ConnState(x)
+		}
+	}
+	return offset, nil
+}
+
+func (s *Duration) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
@@ -3,11 +3,15 @@
 package main
 
 import (
+	"fmt"
 	"io"
+	"math"
 	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
 )
 
-func (s *Innermost) WriteTo(w io.Writer) (n int, err error) {
+func (s *Innermost) WriteTo(w io.Writer) (n int64, err error) {
 	size := 2
 	buf := make([]byte, size)
 	offset := 0
@@ -15,19 +19,90 @@ func (s *Innermost) WriteTo(w io.Writer) (n int, err error) {
 	offset += 1
 	buf[offset] = byte(s.Bar)
 	offset += 1
-	return w.Write(buf)
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Innermost) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Innermost) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Innermost) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Innermost", n, "Foo")
+	}
+	n += 1
+	s.Foo = uint8(buf[0])
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Innermost", n, "Bar")
+	}
+	n += 1
+	s.Bar = uint8(buf[0])
+	return n, nil
+}
+
+func (s *Innermost) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 2
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(s.Foo)
+	offset += 1
+	buf[offset] = byte(s.Bar)
+	offset += 1
+	return buf, nil
+}
+
+func (s *Innermost) EncodedSize() int {
+	size := 2
+	return size
+}
+
+func (s *Innermost) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Innermost) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
 }
 
-func (s *Innermost) ReadFrom(r io.Reader) error {
-	buf := make([]byte, 8)
-	r.Read(buf[:1])
+func (s *Innermost) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Innermost", int64(offset), "Foo")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
 	s.Foo = uint8(buf[0])
-	r.Read(buf[:1])
+	buf = data[offset : offset+1]
+	offset += 1
 	s.Bar = uint8(buf[0])
+	return offset, nil
+}
+
+func (s *Innermost) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
 	return nil
 }
 
-func (s *Inner) WriteTo(w io.Writer) (n int, err error) {
+func (s *Inner) WriteTo(w io.Writer) (n int64, err error) {
 	size := 5
 	for _, v := range s.Arr4 {
 		size += 2
@@ -37,10 +112,16 @@ func (s *Inner) WriteTo(w io.Writer) (n int, err error) {
 	offset := 0
 	buf[offset] = byte(s.Num)
 	offset += 1
+	if uint64(len(s.Arr4)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Arr4))
+	}
 	buf[offset] = byte(len(s.Arr4))
 	buf[offset+1] = byte(len(s.Arr4) >> 8)
 	offset += 2
 	for _, v := range s.Arr4 {
+		if uint64(len(v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
 		buf[offset] = byte(len(v))
 		buf[offset+1] = byte(len(v) >> 8)
 		offset += 2
@@ -51,46 +132,213 @@ func (s *Inner) WriteTo(w io.Writer) (n int, err error) {
 	offset += 1
 	buf[offset] = byte(s.Innermost.Bar)
 	offset += 1
-	return w.Write(buf)
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Inner) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Inner) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
 }
 
-func (s *Inner) ReadFrom(r io.Reader) error {
-	buf := make([]byte, 8)
-	var size uint16
+func (s *Inner) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Inner", n, "Num")
+	}
+	n += 1
+	s.Num = uint8(buf[0])
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Inner", n, "Arr4")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Inner", n, "Arr4")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Inner", n, "Arr4")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Inner", n, "Arr4")
+	}
+	s.Arr4 = make([]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Inner", n, "Arr4[%d]", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Inner", n, "Arr4[%d]", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Inner", n, "Arr4[%d]", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Inner", n, "Arr4[%d]", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Inner", n, "Arr4[%d]", i)
+		}
+		n += int64(size)
+		s.Arr4[i] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Inner", n, "Innermost.Foo")
+	}
+	n += 1
+	s.Innermost.Foo = uint8(buf[0])
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Inner", n, "Innermost.Bar")
+	}
+	n += 1
+	s.Innermost.Bar = uint8(buf[0])
+	return n, nil
+}
+
+func (s *Inner) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 5
+	for _, v := range s.Arr4 {
+		size += 2
+		size += len(v)
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(s.Num)
+	offset += 1
+	if uint64(len(s.Arr4)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Arr4))
+	}
+	buf[offset] = byte(len(s.Arr4))
+	buf[offset+1] = byte(len(s.Arr4) >> 8)
+	offset += 2
+	for _, v := range s.Arr4 {
+		if uint64(len(v)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	buf[offset] = byte(s.Innermost.Foo)
+	offset += 1
+	buf[offset] = byte(s.Innermost.Bar)
+	offset += 1
+	return buf, nil
+}
+
+func (s *Inner) EncodedSize() int {
+	size := 5
+	for _, v := range s.Arr4 {
+		size += 2
+		size += len(v)
+	}
+	return size
+}
+
+func (s *Inner) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Inner) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Inner) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
 	var tmp []byte
 	m := 0
 	c := 64
 	strBuf := make([]byte, c)
-	r.Read(buf[:1])
+	if len(data)-offset < 3 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Num")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
 	s.Num = uint8(buf[0])
-	r.Read(buf[:2])
-	size = uint16(buf[0]) | (uint16(buf[1]) << 8)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Inner", int64(offset), "Arr4")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Arr4")
+	}
 	s.Arr4 = make([]string, size)
-	si := int(size)
+	si := size
 	for i := 0; i < si; i++ {
-		r.Read(buf[:2])
-		size = uint16(buf[0]) | (uint16(buf[1]) << 8)
-		if c-m < int(size) {
-			c = int(size)
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Arr4[%d]", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Inner", int64(offset), "Arr4[%d]", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Arr4[%d]", i)
+		}
+		if c-m < size {
+			c = size
 			if c < 2*cap(strBuf) {
 				c = 2 * cap(strBuf)
 			}
 			strBuf = append([]byte(nil), make([]byte, c)...)
 			m = 0
 		}
-		r.Read(strBuf[m : m+int(size)])
-		tmp = strBuf[m : m+int(size)]
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Arr4[%d]", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
 		s.Arr4[i] = *(*string)(unsafe.Pointer(&tmp))
-		m += int(size)
+		m += size
 	}
-	r.Read(buf[:1])
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Innermost.Foo")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
 	s.Innermost.Foo = uint8(buf[0])
-	r.Read(buf[:1])
+	buf = data[offset : offset+1]
+	offset += 1
 	s.Innermost.Bar = uint8(buf[0])
+	return offset, nil
+}
+
+func (s *Inner) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
 	return nil
 }
 
-func (s *Outer) WriteTo(w io.Writer) (n int, err error) {
+func (s *Outer) WriteTo(w io.Writer) (n int64, err error) {
 	size := 6
 	for _, v := range s.Inner.Arr4 {
 		size += 2
@@ -102,10 +350,130 @@ func (s *Outer) WriteTo(w io.Writer) (n int, err error) {
 	offset += 1
 	buf[offset] = byte(s.Inner.Num)
 	offset += 1
+	if uint64(len(s.Inner.Arr4)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Inner.Arr4))
+	}
+	buf[offset] = byte(len(s.Inner.Arr4))
+	buf[offset+1] = byte(len(s.Inner.Arr4) >> 8)
+	offset += 2
+	for _, v := range s.Inner.Arr4 {
+		if uint64(len(v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	buf[offset] = byte(s.Inner.Innermost.Foo)
+	offset += 1
+	buf[offset] = byte(s.Inner.Innermost.Bar)
+	offset += 1
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Outer) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Outer) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Outer) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Outer", n, "Foo")
+	}
+	n += 1
+	s.Foo = uint8(buf[0])
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inner.Num")
+	}
+	n += 1
+	s.Inner.Num = uint8(buf[0])
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inner.Arr4")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", n, "Inner.Arr4")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Inner.Arr4")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Inner.Arr4")
+	}
+	s.Inner.Arr4 = make([]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inner.Arr4[%d]", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", n, "Inner.Arr4[%d]", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Inner.Arr4[%d]", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Inner.Arr4[%d]", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inner.Arr4[%d]", i)
+		}
+		n += int64(size)
+		s.Inner.Arr4[i] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inner.Innermost.Foo")
+	}
+	n += 1
+	s.Inner.Innermost.Foo = uint8(buf[0])
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inner.Innermost.Bar")
+	}
+	n += 1
+	s.Inner.Innermost.Bar = uint8(buf[0])
+	return n, nil
+}
+
+func (s *Outer) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 6
+	for _, v := range s.Inner.Arr4 {
+		size += 2
+		size += len(v)
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(s.Foo)
+	offset += 1
+	buf[offset] = byte(s.Inner.Num)
+	offset += 1
+	if uint64(len(s.Inner.Arr4)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Inner.Arr4))
+	}
 	buf[offset] = byte(len(s.Inner.Arr4))
 	buf[offset+1] = byte(len(s.Inner.Arr4) >> 8)
 	offset += 2
 	for _, v := range s.Inner.Arr4 {
+		if uint64(len(v)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
 		buf[offset] = byte(len(v))
 		buf[offset+1] = byte(len(v) >> 8)
 		offset += 2
@@ -116,43 +484,104 @@ func (s *Outer) WriteTo(w io.Writer) (n int, err error) {
 	offset += 1
 	buf[offset] = byte(s.Inner.Innermost.Bar)
 	offset += 1
-	return w.Write(buf)
+	return buf, nil
 }
 
-func (s *Outer) ReadFrom(r io.Reader) error {
-	buf := make([]byte, 8)
-	var size uint16
+func (s *Outer) EncodedSize() int {
+	size := 6
+	for _, v := range s.Inner.Arr4 {
+		size += 2
+		size += len(v)
+	}
+	return size
+}
+
+func (s *Outer) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Outer) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Outer) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
 	var tmp []byte
 	m := 0
 	c := 64
 	strBuf := make([]byte, c)
-	r.Read(buf[:1])
+	if len(data)-offset < 4 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Foo")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
 	s.Foo = uint8(buf[0])
-	r.Read(buf[:1])
+	buf = data[offset : offset+1]
+	offset += 1
 	s.Inner.Num = uint8(buf[0])
-	r.Read(buf[:2])
-	size = uint16(buf[0]) | (uint16(buf[1]) << 8)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", int64(offset), "Inner.Arr4")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inner.Arr4")
+	}
 	s.Inner.Arr4 = make([]string, size)
-	si := int(size)
+	si := size
 	for i := 0; i < si; i++ {
-		r.Read(buf[:2])
-		size = uint16(buf[0]) | (uint16(buf[1]) << 8)
-		if c-m < int(size) {
-			c = int(size)
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inner.Arr4[%d]", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", int64(offset), "Inner.Arr4[%d]", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inner.Arr4[%d]", i)
+		}
+		if c-m < size {
+			c = size
 			if c < 2*cap(strBuf) {
 				c = 2 * cap(strBuf)
 			}
 			strBuf = append([]byte(nil), make([]byte, c)...)
 			m = 0
 		}
-		r.Read(strBuf[m : m+int(size)])
-		tmp = strBuf[m : m+int(size)]
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inner.Arr4[%d]", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
 		s.Inner.Arr4[i] = *(*string)(unsafe.Pointer(&tmp))
-		m += int(size)
+		m += size
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inner.Innermost.Foo")
 	}
-	r.Read(buf[:1])
+	buf = data[offset : offset+1]
+	offset += 1
 	s.Inner.Innermost.Foo = uint8(buf[0])
-	r.Read(buf[:1])
+	buf = data[offset : offset+1]
+	offset += 1
 	s.Inner.Innermost.Bar = uint8(buf[0])
+	return offset, nil
+}
+
+func (s *Outer) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
 	return nil
 }
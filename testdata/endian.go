@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// Host is always encoded in little endian order.
+//
+//binenc:endian=little
+type Host uint32
+
+//go:generate go-binenc-gen -endian big endian.go
+type Endian struct {
+	Uint16  uint16
+	Int64   int64
+	Float64 float64
+	Complex complex64
+	Names   []string
+	Host    Host
+}
+
+func main() {
+	s := &Endian{
+		Uint16:  0x0102,
+		Int64:   -2,
+		Float64: math.Pi,
+		Complex: complex(1.5, -2.5),
+		Names:   []string{"be"},
+		Host:    0x01020304,
+	}
+
+	var buf bytes.Buffer
+	s.WriteTo(&buf)
+
+	var want bytes.Buffer
+	binary.Write(&want, binary.BigEndian, s.Uint16)
+	binary.Write(&want, binary.BigEndian, s.Int64)
+	binary.Write(&want, binary.BigEndian, s.Float64)
+	binary.Write(&want, binary.BigEndian, s.Complex)
+	binary.Write(&want, binary.BigEndian, uint16(len(s.Names)))
+	binary.Write(&want, binary.BigEndian, uint16(len(s.Names[0])))
+	want.WriteString(s.Names[0])
+	binary.Write(&want, binary.LittleEndian, s.Host)
+	if diff := cmp.Diff(want.Bytes(), buf.Bytes()); diff != "" {
+		panic("endian.go: \n" + diff)
+	}
+
+	o := new(Endian)
+	o.ReadFrom(&buf)
+
+	if diff := cmp.Diff(s, o); diff != "" {
+		panic("endian.go: \n" + diff)
+	}
+}
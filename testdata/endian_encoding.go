@@ -0,0 +1,332 @@
+// Code generated by "gobinenc -endian big endian.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Endian) WriteTo(w io.Writer) (n int64, err error) {
+	size := 32
+	for _, v := range s.Names {
+		size += 2
+		size += len(v)
+	}
+	buf := make([]byte, size)
+	offset := 0
+	buf[offset] = byte(s.Uint16 >> 8)
+	buf[offset+1] = byte(s.Uint16)
+	offset += 2
+	buf[offset] = byte(uint64(s.Int64) >> 56)
+	buf[offset+1] = byte(uint64(s.Int64) >> 48)
+	buf[offset+2] = byte(uint64(s.Int64) >> 40)
+	buf[offset+3] = byte(uint64(s.Int64) >> 32)
+	buf[offset+4] = byte(uint64(s.Int64) >> 24)
+	buf[offset+5] = byte(uint64(s.Int64) >> 16)
+	buf[offset+6] = byte(uint64(s.Int64) >> 8)
+	buf[offset+7] = byte(uint64(s.Int64))
+	offset += 8
+	buf[offset] = byte(math.Float64bits(s.Float64) >> 56)
+	buf[offset+1] = byte(math.Float64bits(s.Float64) >> 48)
+	buf[offset+2] = byte(math.Float64bits(s.Float64) >> 40)
+	buf[offset+3] = byte(math.Float64bits(s.Float64) >> 32)
+	buf[offset+4] = byte(math.Float64bits(s.Float64) >> 24)
+	buf[offset+5] = byte(math.Float64bits(s.Float64) >> 16)
+	buf[offset+6] = byte(math.Float64bits(s.Float64) >> 8)
+	buf[offset+7] = byte(math.Float64bits(s.Float64))
+	offset += 8
+	buf[offset] = byte(math.Float32bits(real(s.Complex)) >> 24)
+	buf[offset+1] = byte(math.Float32bits(real(s.Complex)) >> 16)
+	buf[offset+2] = byte(math.Float32bits(real(s.Complex)) >> 8)
+	buf[offset+3] = byte(math.Float32bits(real(s.Complex)))
+	offset += 4
+	buf[offset] = byte(math.Float32bits(imag(s.Complex)) >> 24)
+	buf[offset+1] = byte(math.Float32bits(imag(s.Complex)) >> 16)
+	buf[offset+2] = byte(math.Float32bits(imag(s.Complex)) >> 8)
+	buf[offset+3] = byte(math.Float32bits(imag(s.Complex)))
+	offset += 4
+	if uint64(len(s.Names)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Names))
+	}
+	buf[offset] = byte(len(s.Names) >> 8)
+	buf[offset+1] = byte(len(s.Names))
+	offset += 2
+	for _, v := range s.Names {
+		if uint64(len(v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v) >> 8)
+		buf[offset+1] = byte(len(v))
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	buf[offset] = byte(s.Host)
+	buf[offset+1] = byte(s.Host >> 8)
+	buf[offset+2] = byte(s.Host >> 16)
+	buf[offset+3] = byte(s.Host >> 24)
+	offset += 4
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Endian) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Endian) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Endian) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Endian", n, "Uint16")
+	}
+	n += 2
+	s.Uint16 = (uint16(buf[0]) << 8) | uint16(buf[1])
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Endian", n, "Int64")
+	}
+	n += 8
+	s.Int64 = int64((uint64(buf[0]) << 56) | (uint64(buf[1]) << 48) | (uint64(buf[2]) << 40) | (uint64(buf[3]) << 32) | (uint64(buf[4]) << 24) | (uint64(buf[5]) << 16) | (uint64(buf[6]) << 8) | uint64(buf[7]))
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Endian", n, "Float64")
+	}
+	n += 8
+	s.Float64 = math.Float64frombits((uint64(buf[0]) << 56) | (uint64(buf[1]) << 48) | (uint64(buf[2]) << 40) | (uint64(buf[3]) << 32) | (uint64(buf[4]) << 24) | (uint64(buf[5]) << 16) | (uint64(buf[6]) << 8) | uint64(buf[7]))
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Endian", n, "Complex")
+	}
+	n += 4
+	s.Complex = complex(math.Float32frombits((uint32(buf[0])<<24)|(uint32(buf[1])<<16)|(uint32(buf[2])<<8)|uint32(buf[3])), 0)
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Endian", n, "Complex")
+	}
+	n += 4
+	s.Complex = complex(real(s.Complex), math.Float32frombits((uint32(buf[0])<<24)|(uint32(buf[1])<<16)|(uint32(buf[2])<<8)|uint32(buf[3])))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Endian", n, "Names")
+	}
+	n += 2
+	size = int((uint16(buf[0]) << 8) | uint16(buf[1]))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Endian", n, "Names")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Endian", n, "Names")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Endian", n, "Names")
+	}
+	s.Names = make([]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Endian", n, "Names[%d]", i)
+		}
+		n += 2
+		size = int((uint16(buf[0]) << 8) | uint16(buf[1]))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Endian", n, "Names[%d]", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Endian", n, "Names[%d]", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Endian", n, "Names[%d]", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Endian", n, "Names[%d]", i)
+		}
+		n += int64(size)
+		s.Names[i] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Endian", n, "Host")
+	}
+	n += 4
+	s.Host = Host(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	return n, nil
+}
+
+func (s *Endian) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 32
+	for _, v := range s.Names {
+		size += 2
+		size += len(v)
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(s.Uint16 >> 8)
+	buf[offset+1] = byte(s.Uint16)
+	offset += 2
+	buf[offset] = byte(uint64(s.Int64) >> 56)
+	buf[offset+1] = byte(uint64(s.Int64) >> 48)
+	buf[offset+2] = byte(uint64(s.Int64) >> 40)
+	buf[offset+3] = byte(uint64(s.Int64) >> 32)
+	buf[offset+4] = byte(uint64(s.Int64) >> 24)
+	buf[offset+5] = byte(uint64(s.Int64) >> 16)
+	buf[offset+6] = byte(uint64(s.Int64) >> 8)
+	buf[offset+7] = byte(uint64(s.Int64))
+	offset += 8
+	buf[offset] = byte(math.Float64bits(s.Float64) >> 56)
+	buf[offset+1] = byte(math.Float64bits(s.Float64) >> 48)
+	buf[offset+2] = byte(math.Float64bits(s.Float64) >> 40)
+	buf[offset+3] = byte(math.Float64bits(s.Float64) >> 32)
+	buf[offset+4] = byte(math.Float64bits(s.Float64) >> 24)
+	buf[offset+5] = byte(math.Float64bits(s.Float64) >> 16)
+	buf[offset+6] = byte(math.Float64bits(s.Float64) >> 8)
+	buf[offset+7] = byte(math.Float64bits(s.Float64))
+	offset += 8
+	buf[offset] = byte(math.Float32bits(real(s.Complex)) >> 24)
+	buf[offset+1] = byte(math.Float32bits(real(s.Complex)) >> 16)
+	buf[offset+2] = byte(math.Float32bits(real(s.Complex)) >> 8)
+	buf[offset+3] = byte(math.Float32bits(real(s.Complex)))
+	offset += 4
+	buf[offset] = byte(math.Float32bits(imag(s.Complex)) >> 24)
+	buf[offset+1] = byte(math.Float32bits(imag(s.Complex)) >> 16)
+	buf[offset+2] = byte(math.Float32bits(imag(s.Complex)) >> 8)
+	buf[offset+3] = byte(math.Float32bits(imag(s.Complex)))
+	offset += 4
+	if uint64(len(s.Names)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Names))
+	}
+	buf[offset] = byte(len(s.Names) >> 8)
+	buf[offset+1] = byte(len(s.Names))
+	offset += 2
+	for _, v := range s.Names {
+		if uint64(len(v)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v) >> 8)
+		buf[offset+1] = byte(len(v))
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	buf[offset] = byte(s.Host)
+	buf[offset+1] = byte(s.Host >> 8)
+	buf[offset+2] = byte(s.Host >> 16)
+	buf[offset+3] = byte(s.Host >> 24)
+	offset += 4
+	return buf, nil
+}
+
+func (s *Endian) EncodedSize() int {
+	size := 32
+	for _, v := range s.Names {
+		size += 2
+		size += len(v)
+	}
+	return size
+}
+
+func (s *Endian) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Endian) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Endian) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 28 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Endian", int64(offset), "Uint16")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	s.Uint16 = (uint16(buf[0]) << 8) | uint16(buf[1])
+	buf = data[offset : offset+8]
+	offset += 8
+	s.Int64 = int64((uint64(buf[0]) << 56) | (uint64(buf[1]) << 48) | (uint64(buf[2]) << 40) | (uint64(buf[3]) << 32) | (uint64(buf[4]) << 24) | (uint64(buf[5]) << 16) | (uint64(buf[6]) << 8) | uint64(buf[7]))
+	buf = data[offset : offset+8]
+	offset += 8
+	s.Float64 = math.Float64frombits((uint64(buf[0]) << 56) | (uint64(buf[1]) << 48) | (uint64(buf[2]) << 40) | (uint64(buf[3]) << 32) | (uint64(buf[4]) << 24) | (uint64(buf[5]) << 16) | (uint64(buf[6]) << 8) | uint64(buf[7]))
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Complex = complex(math.Float32frombits((uint32(buf[0])<<24)|(uint32(buf[1])<<16)|(uint32(buf[2])<<8)|uint32(buf[3])), 0)
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Complex = complex(real(s.Complex), math.Float32frombits((uint32(buf[0])<<24)|(uint32(buf[1])<<16)|(uint32(buf[2])<<8)|uint32(buf[3])))
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int((uint16(buf[0]) << 8) | uint16(buf[1]))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Endian", int64(offset), "Names")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Endian", int64(offset), "Names")
+	}
+	s.Names = make([]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Endian", int64(offset), "Names[%d]", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int((uint16(buf[0]) << 8) | uint16(buf[1]))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Endian", int64(offset), "Names[%d]", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Endian", int64(offset), "Names[%d]", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Endian", int64(offset), "Names[%d]", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Names[i] = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	}
+	if len(data)-offset < 4 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Endian", int64(offset), "Host")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Host = Host(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	return offset, nil
+}
+
+func (s *Endian) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
@@ -3,23 +3,120 @@
 package main
 
 import (
+	"fmt"
 	"io"
-	"unsafe"
+	"math"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
 )
 
-func (s *Float) WriteTo(w io.Writer) (n int, err error) {
+func (s *Float) WriteTo(w io.Writer) (n int64, err error) {
 	size := 12
 	buf := make([]byte, size)
 	offset := 0
-	copy(buf[offset:], (*(*[4]byte)(unsafe.Pointer(&(s.Float32))))[:])
+	buf[offset] = byte(math.Float32bits(s.Float32))
+	buf[offset+1] = byte(math.Float32bits(s.Float32) >> 8)
+	buf[offset+2] = byte(math.Float32bits(s.Float32) >> 16)
+	buf[offset+3] = byte(math.Float32bits(s.Float32) >> 24)
+	offset += 4
+	buf[offset] = byte(math.Float64bits(s.Float64))
+	buf[offset+1] = byte(math.Float64bits(s.Float64) >> 8)
+	buf[offset+2] = byte(math.Float64bits(s.Float64) >> 16)
+	buf[offset+3] = byte(math.Float64bits(s.Float64) >> 24)
+	buf[offset+4] = byte(math.Float64bits(s.Float64) >> 32)
+	buf[offset+5] = byte(math.Float64bits(s.Float64) >> 40)
+	buf[offset+6] = byte(math.Float64bits(s.Float64) >> 48)
+	buf[offset+7] = byte(math.Float64bits(s.Float64) >> 56)
+	offset += 8
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Float) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Float) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Float) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Float", n, "Float32")
+	}
+	n += 4
+	s.Float32 = math.Float32frombits(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Float", n, "Float64")
+	}
+	n += 8
+	s.Float64 = math.Float64frombits(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
+	return n, nil
+}
+
+func (s *Float) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 12
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(math.Float32bits(s.Float32))
+	buf[offset+1] = byte(math.Float32bits(s.Float32) >> 8)
+	buf[offset+2] = byte(math.Float32bits(s.Float32) >> 16)
+	buf[offset+3] = byte(math.Float32bits(s.Float32) >> 24)
+	offset += 4
+	buf[offset] = byte(math.Float64bits(s.Float64))
+	buf[offset+1] = byte(math.Float64bits(s.Float64) >> 8)
+	buf[offset+2] = byte(math.Float64bits(s.Float64) >> 16)
+	buf[offset+3] = byte(math.Float64bits(s.Float64) >> 24)
+	buf[offset+4] = byte(math.Float64bits(s.Float64) >> 32)
+	buf[offset+5] = byte(math.Float64bits(s.Float64) >> 40)
+	buf[offset+6] = byte(math.Float64bits(s.Float64) >> 48)
+	buf[offset+7] = byte(math.Float64bits(s.Float64) >> 56)
+	offset += 8
+	return buf, nil
+}
+
+func (s *Float) EncodedSize() int {
+	size := 12
+	return size
+}
+
+func (s *Float) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Float) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Float) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	if len(data)-offset < 12 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Float", int64(offset), "Float32")
+	}
+	buf = data[offset : offset+4]
 	offset += 4
-	copy(buf[offset:], (*(*[8]byte)(unsafe.Pointer(&(s.Float64))))[:])
+	s.Float32 = math.Float32frombits(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	buf = data[offset : offset+8]
 	offset += 8
-	return w.Write(buf)
+	s.Float64 = math.Float64frombits(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
+	return offset, nil
 }
 
-func (s *Float) ReadFrom(r io.Reader) error {
-	r.Read((*(*[4]byte)(unsafe.Pointer(&(s.Float32))))[:])
-	r.Read((*(*[8]byte)(unsafe.Pointer(&(s.Float64))))[:])
+func (s *Float) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
 	return nil
 }
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+	"github.com/cezarguimaraes/go-binenc-gen/binenc/frame"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+//go:generate go-binenc-gen frame.go
+type Message struct {
+	Seq  uint32
+	Body string
+	Refs []uint32
+}
+
+type Empty struct {
+	Pad [0]uint8
+}
+
+// countWriter counts the writes to w.
+type countWriter struct {
+	w      io.Writer
+	writes int
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	c.writes++
+	return c.w.Write(p)
+}
+
+func main() {
+	messages := []*Message{
+		{Seq: 1, Body: "hello", Refs: []uint32{1, 2}},
+		{Seq: 2},
+		{Seq: 3, Body: "world"},
+	}
+
+	// frames are streamed through a pipe, batched into a single write
+	pr, pw := io.Pipe()
+	var writes int
+	go func() {
+		cw := &countWriter{w: pw}
+		e := frame.NewEncoder(cw)
+		for _, m := range messages {
+			if err := e.Encode(m); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		if err := e.Encode(&Empty{}); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		err := e.Flush()
+		writes = cw.writes
+		pw.CloseWithError(err)
+	}()
+	d := frame.NewDecoder(pr)
+	for i, m := range messages {
+		o := new(Message)
+		if err := d.Decode(o); err != nil {
+			panic(fmt.Sprintf("frame.go: Decode #%d: %v", i, err))
+		}
+		if diff := cmp.Diff(m, o, cmpopts.EquateEmpty()); diff != "" {
+			panic("frame.go: \n" + diff)
+		}
+	}
+	// a value may take no bytes at all
+	if err := d.Decode(&Empty{}); err != nil {
+		panic("frame.go: " + err.Error())
+	}
+	if err := d.Decode(new(Message)); err != io.EOF {
+		panic(fmt.Sprintf("frame.go: Decode = %v, want EOF", err))
+	}
+	if writes != 1 {
+		panic(fmt.Sprintf("frame.go: %d writes, want 1", writes))
+	}
+
+	var buf bytes.Buffer
+	e := frame.NewEncoder(&buf)
+	for _, m := range messages {
+		if err := e.Encode(m); err != nil {
+			panic("frame.go: " + err.Error())
+		}
+	}
+	if err := e.Flush(); err != nil {
+		panic("frame.go: " + err.Error())
+	}
+	stream := buf.Bytes()
+
+	// frames larger than allowed are rejected before being read
+	max := int64(messages[1].EncodedSize())
+	d = frame.NewDecoderOptions(bytes.NewReader(stream), binenc.DecodeOptions{MaxBytes: max})
+	var limitErr *binenc.LimitError
+	if err := d.Decode(new(Message)); !errors.As(err, &limitErr) || limitErr.Limit != "MaxBytes" {
+		panic(fmt.Sprintf("frame.go: Decode = %v, want a MaxBytes LimitError", err))
+	}
+
+	// a frame holding more than its value is an error, but the next frame
+	// is still decoded
+	first, _ := messages[0].MarshalBinary()
+	var bad []byte
+	bad = binary.AppendUvarint(bad, uint64(len(first)+2))
+	bad = append(append(bad, first...), 0, 0)
+	d = frame.NewDecoder(bytes.NewReader(append(bad, stream...)))
+	if err := d.Decode(new(Message)); err == nil {
+		panic("frame.go: expected trailing bytes error")
+	}
+	o := new(Message)
+	if err := d.Decode(o); err != nil || o.Seq != 1 {
+		panic(fmt.Sprintf("frame.go: Decode after a bad frame = %v, %v", o, err))
+	}
+
+	// as is a frame too short for its value
+	bad = binary.AppendUvarint(nil, 3)
+	bad = append(bad, first[:3]...)
+	d = frame.NewDecoder(bytes.NewReader(append(bad, stream...)))
+	if err := d.Decode(new(Message)); !errors.Is(err, io.ErrUnexpectedEOF) {
+		panic(fmt.Sprintf("frame.go: Decode = %v, want ErrUnexpectedEOF", err))
+	}
+	if err := d.Decode(o); err != nil || o.Seq != 1 {
+		panic(fmt.Sprintf("frame.go: Decode after a short frame = %v, %v", o, err))
+	}
+
+	// and a stream ending within a frame
+	d = frame.NewDecoder(bytes.NewReader(stream[:len(stream)-1]))
+	for i := 0; i < len(messages)-1; i++ {
+		if err := d.Decode(o); err != nil {
+			panic("frame.go: " + err.Error())
+		}
+	}
+	if err := d.Decode(o); !errors.Is(err, io.ErrUnexpectedEOF) {
+		panic(fmt.Sprintf("frame.go: Decode = %v, want ErrUnexpectedEOF", err))
+	}
+}
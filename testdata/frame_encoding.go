@@ -0,0 +1,451 @@
+// Code generated by "gobinenc frame.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Message) WriteTo(w io.Writer) (n int64, err error) {
+	size := 8
+	size += len(s.Body) + 4*len(s.Refs)
+	buf := make([]byte, size)
+	offset := 0
+	buf[offset] = byte(s.Seq)
+	buf[offset+1] = byte(s.Seq >> 8)
+	buf[offset+2] = byte(s.Seq >> 16)
+	buf[offset+3] = byte(s.Seq >> 24)
+	offset += 4
+	if uint64(len(s.Body)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Body))
+	}
+	buf[offset] = byte(len(s.Body))
+	buf[offset+1] = byte(len(s.Body) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Body)
+	offset += len(s.Body)
+	if uint64(len(s.Refs)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Refs))
+	}
+	buf[offset] = byte(len(s.Refs))
+	buf[offset+1] = byte(len(s.Refs) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Refs) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Refs[0])), 4*len(s.Refs)))
+		offset += 4 * len(s.Refs)
+	} else {
+		for _, v := range s.Refs {
+			buf[offset] = byte(v)
+			buf[offset+1] = byte(v >> 8)
+			buf[offset+2] = byte(v >> 16)
+			buf[offset+3] = byte(v >> 24)
+			offset += 4
+		}
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Message) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Message) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Message) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Seq")
+	}
+	n += 4
+	s.Seq = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Body")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Message", n, "Body")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Message", n, "Body")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Message", n, "Body")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Body")
+	}
+	n += int64(size)
+	s.Body = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Refs")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", n, "Refs")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Message", n, "Refs")
+	}
+	if size > binenc.MaxAlloc/4 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Message", n, "Refs")
+	}
+	s.Refs = make([]uint32, size)
+	if binenc.LittleEndian && size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Refs[0])), 4*size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Message", n, "Refs")
+		}
+		n += int64(4 * size)
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Message", n, "Refs[%d]", i)
+			}
+			n += 4
+			s.Refs[i] = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		}
+	}
+	return n, nil
+}
+
+func (s *Message) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 8
+	size += len(s.Body) + 4*len(s.Refs)
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(s.Seq)
+	buf[offset+1] = byte(s.Seq >> 8)
+	buf[offset+2] = byte(s.Seq >> 16)
+	buf[offset+3] = byte(s.Seq >> 24)
+	offset += 4
+	if uint64(len(s.Body)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Body))
+	}
+	buf[offset] = byte(len(s.Body))
+	buf[offset+1] = byte(len(s.Body) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Body)
+	offset += len(s.Body)
+	if uint64(len(s.Refs)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Refs))
+	}
+	buf[offset] = byte(len(s.Refs))
+	buf[offset+1] = byte(len(s.Refs) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Refs) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Refs[0])), 4*len(s.Refs)))
+		offset += 4 * len(s.Refs)
+	} else {
+		for _, v := range s.Refs {
+			buf[offset] = byte(v)
+			buf[offset+1] = byte(v >> 8)
+			buf[offset+2] = byte(v >> 16)
+			buf[offset+3] = byte(v >> 24)
+			offset += 4
+		}
+	}
+	return buf, nil
+}
+
+func (s *Message) EncodedSize() int {
+	size := 8
+	size += len(s.Body) + 4*len(s.Refs)
+	return size
+}
+
+func (s *Message) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Message) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Message) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 6 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Seq")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Seq = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Message", int64(offset), "Body")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Body")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Body")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Body = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Refs")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", int64(offset), "Refs")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Refs")
+	}
+	s.Refs = make([]uint32, size)
+	if binenc.LittleEndian && size > 0 {
+		if len(data)-offset < 4*size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Refs")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Refs[0])), 4*size), data[offset:])
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if len(data)-offset < 4 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Refs[%d]", i)
+			}
+			buf = data[offset : offset+4]
+			offset += 4
+			s.Refs[i] = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		}
+	}
+	return offset, nil
+}
+
+func (s *Message) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Empty) WriteTo(w io.Writer) (n int64, err error) {
+	size := 0
+	buf := make([]byte, size)
+	offset := 0
+	for i1 := 0; i1 < 0; i1++ {
+		buf[offset] = byte(s.Pad[i1])
+		offset += 1
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Empty) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Empty) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Empty) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	for i := 0; i < 0; i++ {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Empty", n, "Pad[%d]", i)
+		}
+		n += 1
+		s.Pad[i] = uint8(buf[0])
+	}
+	return n, nil
+}
+
+func (s *Empty) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 0
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	for i1 := 0; i1 < 0; i1++ {
+		buf[offset] = byte(s.Pad[i1])
+		offset += 1
+	}
+	return buf, nil
+}
+
+func (s *Empty) EncodedSize() int {
+	size := 0
+	return size
+}
+
+func (s *Empty) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Empty) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Empty) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	for i := 0; i < 0; i++ {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Empty", int64(offset), "Pad[%d]", i)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		s.Pad[i] = uint8(buf[0])
+	}
+	return offset, nil
+}
+
+func (s *Empty) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *countWriter) WriteTo(w io.Writer) (n int64, err error) {
+	size := 8
+	buf := make([]byte, size)
+	offset := 0
+	buf[offset] = byte(uint64(s.writes))
+	buf[offset+1] = byte(uint64(s.writes) >> 8)
+	buf[offset+2] = byte(uint64(s.writes) >> 16)
+	buf[offset+3] = byte(uint64(s.writes) >> 24)
+	buf[offset+4] = byte(uint64(s.writes) >> 32)
+	buf[offset+5] = byte(uint64(s.writes) >> 40)
+	buf[offset+6] = byte(uint64(s.writes) >> 48)
+	buf[offset+7] = byte(uint64(s.writes) >> 56)
+	offset += 8
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *countWriter) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *countWriter) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *countWriter) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "countWriter", n, "writes")
+	}
+	n += 8
+	if x := int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)); int64(int(x)) != x {
+		return n, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "countWriter", n, "writes")
+	} else {
+		s.writes = int(x)
+	}
+	return n, nil
+}
+
+func (s *countWriter) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 8
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(uint64(s.writes))
+	buf[offset+1] = byte(uint64(s.writes) >> 8)
+	buf[offset+2] = byte(uint64(s.writes) >> 16)
+	buf[offset+3] = byte(uint64(s.writes) >> 24)
+	buf[offset+4] = byte(uint64(s.writes) >> 32)
+	buf[offset+5] = byte(uint64(s.writes) >> 40)
+	buf[offset+6] = byte(uint64(s.writes) >> 48)
+	buf[offset+7] = byte(uint64(s.writes) >> 56)
+	offset += 8
+	return buf, nil
+}
+
+func (s *countWriter) EncodedSize() int {
+	size := 8
+	return size
+}
+
+func (s *countWriter) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *countWriter) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *countWriter) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	if len(data)-offset < 8 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "countWriter", int64(offset), "writes")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	if x := int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)); int64(int(x)) != x {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "countWriter", int64(offset), "writes")
+	} else {
+		s.writes = int(x)
+	}
+	return offset, nil
+}
+
+func (s *countWriter) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"bytes"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+//go:generate go-binenc-gen -legacy legacy.go
+type Legacy struct {
+	Name  string
+	Value uint32
+}
+
+func main() {
+	s := &Legacy{
+		Name:  "legacy",
+		Value: 42,
+	}
+
+	var buf bytes.Buffer
+	var n int
+	n, err := s.WriteTo(&buf)
+	if err != nil || n != buf.Len() {
+		panic("legacy.go: unexpected WriteTo result")
+	}
+
+	o := new(Legacy)
+	if err := o.ReadFrom(&buf); err != nil {
+		panic("legacy.go: " + err.Error())
+	}
+
+	if diff := cmp.Diff(s, o); diff != "" {
+		panic("legacy.go: \n" + diff)
+	}
+}
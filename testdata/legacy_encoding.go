@@ -0,0 +1,171 @@
+// Code generated by "gobinenc -legacy legacy.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Legacy) WriteTo(w io.Writer) (n int, err error) {
+	size := 6
+	size += len(s.Name)
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	buf[offset] = byte(s.Value)
+	buf[offset+1] = byte(s.Value >> 8)
+	buf[offset+2] = byte(s.Value >> 16)
+	buf[offset+3] = byte(s.Value >> 24)
+	offset += 4
+	return w.Write(buf)
+}
+
+func (s *Legacy) ReadFrom(r io.Reader) error {
+	_, err := s.ReadFromOptions(r, binenc.DecodeOptions{})
+	return err
+}
+
+func (s *Legacy) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Legacy) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Legacy", n, "Name")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Legacy", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Legacy", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Legacy", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Legacy", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Legacy", n, "Value")
+	}
+	n += 4
+	s.Value = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	return n, nil
+}
+
+func (s *Legacy) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 6
+	size += len(s.Name)
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	buf[offset] = byte(s.Value)
+	buf[offset+1] = byte(s.Value >> 8)
+	buf[offset+2] = byte(s.Value >> 16)
+	buf[offset+3] = byte(s.Value >> 24)
+	offset += 4
+	return buf, nil
+}
+
+func (s *Legacy) EncodedSize() int {
+	size := 6
+	size += len(s.Name)
+	return size
+}
+
+func (s *Legacy) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Legacy) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Legacy) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Legacy", int64(offset), "Name")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Legacy", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Legacy", int64(offset), "Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Legacy", int64(offset), "Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 4 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Legacy", int64(offset), "Value")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Value = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	return offset, nil
+}
+
+func (s *Legacy) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
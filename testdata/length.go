@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+//go:generate go-binenc-gen length.go
+type Length struct {
+	Short   string `binenc:"len=1"`
+	Default []uint8
+	Long    string            `binenc:"len=4"`
+	Huge    []string          `binenc:"len=8"`
+	Varint  map[string]string `binenc:"len=uvarint"`
+}
+
+func main() {
+	s := &Length{
+		Short:   "short",
+		Default: make([]uint8, 1<<16-1),
+		Long:    strings.Repeat("long", 1<<15),
+		Huge:    []string{"a", "b"},
+		Varint: map[string]string{
+			"k": strings.Repeat("v", 300),
+		},
+	}
+
+	var buf bytes.Buffer
+	if _, err := s.WriteTo(&buf); err != nil {
+		panic("length.go: " + err.Error())
+	}
+
+	o := new(Length)
+	o.ReadFrom(&buf)
+
+	if diff := cmp.Diff(s, o); diff != "" {
+		panic("length.go: \n" + diff)
+	}
+
+	// lengths that do not fit their prefix are errors
+	for _, s := range []*Length{
+		{Short: strings.Repeat("x", 1<<8)},
+		{Default: make([]uint8, 1<<16)},
+	} {
+		buf.Reset()
+		if _, err := s.WriteTo(&buf); err == nil {
+			panic("length.go: expected overflow error")
+		}
+		if buf.Len() != 0 {
+			panic("length.go: partial write on error")
+		}
+	}
+}
@@ -0,0 +1,668 @@
+// Code generated by "gobinenc length.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Length) WriteTo(w io.Writer) (n int64, err error) {
+	size := 15
+	size += len(s.Short) + len(s.Default) + len(s.Long) + (bits.Len64(uint64(len(s.Varint))|1)+6)/7
+	for _, v := range s.Huge {
+		size += 8
+		size += len(v)
+	}
+	for k, v := range s.Varint {
+		size += (bits.Len64(uint64(len(k))|1)+6)/7 + len(k) + (bits.Len64(uint64(len(v))|1)+6)/7 + len(v)
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Short)) > math.MaxUint8 {
+		return 0, fmt.Errorf("binenc: length %d overflows 1-byte prefix", len(s.Short))
+	}
+	buf[offset] = byte(len(s.Short))
+	offset += 1
+	copy(buf[offset:], s.Short)
+	offset += len(s.Short)
+	if uint64(len(s.Default)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Default))
+	}
+	buf[offset] = byte(len(s.Default))
+	buf[offset+1] = byte(len(s.Default) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Default)
+	offset += len(s.Default)
+	if uint64(len(s.Long)) > math.MaxUint32 {
+		return 0, fmt.Errorf("binenc: length %d overflows 4-byte prefix", len(s.Long))
+	}
+	buf[offset] = byte(len(s.Long))
+	buf[offset+1] = byte(len(s.Long) >> 8)
+	buf[offset+2] = byte(len(s.Long) >> 16)
+	buf[offset+3] = byte(len(s.Long) >> 24)
+	offset += 4
+	copy(buf[offset:], s.Long)
+	offset += len(s.Long)
+	buf[offset] = byte(len(s.Huge))
+	buf[offset+1] = byte(len(s.Huge) >> 8)
+	buf[offset+2] = byte(len(s.Huge) >> 16)
+	buf[offset+3] = byte(len(s.Huge) >> 24)
+	buf[offset+4] = byte(len(s.Huge) >> 32)
+	buf[offset+5] = byte(len(s.Huge) >> 40)
+	buf[offset+6] = byte(len(s.Huge) >> 48)
+	buf[offset+7] = byte(len(s.Huge) >> 56)
+	offset += 8
+	for _, v := range s.Huge {
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		buf[offset+2] = byte(len(v) >> 16)
+		buf[offset+3] = byte(len(v) >> 24)
+		buf[offset+4] = byte(len(v) >> 32)
+		buf[offset+5] = byte(len(v) >> 40)
+		buf[offset+6] = byte(len(v) >> 48)
+		buf[offset+7] = byte(len(v) >> 56)
+		offset += 8
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Varint)))
+	for k, v := range s.Varint {
+		offset += binary.PutUvarint(buf[offset:], uint64(len(k)))
+		copy(buf[offset:], k)
+		offset += len(k)
+		offset += binary.PutUvarint(buf[offset:], uint64(len(v)))
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Length) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Length) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Length) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var usize uint64
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Length", n, "Short")
+	}
+	n += 1
+	size = int(uint8(buf[0]))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Length", n, "Short")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Length", n, "Short")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Length", n, "Short")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Length", n, "Short")
+	}
+	n += int64(size)
+	s.Short = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Length", n, "Default")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Length", n, "Default")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Length", n, "Default")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Length", n, "Default")
+	}
+	s.Default = make([]uint8, size)
+	if nr, err := io.ReadFull(r, s.Default); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Length", n, "Default")
+	}
+	n += int64(size)
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Length", n, "Long")
+	}
+	n += 4
+	if x := uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24); uint64(x) > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Length", n, "Long")
+	} else {
+		size = int(x)
+	}
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Length", n, "Long")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Length", n, "Long")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Length", n, "Long")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Length", n, "Long")
+	}
+	n += int64(size)
+	s.Long = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Length", n, "Huge")
+	}
+	n += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Length", n, "Huge")
+	} else {
+		size = int(x)
+	}
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Length", n, "Huge")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Length", n, "Huge")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Length", n, "Huge")
+	}
+	s.Huge = make([]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Length", n, "Huge[%d]", i)
+		}
+		n += 8
+		if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Length", n, "Huge[%d]", i)
+		} else {
+			size = int(x)
+		}
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Length", n, "Huge[%d]", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Length", n, "Huge[%d]", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Length", n, "Huge[%d]", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Length", n, "Huge[%d]", i)
+		}
+		n += int64(size)
+		s.Huge[i] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Length", n, "Varint")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Length", n, "Varint")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Length", n, "Varint")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Length", n, "Varint")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Length", n, "Varint")
+	}
+	s.Varint = make(map[string]string, binenc.MapHint(size))
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 string
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Length", n, "Varint")
+			}
+			n += 1
+			if shift == 63 && buf[0] > 1 {
+				return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Length", n, "Varint")
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if usize > math.MaxInt {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Length", n, "Varint")
+		}
+		size = int(usize)
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Length", n, "Varint")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Length", n, "Varint")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Length", n, "Varint")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Length", n, "Varint")
+		}
+		n += int64(size)
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Length", n, "Varint[%q]", k1)
+			}
+			n += 1
+			if shift == 63 && buf[0] > 1 {
+				return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Length", n, "Varint[%q]", k1)
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if usize > math.MaxInt {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Length", n, "Varint[%q]", k1)
+		}
+		size = int(usize)
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Length", n, "Varint[%q]", k1)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Length", n, "Varint[%q]", k1)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Length", n, "Varint[%q]", k1)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Length", n, "Varint[%q]", k1)
+		}
+		n += int64(size)
+		v1 = *(*string)(unsafe.Pointer(&tmp))
+		s.Varint[k1] = v1
+	}
+	return n, nil
+}
+
+func (s *Length) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 15
+	size += len(s.Short) + len(s.Default) + len(s.Long) + (bits.Len64(uint64(len(s.Varint))|1)+6)/7
+	for _, v := range s.Huge {
+		size += 8
+		size += len(v)
+	}
+	for k, v := range s.Varint {
+		size += (bits.Len64(uint64(len(k))|1)+6)/7 + len(k) + (bits.Len64(uint64(len(v))|1)+6)/7 + len(v)
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Short)) > math.MaxUint8 {
+		return dst, fmt.Errorf("binenc: length %d overflows 1-byte prefix", len(s.Short))
+	}
+	buf[offset] = byte(len(s.Short))
+	offset += 1
+	copy(buf[offset:], s.Short)
+	offset += len(s.Short)
+	if uint64(len(s.Default)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Default))
+	}
+	buf[offset] = byte(len(s.Default))
+	buf[offset+1] = byte(len(s.Default) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Default)
+	offset += len(s.Default)
+	if uint64(len(s.Long)) > math.MaxUint32 {
+		return dst, fmt.Errorf("binenc: length %d overflows 4-byte prefix", len(s.Long))
+	}
+	buf[offset] = byte(len(s.Long))
+	buf[offset+1] = byte(len(s.Long) >> 8)
+	buf[offset+2] = byte(len(s.Long) >> 16)
+	buf[offset+3] = byte(len(s.Long) >> 24)
+	offset += 4
+	copy(buf[offset:], s.Long)
+	offset += len(s.Long)
+	buf[offset] = byte(len(s.Huge))
+	buf[offset+1] = byte(len(s.Huge) >> 8)
+	buf[offset+2] = byte(len(s.Huge) >> 16)
+	buf[offset+3] = byte(len(s.Huge) >> 24)
+	buf[offset+4] = byte(len(s.Huge) >> 32)
+	buf[offset+5] = byte(len(s.Huge) >> 40)
+	buf[offset+6] = byte(len(s.Huge) >> 48)
+	buf[offset+7] = byte(len(s.Huge) >> 56)
+	offset += 8
+	for _, v := range s.Huge {
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		buf[offset+2] = byte(len(v) >> 16)
+		buf[offset+3] = byte(len(v) >> 24)
+		buf[offset+4] = byte(len(v) >> 32)
+		buf[offset+5] = byte(len(v) >> 40)
+		buf[offset+6] = byte(len(v) >> 48)
+		buf[offset+7] = byte(len(v) >> 56)
+		offset += 8
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Varint)))
+	for k, v := range s.Varint {
+		offset += binary.PutUvarint(buf[offset:], uint64(len(k)))
+		copy(buf[offset:], k)
+		offset += len(k)
+		offset += binary.PutUvarint(buf[offset:], uint64(len(v)))
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	return buf, nil
+}
+
+func (s *Length) EncodedSize() int {
+	size := 15
+	size += len(s.Short) + len(s.Default) + len(s.Long) + (bits.Len64(uint64(len(s.Varint))|1)+6)/7
+	for _, v := range s.Huge {
+		size += 8
+		size += len(v)
+	}
+	for k, v := range s.Varint {
+		size += (bits.Len64(uint64(len(k))|1)+6)/7 + len(k) + (bits.Len64(uint64(len(v))|1)+6)/7 + len(v)
+	}
+	return size
+}
+
+func (s *Length) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Length) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Length) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var usize uint64
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Short")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	size = int(uint8(buf[0]))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Length", int64(offset), "Short")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Short")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Short")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Short = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Default")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Length", int64(offset), "Default")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Default")
+	}
+	s.Default = make([]uint8, size)
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Default")
+	}
+	offset += copy(s.Default, data[offset:])
+	if len(data)-offset < 4 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Long")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	if x := uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24); uint64(x) > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Length", int64(offset), "Long")
+	} else {
+		size = int(x)
+	}
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Length", int64(offset), "Long")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Long")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Long")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Long = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 8 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Huge")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Length", int64(offset), "Huge")
+	} else {
+		size = int(x)
+	}
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Length", int64(offset), "Huge")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Huge")
+	}
+	s.Huge = make([]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 8 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Huge[%d]", i)
+		}
+		buf = data[offset : offset+8]
+		offset += 8
+		if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); x > math.MaxInt {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", x), "Length", int64(offset), "Huge[%d]", i)
+		} else {
+			size = int(x)
+		}
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Length", int64(offset), "Huge[%d]", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Huge[%d]", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Huge[%d]", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Huge[i] = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	}
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Varint")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Length", int64(offset), "Varint")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Length", int64(offset), "Varint")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Length", int64(offset), "Varint")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Varint")
+	}
+	s.Varint = make(map[string]string, size)
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 string
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if len(data)-offset < 1 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Varint")
+			}
+			buf = data[offset : offset+1]
+			offset += 1
+			if shift == 63 && buf[0] > 1 {
+				return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Length", int64(offset), "Varint")
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if usize > math.MaxInt {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Length", int64(offset), "Varint")
+		}
+		size = int(usize)
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Length", int64(offset), "Varint")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Varint")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Varint")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if len(data)-offset < 1 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Varint[%q]", k1)
+			}
+			buf = data[offset : offset+1]
+			offset += 1
+			if shift == 63 && buf[0] > 1 {
+				return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Length", int64(offset), "Varint[%q]", k1)
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if usize > math.MaxInt {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Length", int64(offset), "Varint[%q]", k1)
+		}
+		size = int(usize)
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Length", int64(offset), "Varint[%q]", k1)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Varint[%q]", k1)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Length", int64(offset), "Varint[%q]", k1)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		v1 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		s.Varint[k1] = v1
+	}
+	return offset, nil
+}
+
+func (s *Length) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+//go:generate go-binenc-gen -lensize uvarint limits.go
+type Limits struct {
+	Name   string
+	Values []uint32
+	Attrs  map[string]bool
+	Tree   *Tree
+}
+
+type Tree struct {
+	Children []Tree
+}
+
+// decodeLimit decodes data into a new Limits through both ReadFromOptions
+// and DecodeFromOptions, and returns the limit exceeded by both, if any.
+func decodeLimit(data []byte, opts binenc.DecodeOptions) string {
+	var limits [2]string
+	var errs [2]error
+	_, errs[0] = new(Limits).ReadFromOptions(bytes.NewReader(data), opts)
+	_, errs[1] = new(Limits).DecodeFromOptions(data, opts)
+	for i, err := range errs {
+		var limitErr *binenc.LimitError
+		if errors.As(err, &limitErr) {
+			limits[i] = limitErr.Limit
+		} else if err != nil {
+			panic("limits.go: " + err.Error())
+		}
+	}
+	if limits[0] != limits[1] {
+		panic("limits.go: ReadFromOptions exceeded " + limits[0] + ", DecodeFromOptions " + limits[1])
+	}
+	return limits[0]
+}
+
+func main() {
+	s := &Limits{
+		Name:   "name",
+		Values: []uint32{1, 2, 3},
+		Attrs:  map[string]bool{"a": true},
+		Tree:   &Tree{Children: []Tree{{Children: []Tree{{}}}}},
+	}
+	data, err := s.MarshalBinary()
+	if err != nil {
+		panic("limits.go: " + err.Error())
+	}
+
+	// values within the limits decode as usual
+	opts := binenc.DecodeOptions{MaxSliceLen: 3, MaxStringLen: 4, MaxBytes: int64(len(data)), MaxDepth: 2}
+	if limit := decodeLimit(data, opts); limit != "" {
+		panic("limits.go: unexpected limit " + limit)
+	}
+	o := new(Limits)
+	if _, err := o.DecodeFromOptions(data, opts); err != nil {
+		panic("limits.go: " + err.Error())
+	}
+	if diff := cmp.Diff(s, o, cmpopts.EquateEmpty()); diff != "" {
+		panic("limits.go: \n" + diff)
+	}
+
+	for _, c := range []struct {
+		opts binenc.DecodeOptions
+		want string
+	}{
+		{binenc.DecodeOptions{MaxSliceLen: 2}, "MaxSliceLen"},
+		{binenc.DecodeOptions{MaxStringLen: 3}, "MaxStringLen"},
+		{binenc.DecodeOptions{MaxBytes: int64(len(data) - 1)}, "MaxBytes"},
+		{binenc.DecodeOptions{MaxBytes: 3}, "MaxBytes"},
+		{binenc.DecodeOptions{MaxDepth: 1}, "MaxDepth"},
+	} {
+		if limit := decodeLimit(data, c.opts); limit != c.want {
+			panic("limits.go: exceeded " + limit + ", want " + c.want)
+		}
+	}
+
+	// a few bytes claiming a huge slice do not allocate it
+	hostile := []byte{0x00, 0xff, 0xff, 0xff, 0x7f}
+	if limit := decodeLimit(hostile, binenc.DecodeOptions{MaxSliceLen: 1 << 20}); limit != "MaxSliceLen" {
+		panic("limits.go: exceeded " + limit + ", want MaxSliceLen")
+	}
+	// nor does reading them from a stream with a byte limit, while decoding
+	// them from memory reports the input as truncated
+	var limitErr *binenc.LimitError
+	opts = binenc.DecodeOptions{MaxBytes: 1 << 20}
+	if _, err := new(Limits).ReadFromOptions(bytes.NewReader(hostile), opts); !errors.As(err, &limitErr) || limitErr.Limit != "MaxBytes" {
+		panic("limits.go: expected MaxBytes LimitError")
+	}
+	if _, err := new(Limits).DecodeFromOptions(hostile, opts); !errors.Is(err, io.ErrUnexpectedEOF) {
+		panic("limits.go: expected io.ErrUnexpectedEOF")
+	}
+}
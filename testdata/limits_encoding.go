@@ -0,0 +1,874 @@
+// Code generated by "gobinenc -lensize uvarint limits.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Limits) WriteTo(w io.Writer) (n int64, err error) {
+	size := 1
+	size += (bits.Len64(uint64(len(s.Name))|1)+6)/7 + len(s.Name) + (bits.Len64(uint64(len(s.Values))|1)+6)/7 + 4*len(s.Values) + (bits.Len64(uint64(len(s.Attrs))|1)+6)/7
+	for k := range s.Attrs {
+		size += 1
+		size += (bits.Len64(uint64(len(k))|1)+6)/7 + len(k)
+	}
+	if s.Tree != nil {
+		size += (bits.Len64(uint64(len((*s.Tree).Children))|1) + 6) / 7
+		for i2 := range (*s.Tree).Children {
+			size += (*s.Tree).Children[i2].sizeBinenc()
+		}
+	}
+	buf := make([]byte, size)
+	offset := 0
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Name)))
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Values)))
+	if binenc.LittleEndian && len(s.Values) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 4*len(s.Values)))
+		offset += 4 * len(s.Values)
+	} else {
+		for _, v := range s.Values {
+			buf[offset] = byte(v)
+			buf[offset+1] = byte(v >> 8)
+			buf[offset+2] = byte(v >> 16)
+			buf[offset+3] = byte(v >> 24)
+			offset += 4
+		}
+	}
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Attrs)))
+	for k, v := range s.Attrs {
+		offset += binary.PutUvarint(buf[offset:], uint64(len(k)))
+		copy(buf[offset:], k)
+		offset += len(k)
+		if v {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+	}
+	if s.Tree != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Tree != nil {
+		offset += binary.PutUvarint(buf[offset:], uint64(len((*s.Tree).Children)))
+		for i2 := range (*s.Tree).Children {
+			offset, err = (*s.Tree).Children[i2].writeBinenc(buf, offset)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Limits) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Limits) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Limits) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var usize uint64
+	var tmp []byte
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Limits", n, "Name")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Limits", n, "Name")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Limits", n, "Name")
+	}
+	size = int(usize)
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Limits", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Limits", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Limits", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Limits", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Limits", n, "Values")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Limits", n, "Values")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Limits", n, "Values")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Limits", n, "Values")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Limits", n, "Values")
+	}
+	if size > binenc.MaxAlloc/4 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Limits", n, "Values")
+	}
+	s.Values = make([]uint32, size)
+	if binenc.LittleEndian && size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 4*size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Limits", n, "Values")
+		}
+		n += int64(4 * size)
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Limits", n, "Values[%d]", i)
+			}
+			n += 4
+			s.Values[i] = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		}
+	}
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Limits", n, "Attrs")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Limits", n, "Attrs")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Limits", n, "Attrs")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Limits", n, "Attrs")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Limits", n, "Attrs")
+	}
+	s.Attrs = make(map[string]bool, binenc.MapHint(size))
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 bool
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Limits", n, "Attrs")
+			}
+			n += 1
+			if shift == 63 && buf[0] > 1 {
+				return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Limits", n, "Attrs")
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if usize > math.MaxInt {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Limits", n, "Attrs")
+		}
+		size = int(usize)
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Limits", n, "Attrs")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Limits", n, "Attrs")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Limits", n, "Attrs")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Limits", n, "Attrs")
+		}
+		n += int64(size)
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Limits", n, "Attrs[%q]", k1)
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			v1 = true
+		} else {
+			v1 = false
+		}
+		s.Attrs[k1] = v1
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Limits", n, "Tree")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Tree = new(Tree)
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Limits", n, "Tree.Children")
+			}
+			n += 1
+			if shift == 63 && buf[0] > 1 {
+				return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Limits", n, "Tree.Children")
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if usize > math.MaxInt {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Limits", n, "Tree.Children")
+		}
+		size = int(usize)
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Limits", n, "Tree.Children")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Limits", n, "Tree.Children")
+		}
+		if size > binenc.MaxAlloc/24 {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Limits", n, "Tree.Children")
+		}
+		(*s.Tree).Children = make([]Tree, size)
+		si2 := size
+		for i2 := 0; i2 < si2; i2++ {
+			if n, err = (*s.Tree).Children[i2].readBinenc(d, n, 1); err != nil {
+				return n, binenc.FieldError(err, "Limits", n, "Tree.Children[%d]", i2)
+			}
+		}
+	} else {
+		s.Tree = nil
+	}
+	return n, nil
+}
+
+func (s *Limits) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 1
+	size += (bits.Len64(uint64(len(s.Name))|1)+6)/7 + len(s.Name) + (bits.Len64(uint64(len(s.Values))|1)+6)/7 + 4*len(s.Values) + (bits.Len64(uint64(len(s.Attrs))|1)+6)/7
+	for k := range s.Attrs {
+		size += 1
+		size += (bits.Len64(uint64(len(k))|1)+6)/7 + len(k)
+	}
+	if s.Tree != nil {
+		size += (bits.Len64(uint64(len((*s.Tree).Children))|1) + 6) / 7
+		for i2 := range (*s.Tree).Children {
+			size += (*s.Tree).Children[i2].sizeBinenc()
+		}
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Name)))
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Values)))
+	if binenc.LittleEndian && len(s.Values) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 4*len(s.Values)))
+		offset += 4 * len(s.Values)
+	} else {
+		for _, v := range s.Values {
+			buf[offset] = byte(v)
+			buf[offset+1] = byte(v >> 8)
+			buf[offset+2] = byte(v >> 16)
+			buf[offset+3] = byte(v >> 24)
+			offset += 4
+		}
+	}
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Attrs)))
+	for k, v := range s.Attrs {
+		offset += binary.PutUvarint(buf[offset:], uint64(len(k)))
+		copy(buf[offset:], k)
+		offset += len(k)
+		if v {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+	}
+	if s.Tree != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Tree != nil {
+		offset += binary.PutUvarint(buf[offset:], uint64(len((*s.Tree).Children)))
+		for i2 := range (*s.Tree).Children {
+			offset, err = (*s.Tree).Children[i2].writeBinenc(buf, offset)
+			if err != nil {
+				return dst, err
+			}
+		}
+	}
+	return buf, nil
+}
+
+func (s *Limits) EncodedSize() int {
+	size := 1
+	size += (bits.Len64(uint64(len(s.Name))|1)+6)/7 + len(s.Name) + (bits.Len64(uint64(len(s.Values))|1)+6)/7 + 4*len(s.Values) + (bits.Len64(uint64(len(s.Attrs))|1)+6)/7
+	for k := range s.Attrs {
+		size += 1
+		size += (bits.Len64(uint64(len(k))|1)+6)/7 + len(k)
+	}
+	if s.Tree != nil {
+		size += (bits.Len64(uint64(len((*s.Tree).Children))|1) + 6) / 7
+		for i2 := range (*s.Tree).Children {
+			size += (*s.Tree).Children[i2].sizeBinenc()
+		}
+	}
+	return size
+}
+
+func (s *Limits) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Limits) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Limits) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var usize uint64
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Name")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Limits", int64(offset), "Name")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Limits", int64(offset), "Name")
+	}
+	size = int(usize)
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Limits", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Values")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Limits", int64(offset), "Values")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Limits", int64(offset), "Values")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Limits", int64(offset), "Values")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Values")
+	}
+	s.Values = make([]uint32, size)
+	if binenc.LittleEndian && size > 0 {
+		if len(data)-offset < 4*size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Values")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Values[0])), 4*size), data[offset:])
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if len(data)-offset < 4 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Values[%d]", i)
+			}
+			buf = data[offset : offset+4]
+			offset += 4
+			s.Values[i] = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		}
+	}
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Attrs")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Limits", int64(offset), "Attrs")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Limits", int64(offset), "Attrs")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Limits", int64(offset), "Attrs")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Attrs")
+	}
+	s.Attrs = make(map[string]bool, size)
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 bool
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if len(data)-offset < 1 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Attrs")
+			}
+			buf = data[offset : offset+1]
+			offset += 1
+			if shift == 63 && buf[0] > 1 {
+				return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Limits", int64(offset), "Attrs")
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if usize > math.MaxInt {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Limits", int64(offset), "Attrs")
+		}
+		size = int(usize)
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Limits", int64(offset), "Attrs")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Attrs")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Attrs")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Attrs[%q]", k1)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			v1 = true
+		} else {
+			v1 = false
+		}
+		s.Attrs[k1] = v1
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Tree")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Tree = new(Tree)
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if len(data)-offset < 1 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Tree.Children")
+			}
+			buf = data[offset : offset+1]
+			offset += 1
+			if shift == 63 && buf[0] > 1 {
+				return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Limits", int64(offset), "Tree.Children")
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if usize > math.MaxInt {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Limits", int64(offset), "Tree.Children")
+		}
+		size = int(usize)
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Limits", int64(offset), "Tree.Children")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Limits", int64(offset), "Tree.Children")
+		}
+		(*s.Tree).Children = make([]Tree, size)
+		si2 := size
+		for i2 := 0; i2 < si2; i2++ {
+			if offset, err = (*s.Tree).Children[i2].decodeBinenc(data, offset, opts, 1); err != nil {
+				return offset, binenc.FieldError(err, "Limits", int64(offset), "Tree.Children[%d]", i2)
+			}
+		}
+	} else {
+		s.Tree = nil
+	}
+	return offset, nil
+}
+
+func (s *Limits) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Tree) WriteTo(w io.Writer) (n int64, err error) {
+	size := 0
+	size += (bits.Len64(uint64(len(s.Children))|1) + 6) / 7
+	for i1 := range s.Children {
+		size += s.Children[i1].sizeBinenc()
+	}
+	buf := make([]byte, size)
+	offset := 0
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Children)))
+	for i1 := range s.Children {
+		offset, err = s.Children[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Tree) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Tree) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Tree) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var usize uint64
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Tree", n, "Children")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Tree", n, "Children")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Tree", n, "Children")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Tree", n, "Children")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Tree", n, "Children")
+	}
+	if size > binenc.MaxAlloc/24 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Tree", n, "Children")
+	}
+	s.Children = make([]Tree, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if n, err = s.Children[i].readBinenc(d, n, 1); err != nil {
+			return n, binenc.FieldError(err, "Tree", n, "Children[%d]", i)
+		}
+	}
+	return n, nil
+}
+
+func (s *Tree) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 0
+	size += (bits.Len64(uint64(len(s.Children))|1) + 6) / 7
+	for i1 := range s.Children {
+		size += s.Children[i1].sizeBinenc()
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Children)))
+	for i1 := range s.Children {
+		offset, err = s.Children[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return dst, err
+		}
+	}
+	return buf, nil
+}
+
+func (s *Tree) EncodedSize() int {
+	size := 0
+	size += (bits.Len64(uint64(len(s.Children))|1) + 6) / 7
+	for i1 := range s.Children {
+		size += s.Children[i1].sizeBinenc()
+	}
+	return size
+}
+
+func (s *Tree) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Tree) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Tree) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var usize uint64
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Tree", int64(offset), "Children")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Tree", int64(offset), "Children")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Tree", int64(offset), "Children")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Tree", int64(offset), "Children")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Tree", int64(offset), "Children")
+	}
+	s.Children = make([]Tree, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if offset, err = s.Children[i].decodeBinenc(data, offset, opts, 1); err != nil {
+			return offset, binenc.FieldError(err, "Tree", int64(offset), "Children[%d]", i)
+		}
+	}
+	return offset, nil
+}
+
+func (s *Tree) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Tree) sizeBinenc() int {
+	size := 0
+	size += (bits.Len64(uint64(len(s.Children))|1) + 6) / 7
+	for i1 := range s.Children {
+		size += s.Children[i1].sizeBinenc()
+	}
+	return size
+}
+
+func (s *Tree) writeBinenc(buf []byte, offset int) (_ int, err error) {
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Children)))
+	for i1 := range s.Children {
+		offset, err = s.Children[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return offset, nil
+}
+
+func (s *Tree) readBinenc(d *binenc.Decoder, n int64, depth int) (_ int64, err error) {
+	if limit := d.Options().MaxDepth; limit > 0 && depth > limit {
+		return n, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var usize uint64
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Tree", n, "Children")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Tree", n, "Children")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Tree", n, "Children")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Tree", n, "Children")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Tree", n, "Children")
+	}
+	if size > binenc.MaxAlloc/24 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Tree", n, "Children")
+	}
+	s.Children = make([]Tree, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if n, err = s.Children[i].readBinenc(d, n, depth+1); err != nil {
+			return n, binenc.FieldError(err, "Tree", n, "Children[%d]", i)
+		}
+	}
+	return n, nil
+}
+
+func (s *Tree) decodeBinenc(data []byte, offset int, opts binenc.DecodeOptions, depth int) (_ int, err error) {
+	if limit := opts.MaxDepth; limit > 0 && depth > limit {
+		return offset, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	var buf []byte
+	var size int
+	var usize uint64
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Tree", int64(offset), "Children")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "Tree", int64(offset), "Children")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "Tree", int64(offset), "Children")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Tree", int64(offset), "Children")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Tree", int64(offset), "Children")
+	}
+	s.Children = make([]Tree, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if offset, err = s.Children[i].decodeBinenc(data, offset, opts, depth+1); err != nil {
+			return offset, binenc.FieldError(err, "Tree", int64(offset), "Children[%d]", i)
+		}
+	}
+	return offset, nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bytes"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+//go:generate go-binenc-gen map.go
+type Entry struct {
+	Name  string
+	Attrs map[string]string
+}
+
+// Keyed and VKey have fields named as the loop variables of the generated
+// code, k and v, which must not be mistaken for them.
+type Keyed struct {
+	k string
+	N uint32
+}
+
+type VKey struct {
+	v string
+}
+
+type Map struct {
+	Counts  map[uint16]int32
+	Entries map[string]Entry
+	Groups  map[int8][]string
+	Set     map[uint32]struct{}
+	Empty   map[string]uint8
+	Keyed   map[uint32]Keyed
+	VKeys   map[VKey]uint8
+	Unit    map[struct{}]string
+}
+
+func main() {
+	s := &Map{
+		Counts: map[uint16]int32{1: -1, 2: 200, 300: 3},
+		Entries: map[string]Entry{
+			"a": {Name: "first", Attrs: map[string]string{"k1": "v1", "k2": "v2"}},
+			"b": {Name: "second", Attrs: map[string]string{}},
+		},
+		Groups: map[int8][]string{-1: {"x", "y"}, 7: {}},
+		Set:    map[uint32]struct{}{1: {}, 1 << 20: {}},
+		Empty:  map[string]uint8{},
+		Keyed:  map[uint32]Keyed{1: {k: "one", N: 1}},
+		VKeys:  map[VKey]uint8{{v: "a"}: 1, {v: "bc"}: 2},
+		Unit:   map[struct{}]string{{}: "unit"},
+	}
+
+	var buf bytes.Buffer
+	s.WriteTo(&buf)
+
+	o := new(Map)
+	o.ReadFrom(&buf)
+
+	if diff := cmp.Diff(s, o, cmp.AllowUnexported(Keyed{}, VKey{})); diff != "" {
+		panic("map.go: \n" + diff)
+	}
+}
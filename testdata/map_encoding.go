@@ -0,0 +1,2037 @@
+// Code generated by "gobinenc map.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Entry) WriteTo(w io.Writer) (n int64, err error) {
+	size := 4
+	size += len(s.Name)
+	for k, v := range s.Attrs {
+		size += 4
+		size += len(k) + len(v)
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if uint64(len(s.Attrs)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Attrs))
+	}
+	buf[offset] = byte(len(s.Attrs))
+	buf[offset+1] = byte(len(s.Attrs) >> 8)
+	offset += 2
+	for k, v := range s.Attrs {
+		if uint64(len(k)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if uint64(len(v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Entry) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Entry) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Entry) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Entry", n, "Name")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Entry", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Entry", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Entry", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Entry", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Entry", n, "Attrs")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Entry", n, "Attrs")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Entry", n, "Attrs")
+	}
+	s.Attrs = make(map[string]string, binenc.MapHint(size))
+	si := size
+	for i := 0; i < si; i++ {
+		var k string
+		var v string
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Entry", n, "Attrs")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Entry", n, "Attrs")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Entry", n, "Attrs")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Entry", n, "Attrs")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Entry", n, "Attrs")
+		}
+		n += int64(size)
+		k = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Entry", n, "Attrs[%q]", k)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Entry", n, "Attrs[%q]", k)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Entry", n, "Attrs[%q]", k)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Entry", n, "Attrs[%q]", k)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Entry", n, "Attrs[%q]", k)
+		}
+		n += int64(size)
+		v = *(*string)(unsafe.Pointer(&tmp))
+		s.Attrs[k] = v
+	}
+	return n, nil
+}
+
+func (s *Entry) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 4
+	size += len(s.Name)
+	for k, v := range s.Attrs {
+		size += 4
+		size += len(k) + len(v)
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if uint64(len(s.Attrs)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Attrs))
+	}
+	buf[offset] = byte(len(s.Attrs))
+	buf[offset+1] = byte(len(s.Attrs) >> 8)
+	offset += 2
+	for k, v := range s.Attrs {
+		if uint64(len(k)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if uint64(len(v)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	return buf, nil
+}
+
+func (s *Entry) EncodedSize() int {
+	size := 4
+	size += len(s.Name)
+	for k, v := range s.Attrs {
+		size += 4
+		size += len(k) + len(v)
+	}
+	return size
+}
+
+func (s *Entry) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Entry) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Entry) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Entry", int64(offset), "Name")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Entry", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Entry", int64(offset), "Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Entry", int64(offset), "Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Entry", int64(offset), "Attrs")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Entry", int64(offset), "Attrs")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Entry", int64(offset), "Attrs")
+	}
+	s.Attrs = make(map[string]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		var k string
+		var v string
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Entry", int64(offset), "Attrs")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Entry", int64(offset), "Attrs")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Entry", int64(offset), "Attrs")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Entry", int64(offset), "Attrs")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		k = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Entry", int64(offset), "Attrs[%q]", k)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Entry", int64(offset), "Attrs[%q]", k)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Entry", int64(offset), "Attrs[%q]", k)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Entry", int64(offset), "Attrs[%q]", k)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		v = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		s.Attrs[k] = v
+	}
+	return offset, nil
+}
+
+func (s *Entry) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Keyed) WriteTo(w io.Writer) (n int64, err error) {
+	size := 6
+	size += len(s.k)
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.k)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.k))
+	}
+	buf[offset] = byte(len(s.k))
+	buf[offset+1] = byte(len(s.k) >> 8)
+	offset += 2
+	copy(buf[offset:], s.k)
+	offset += len(s.k)
+	buf[offset] = byte(s.N)
+	buf[offset+1] = byte(s.N >> 8)
+	buf[offset+2] = byte(s.N >> 16)
+	buf[offset+3] = byte(s.N >> 24)
+	offset += 4
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Keyed) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Keyed) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Keyed) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Keyed", n, "k")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Keyed", n, "k")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Keyed", n, "k")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Keyed", n, "k")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Keyed", n, "k")
+	}
+	n += int64(size)
+	s.k = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Keyed", n, "N")
+	}
+	n += 4
+	s.N = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	return n, nil
+}
+
+func (s *Keyed) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 6
+	size += len(s.k)
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.k)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.k))
+	}
+	buf[offset] = byte(len(s.k))
+	buf[offset+1] = byte(len(s.k) >> 8)
+	offset += 2
+	copy(buf[offset:], s.k)
+	offset += len(s.k)
+	buf[offset] = byte(s.N)
+	buf[offset+1] = byte(s.N >> 8)
+	buf[offset+2] = byte(s.N >> 16)
+	buf[offset+3] = byte(s.N >> 24)
+	offset += 4
+	return buf, nil
+}
+
+func (s *Keyed) EncodedSize() int {
+	size := 6
+	size += len(s.k)
+	return size
+}
+
+func (s *Keyed) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Keyed) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Keyed) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Keyed", int64(offset), "k")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Keyed", int64(offset), "k")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Keyed", int64(offset), "k")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Keyed", int64(offset), "k")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.k = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 4 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Keyed", int64(offset), "N")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.N = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	return offset, nil
+}
+
+func (s *Keyed) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *VKey) WriteTo(w io.Writer) (n int64, err error) {
+	size := 2
+	size += len(s.v)
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.v)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.v))
+	}
+	buf[offset] = byte(len(s.v))
+	buf[offset+1] = byte(len(s.v) >> 8)
+	offset += 2
+	copy(buf[offset:], s.v)
+	offset += len(s.v)
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *VKey) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *VKey) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *VKey) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "VKey", n, "v")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "VKey", n, "v")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "VKey", n, "v")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "VKey", n, "v")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "VKey", n, "v")
+	}
+	n += int64(size)
+	s.v = *(*string)(unsafe.Pointer(&tmp))
+	return n, nil
+}
+
+func (s *VKey) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 2
+	size += len(s.v)
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.v)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.v))
+	}
+	buf[offset] = byte(len(s.v))
+	buf[offset+1] = byte(len(s.v) >> 8)
+	offset += 2
+	copy(buf[offset:], s.v)
+	offset += len(s.v)
+	return buf, nil
+}
+
+func (s *VKey) EncodedSize() int {
+	size := 2
+	size += len(s.v)
+	return size
+}
+
+func (s *VKey) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *VKey) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *VKey) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "VKey", int64(offset), "v")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "VKey", int64(offset), "v")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "VKey", int64(offset), "v")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "VKey", int64(offset), "v")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.v = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	return offset, nil
+}
+
+func (s *VKey) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Map) WriteTo(w io.Writer) (n int64, err error) {
+	size := 16
+	size += 6*len(s.Counts) + 4*len(s.Set)
+	for k, v := range s.Entries {
+		size += 6
+		size += len(k) + len(v.Name)
+		for k1, v1 := range v.Attrs {
+			size += 4
+			size += len(k1) + len(v1)
+		}
+	}
+	for _, v := range s.Groups {
+		size += 3
+		for _, v1 := range v {
+			size += 2
+			size += len(v1)
+		}
+	}
+	for k := range s.Empty {
+		size += 3
+		size += len(k)
+	}
+	for _, v := range s.Keyed {
+		size += 10
+		size += len(v.k)
+	}
+	for k := range s.VKeys {
+		size += 3
+		size += len(k.v)
+	}
+	for _, v := range s.Unit {
+		size += 2
+		size += len(v)
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Counts)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Counts))
+	}
+	buf[offset] = byte(len(s.Counts))
+	buf[offset+1] = byte(len(s.Counts) >> 8)
+	offset += 2
+	for k, v := range s.Counts {
+		buf[offset] = byte(k)
+		buf[offset+1] = byte(k >> 8)
+		offset += 2
+		buf[offset] = byte(uint32(v))
+		buf[offset+1] = byte(uint32(v) >> 8)
+		buf[offset+2] = byte(uint32(v) >> 16)
+		buf[offset+3] = byte(uint32(v) >> 24)
+		offset += 4
+	}
+	if uint64(len(s.Entries)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Entries))
+	}
+	buf[offset] = byte(len(s.Entries))
+	buf[offset+1] = byte(len(s.Entries) >> 8)
+	offset += 2
+	for k, v := range s.Entries {
+		if uint64(len(k)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if uint64(len(v.Name)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Name))
+		}
+		buf[offset] = byte(len(v.Name))
+		buf[offset+1] = byte(len(v.Name) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Name)
+		offset += len(v.Name)
+		if uint64(len(v.Attrs)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Attrs))
+		}
+		buf[offset] = byte(len(v.Attrs))
+		buf[offset+1] = byte(len(v.Attrs) >> 8)
+		offset += 2
+		for k1, v1 := range v.Attrs {
+			if uint64(len(k1)) > math.MaxUint16 {
+				return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k1))
+			}
+			buf[offset] = byte(len(k1))
+			buf[offset+1] = byte(len(k1) >> 8)
+			offset += 2
+			copy(buf[offset:], k1)
+			offset += len(k1)
+			if uint64(len(v1)) > math.MaxUint16 {
+				return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v1))
+			}
+			buf[offset] = byte(len(v1))
+			buf[offset+1] = byte(len(v1) >> 8)
+			offset += 2
+			copy(buf[offset:], v1)
+			offset += len(v1)
+		}
+	}
+	if uint64(len(s.Groups)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Groups))
+	}
+	buf[offset] = byte(len(s.Groups))
+	buf[offset+1] = byte(len(s.Groups) >> 8)
+	offset += 2
+	for k, v := range s.Groups {
+		buf[offset] = byte(uint8(k))
+		offset += 1
+		if uint64(len(v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		for _, v1 := range v {
+			if uint64(len(v1)) > math.MaxUint16 {
+				return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v1))
+			}
+			buf[offset] = byte(len(v1))
+			buf[offset+1] = byte(len(v1) >> 8)
+			offset += 2
+			copy(buf[offset:], v1)
+			offset += len(v1)
+		}
+	}
+	if uint64(len(s.Set)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Set))
+	}
+	buf[offset] = byte(len(s.Set))
+	buf[offset+1] = byte(len(s.Set) >> 8)
+	offset += 2
+	for k := range s.Set {
+		buf[offset] = byte(k)
+		buf[offset+1] = byte(k >> 8)
+		buf[offset+2] = byte(k >> 16)
+		buf[offset+3] = byte(k >> 24)
+		offset += 4
+	}
+	if uint64(len(s.Empty)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Empty))
+	}
+	buf[offset] = byte(len(s.Empty))
+	buf[offset+1] = byte(len(s.Empty) >> 8)
+	offset += 2
+	for k, v := range s.Empty {
+		if uint64(len(k)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		buf[offset] = byte(v)
+		offset += 1
+	}
+	if uint64(len(s.Keyed)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Keyed))
+	}
+	buf[offset] = byte(len(s.Keyed))
+	buf[offset+1] = byte(len(s.Keyed) >> 8)
+	offset += 2
+	for k, v := range s.Keyed {
+		buf[offset] = byte(k)
+		buf[offset+1] = byte(k >> 8)
+		buf[offset+2] = byte(k >> 16)
+		buf[offset+3] = byte(k >> 24)
+		offset += 4
+		if uint64(len(v.k)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.k))
+		}
+		buf[offset] = byte(len(v.k))
+		buf[offset+1] = byte(len(v.k) >> 8)
+		offset += 2
+		copy(buf[offset:], v.k)
+		offset += len(v.k)
+		buf[offset] = byte(v.N)
+		buf[offset+1] = byte(v.N >> 8)
+		buf[offset+2] = byte(v.N >> 16)
+		buf[offset+3] = byte(v.N >> 24)
+		offset += 4
+	}
+	if uint64(len(s.VKeys)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.VKeys))
+	}
+	buf[offset] = byte(len(s.VKeys))
+	buf[offset+1] = byte(len(s.VKeys) >> 8)
+	offset += 2
+	for k, v := range s.VKeys {
+		if uint64(len(k.v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k.v))
+		}
+		buf[offset] = byte(len(k.v))
+		buf[offset+1] = byte(len(k.v) >> 8)
+		offset += 2
+		copy(buf[offset:], k.v)
+		offset += len(k.v)
+		buf[offset] = byte(v)
+		offset += 1
+	}
+	if uint64(len(s.Unit)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Unit))
+	}
+	buf[offset] = byte(len(s.Unit))
+	buf[offset+1] = byte(len(s.Unit) >> 8)
+	offset += 2
+	for _, v := range s.Unit {
+		if uint64(len(v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Map) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Map) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Map) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Map", n, "Counts")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", n, "Counts")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Counts")
+	}
+	s.Counts = make(map[uint16]int32, binenc.MapHint(size))
+	si := size
+	for i := 0; i < si; i++ {
+		var k uint16
+		var v int32
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Counts")
+		}
+		n += 2
+		k = uint16(buf[0]) | (uint16(buf[1]) << 8)
+		if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Counts[%v]", k)
+		}
+		n += 4
+		v = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+		s.Counts[k] = v
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Map", n, "Entries")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", n, "Entries")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Entries")
+	}
+	s.Entries = make(map[string]Entry, binenc.MapHint(size))
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 Entry
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Entries")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", n, "Entries")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Entries")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Map", n, "Entries")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Entries")
+		}
+		n += int64(size)
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Entries[%q].Name", k1)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", n, "Entries[%q].Name", k1)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Entries[%q].Name", k1)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Map", n, "Entries[%q].Name", k1)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Entries[%q].Name", k1)
+		}
+		n += int64(size)
+		v1.Name = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Entries[%q].Attrs", k1)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", n, "Entries[%q].Attrs", k1)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Entries[%q].Attrs", k1)
+		}
+		v1.Attrs = make(map[string]string, binenc.MapHint(size))
+		si2 := size
+		for i2 := 0; i2 < si2; i2++ {
+			var k2 string
+			var v2 string
+			if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Map", n, "Entries[%q].Attrs", k1)
+			}
+			n += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", n, "Entries[%q].Attrs", k1)
+			}
+			if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Entries[%q].Attrs", k1)
+			}
+			if size > binenc.MaxAlloc {
+				return n, binenc.FieldError(binenc.ErrTooLarge, "Map", n, "Entries[%q].Attrs", k1)
+			}
+			tmp = d.Arena(size)
+			if nr, err := io.ReadFull(r, tmp); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Map", n, "Entries[%q].Attrs", k1)
+			}
+			n += int64(size)
+			k2 = *(*string)(unsafe.Pointer(&tmp))
+			if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Map", n, "Entries[%q].Attrs[%q]", k1, k2)
+			}
+			n += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", n, "Entries[%q].Attrs[%q]", k1, k2)
+			}
+			if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Entries[%q].Attrs[%q]", k1, k2)
+			}
+			if size > binenc.MaxAlloc {
+				return n, binenc.FieldError(binenc.ErrTooLarge, "Map", n, "Entries[%q].Attrs[%q]", k1, k2)
+			}
+			tmp = d.Arena(size)
+			if nr, err := io.ReadFull(r, tmp); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Map", n, "Entries[%q].Attrs[%q]", k1, k2)
+			}
+			n += int64(size)
+			v2 = *(*string)(unsafe.Pointer(&tmp))
+			v1.Attrs[k2] = v2
+		}
+		s.Entries[k1] = v1
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Map", n, "Groups")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", n, "Groups")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Groups")
+	}
+	s.Groups = make(map[int8][]string, binenc.MapHint(size))
+	si3 := size
+	for i3 := 0; i3 < si3; i3++ {
+		var k3 int8
+		var v3 []string
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Groups")
+		}
+		n += 1
+		k3 = int8(uint8(buf[0]))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Groups[%v]", k3)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", n, "Groups[%v]", k3)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Groups[%v]", k3)
+		}
+		if size > binenc.MaxAlloc/16 {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Map", n, "Groups[%v]", k3)
+		}
+		v3 = make([]string, size)
+		si4 := size
+		for i4 := 0; i4 < si4; i4++ {
+			if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Map", n, "Groups[%v][%d]", k3, i4)
+			}
+			n += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", n, "Groups[%v][%d]", k3, i4)
+			}
+			if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Groups[%v][%d]", k3, i4)
+			}
+			if size > binenc.MaxAlloc {
+				return n, binenc.FieldError(binenc.ErrTooLarge, "Map", n, "Groups[%v][%d]", k3, i4)
+			}
+			tmp = d.Arena(size)
+			if nr, err := io.ReadFull(r, tmp); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Map", n, "Groups[%v][%d]", k3, i4)
+			}
+			n += int64(size)
+			v3[i4] = *(*string)(unsafe.Pointer(&tmp))
+		}
+		s.Groups[k3] = v3
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Map", n, "Set")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", n, "Set")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Set")
+	}
+	s.Set = make(map[uint32]struct{}, binenc.MapHint(size))
+	si5 := size
+	for i5 := 0; i5 < si5; i5++ {
+		var k5 uint32
+		var v5 struct{}
+		if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Set")
+		}
+		n += 4
+		k5 = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		s.Set[k5] = v5
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Map", n, "Empty")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", n, "Empty")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Empty")
+	}
+	s.Empty = make(map[string]uint8, binenc.MapHint(size))
+	si6 := size
+	for i6 := 0; i6 < si6; i6++ {
+		var k6 string
+		var v6 uint8
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Empty")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", n, "Empty")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Empty")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Map", n, "Empty")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Empty")
+		}
+		n += int64(size)
+		k6 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Empty[%q]", k6)
+		}
+		n += 1
+		v6 = uint8(buf[0])
+		s.Empty[k6] = v6
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Map", n, "Keyed")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", n, "Keyed")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Keyed")
+	}
+	s.Keyed = make(map[uint32]Keyed, binenc.MapHint(size))
+	si7 := size
+	for i7 := 0; i7 < si7; i7++ {
+		var k7 uint32
+		var v7 Keyed
+		if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Keyed")
+		}
+		n += 4
+		k7 = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Keyed[%v].k", k7)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", n, "Keyed[%v].k", k7)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Keyed[%v].k", k7)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Map", n, "Keyed[%v].k", k7)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Keyed[%v].k", k7)
+		}
+		n += int64(size)
+		v7.k = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Keyed[%v].N", k7)
+		}
+		n += 4
+		v7.N = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		s.Keyed[k7] = v7
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Map", n, "VKeys")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", n, "VKeys")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "VKeys")
+	}
+	s.VKeys = make(map[VKey]uint8, binenc.MapHint(size))
+	si8 := size
+	for i8 := 0; i8 < si8; i8++ {
+		var k8 VKey
+		var v8 uint8
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "VKeys.v")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", n, "VKeys.v")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "VKeys.v")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Map", n, "VKeys.v")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "VKeys.v")
+		}
+		n += int64(size)
+		k8.v = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "VKeys[%v]", k8)
+		}
+		n += 1
+		v8 = uint8(buf[0])
+		s.VKeys[k8] = v8
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Map", n, "Unit")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", n, "Unit")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Unit")
+	}
+	s.Unit = make(map[struct{}]string, binenc.MapHint(size))
+	si9 := size
+	for i9 := 0; i9 < si9; i9++ {
+		var k9 struct{}
+		var v9 string
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Unit[%v]", k9)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", n, "Unit[%v]", k9)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Map", n, "Unit[%v]", k9)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Map", n, "Unit[%v]", k9)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Map", n, "Unit[%v]", k9)
+		}
+		n += int64(size)
+		v9 = *(*string)(unsafe.Pointer(&tmp))
+		s.Unit[k9] = v9
+	}
+	return n, nil
+}
+
+func (s *Map) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 16
+	size += 6*len(s.Counts) + 4*len(s.Set)
+	for k, v := range s.Entries {
+		size += 6
+		size += len(k) + len(v.Name)
+		for k1, v1 := range v.Attrs {
+			size += 4
+			size += len(k1) + len(v1)
+		}
+	}
+	for _, v := range s.Groups {
+		size += 3
+		for _, v1 := range v {
+			size += 2
+			size += len(v1)
+		}
+	}
+	for k := range s.Empty {
+		size += 3
+		size += len(k)
+	}
+	for _, v := range s.Keyed {
+		size += 10
+		size += len(v.k)
+	}
+	for k := range s.VKeys {
+		size += 3
+		size += len(k.v)
+	}
+	for _, v := range s.Unit {
+		size += 2
+		size += len(v)
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Counts)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Counts))
+	}
+	buf[offset] = byte(len(s.Counts))
+	buf[offset+1] = byte(len(s.Counts) >> 8)
+	offset += 2
+	for k, v := range s.Counts {
+		buf[offset] = byte(k)
+		buf[offset+1] = byte(k >> 8)
+		offset += 2
+		buf[offset] = byte(uint32(v))
+		buf[offset+1] = byte(uint32(v) >> 8)
+		buf[offset+2] = byte(uint32(v) >> 16)
+		buf[offset+3] = byte(uint32(v) >> 24)
+		offset += 4
+	}
+	if uint64(len(s.Entries)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Entries))
+	}
+	buf[offset] = byte(len(s.Entries))
+	buf[offset+1] = byte(len(s.Entries) >> 8)
+	offset += 2
+	for k, v := range s.Entries {
+		if uint64(len(k)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if uint64(len(v.Name)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Name))
+		}
+		buf[offset] = byte(len(v.Name))
+		buf[offset+1] = byte(len(v.Name) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Name)
+		offset += len(v.Name)
+		if uint64(len(v.Attrs)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Attrs))
+		}
+		buf[offset] = byte(len(v.Attrs))
+		buf[offset+1] = byte(len(v.Attrs) >> 8)
+		offset += 2
+		for k1, v1 := range v.Attrs {
+			if uint64(len(k1)) > math.MaxUint16 {
+				return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k1))
+			}
+			buf[offset] = byte(len(k1))
+			buf[offset+1] = byte(len(k1) >> 8)
+			offset += 2
+			copy(buf[offset:], k1)
+			offset += len(k1)
+			if uint64(len(v1)) > math.MaxUint16 {
+				return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v1))
+			}
+			buf[offset] = byte(len(v1))
+			buf[offset+1] = byte(len(v1) >> 8)
+			offset += 2
+			copy(buf[offset:], v1)
+			offset += len(v1)
+		}
+	}
+	if uint64(len(s.Groups)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Groups))
+	}
+	buf[offset] = byte(len(s.Groups))
+	buf[offset+1] = byte(len(s.Groups) >> 8)
+	offset += 2
+	for k, v := range s.Groups {
+		buf[offset] = byte(uint8(k))
+		offset += 1
+		if uint64(len(v)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		for _, v1 := range v {
+			if uint64(len(v1)) > math.MaxUint16 {
+				return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v1))
+			}
+			buf[offset] = byte(len(v1))
+			buf[offset+1] = byte(len(v1) >> 8)
+			offset += 2
+			copy(buf[offset:], v1)
+			offset += len(v1)
+		}
+	}
+	if uint64(len(s.Set)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Set))
+	}
+	buf[offset] = byte(len(s.Set))
+	buf[offset+1] = byte(len(s.Set) >> 8)
+	offset += 2
+	for k := range s.Set {
+		buf[offset] = byte(k)
+		buf[offset+1] = byte(k >> 8)
+		buf[offset+2] = byte(k >> 16)
+		buf[offset+3] = byte(k >> 24)
+		offset += 4
+	}
+	if uint64(len(s.Empty)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Empty))
+	}
+	buf[offset] = byte(len(s.Empty))
+	buf[offset+1] = byte(len(s.Empty) >> 8)
+	offset += 2
+	for k, v := range s.Empty {
+		if uint64(len(k)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		buf[offset] = byte(v)
+		offset += 1
+	}
+	if uint64(len(s.Keyed)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Keyed))
+	}
+	buf[offset] = byte(len(s.Keyed))
+	buf[offset+1] = byte(len(s.Keyed) >> 8)
+	offset += 2
+	for k, v := range s.Keyed {
+		buf[offset] = byte(k)
+		buf[offset+1] = byte(k >> 8)
+		buf[offset+2] = byte(k >> 16)
+		buf[offset+3] = byte(k >> 24)
+		offset += 4
+		if uint64(len(v.k)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.k))
+		}
+		buf[offset] = byte(len(v.k))
+		buf[offset+1] = byte(len(v.k) >> 8)
+		offset += 2
+		copy(buf[offset:], v.k)
+		offset += len(v.k)
+		buf[offset] = byte(v.N)
+		buf[offset+1] = byte(v.N >> 8)
+		buf[offset+2] = byte(v.N >> 16)
+		buf[offset+3] = byte(v.N >> 24)
+		offset += 4
+	}
+	if uint64(len(s.VKeys)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.VKeys))
+	}
+	buf[offset] = byte(len(s.VKeys))
+	buf[offset+1] = byte(len(s.VKeys) >> 8)
+	offset += 2
+	for k, v := range s.VKeys {
+		if uint64(len(k.v)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k.v))
+		}
+		buf[offset] = byte(len(k.v))
+		buf[offset+1] = byte(len(k.v) >> 8)
+		offset += 2
+		copy(buf[offset:], k.v)
+		offset += len(k.v)
+		buf[offset] = byte(v)
+		offset += 1
+	}
+	if uint64(len(s.Unit)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Unit))
+	}
+	buf[offset] = byte(len(s.Unit))
+	buf[offset+1] = byte(len(s.Unit) >> 8)
+	offset += 2
+	for _, v := range s.Unit {
+		if uint64(len(v)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	return buf, nil
+}
+
+func (s *Map) EncodedSize() int {
+	size := 16
+	size += 6*len(s.Counts) + 4*len(s.Set)
+	for k, v := range s.Entries {
+		size += 6
+		size += len(k) + len(v.Name)
+		for k1, v1 := range v.Attrs {
+			size += 4
+			size += len(k1) + len(v1)
+		}
+	}
+	for _, v := range s.Groups {
+		size += 3
+		for _, v1 := range v {
+			size += 2
+			size += len(v1)
+		}
+	}
+	for k := range s.Empty {
+		size += 3
+		size += len(k)
+	}
+	for _, v := range s.Keyed {
+		size += 10
+		size += len(v.k)
+	}
+	for k := range s.VKeys {
+		size += 3
+		size += len(k.v)
+	}
+	for _, v := range s.Unit {
+		size += 2
+		size += len(v)
+	}
+	return size
+}
+
+func (s *Map) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Map) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Map) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Counts")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", int64(offset), "Counts")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Counts")
+	}
+	s.Counts = make(map[uint16]int32, size)
+	si := size
+	for i := 0; i < si; i++ {
+		var k uint16
+		var v int32
+		if len(data)-offset < 6 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Counts")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		k = uint16(buf[0]) | (uint16(buf[1]) << 8)
+		buf = data[offset : offset+4]
+		offset += 4
+		v = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+		s.Counts[k] = v
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", int64(offset), "Entries")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries")
+	}
+	s.Entries = make(map[string]Entry, size)
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 Entry
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", int64(offset), "Entries")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries[%q].Name", k1)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", int64(offset), "Entries[%q].Name", k1)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries[%q].Name", k1)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries[%q].Name", k1)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		v1.Name = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries[%q].Attrs", k1)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", int64(offset), "Entries[%q].Attrs", k1)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries[%q].Attrs", k1)
+		}
+		v1.Attrs = make(map[string]string, size)
+		si2 := size
+		for i2 := 0; i2 < si2; i2++ {
+			var k2 string
+			var v2 string
+			if len(data)-offset < 2 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries[%q].Attrs", k1)
+			}
+			buf = data[offset : offset+2]
+			offset += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", int64(offset), "Entries[%q].Attrs", k1)
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries[%q].Attrs", k1)
+			}
+			if c-m < size {
+				c = size
+				if c < 2*cap(strBuf) {
+					c = 2 * cap(strBuf)
+				}
+				strBuf = append([]byte(nil), make([]byte, c)...)
+				m = 0
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries[%q].Attrs", k1)
+			}
+			offset += copy(strBuf[m:m+size], data[offset:])
+			tmp = strBuf[m : m+size]
+			k2 = *(*string)(unsafe.Pointer(&tmp))
+			m += size
+			if len(data)-offset < 2 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries[%q].Attrs[%q]", k1, k2)
+			}
+			buf = data[offset : offset+2]
+			offset += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", int64(offset), "Entries[%q].Attrs[%q]", k1, k2)
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries[%q].Attrs[%q]", k1, k2)
+			}
+			if c-m < size {
+				c = size
+				if c < 2*cap(strBuf) {
+					c = 2 * cap(strBuf)
+				}
+				strBuf = append([]byte(nil), make([]byte, c)...)
+				m = 0
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Entries[%q].Attrs[%q]", k1, k2)
+			}
+			offset += copy(strBuf[m:m+size], data[offset:])
+			tmp = strBuf[m : m+size]
+			v2 = *(*string)(unsafe.Pointer(&tmp))
+			m += size
+			v1.Attrs[k2] = v2
+		}
+		s.Entries[k1] = v1
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Groups")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", int64(offset), "Groups")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Groups")
+	}
+	s.Groups = make(map[int8][]string, size)
+	si3 := size
+	for i3 := 0; i3 < si3; i3++ {
+		var k3 int8
+		var v3 []string
+		if len(data)-offset < 3 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Groups")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		k3 = int8(uint8(buf[0]))
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", int64(offset), "Groups[%v]", k3)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Groups[%v]", k3)
+		}
+		v3 = make([]string, size)
+		si4 := size
+		for i4 := 0; i4 < si4; i4++ {
+			if len(data)-offset < 2 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Groups[%v][%d]", k3, i4)
+			}
+			buf = data[offset : offset+2]
+			offset += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", int64(offset), "Groups[%v][%d]", k3, i4)
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Groups[%v][%d]", k3, i4)
+			}
+			if c-m < size {
+				c = size
+				if c < 2*cap(strBuf) {
+					c = 2 * cap(strBuf)
+				}
+				strBuf = append([]byte(nil), make([]byte, c)...)
+				m = 0
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Groups[%v][%d]", k3, i4)
+			}
+			offset += copy(strBuf[m:m+size], data[offset:])
+			tmp = strBuf[m : m+size]
+			v3[i4] = *(*string)(unsafe.Pointer(&tmp))
+			m += size
+		}
+		s.Groups[k3] = v3
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Set")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", int64(offset), "Set")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Set")
+	}
+	s.Set = make(map[uint32]struct{}, size)
+	si5 := size
+	for i5 := 0; i5 < si5; i5++ {
+		var k5 uint32
+		var v5 struct{}
+		if len(data)-offset < 4 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Set")
+		}
+		buf = data[offset : offset+4]
+		offset += 4
+		k5 = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		s.Set[k5] = v5
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Empty")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", int64(offset), "Empty")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Empty")
+	}
+	s.Empty = make(map[string]uint8, size)
+	si6 := size
+	for i6 := 0; i6 < si6; i6++ {
+		var k6 string
+		var v6 uint8
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Empty")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", int64(offset), "Empty")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Empty")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Empty")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		k6 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Empty[%q]", k6)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		v6 = uint8(buf[0])
+		s.Empty[k6] = v6
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Keyed")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", int64(offset), "Keyed")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Keyed")
+	}
+	s.Keyed = make(map[uint32]Keyed, size)
+	si7 := size
+	for i7 := 0; i7 < si7; i7++ {
+		var k7 uint32
+		var v7 Keyed
+		if len(data)-offset < 6 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Keyed")
+		}
+		buf = data[offset : offset+4]
+		offset += 4
+		k7 = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", int64(offset), "Keyed[%v].k", k7)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Keyed[%v].k", k7)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Keyed[%v].k", k7)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		v7.k = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 4 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Keyed[%v].N", k7)
+		}
+		buf = data[offset : offset+4]
+		offset += 4
+		v7.N = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		s.Keyed[k7] = v7
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "VKeys")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", int64(offset), "VKeys")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "VKeys")
+	}
+	s.VKeys = make(map[VKey]uint8, size)
+	si8 := size
+	for i8 := 0; i8 < si8; i8++ {
+		var k8 VKey
+		var v8 uint8
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "VKeys.v")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", int64(offset), "VKeys.v")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "VKeys.v")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "VKeys.v")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		k8.v = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "VKeys[%v]", k8)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		v8 = uint8(buf[0])
+		s.VKeys[k8] = v8
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Unit")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Map", int64(offset), "Unit")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Unit")
+	}
+	s.Unit = make(map[struct{}]string, size)
+	si9 := size
+	for i9 := 0; i9 < si9; i9++ {
+		var k9 struct{}
+		var v9 string
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Unit[%v]", k9)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Map", int64(offset), "Unit[%v]", k9)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Unit[%v]", k9)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Map", int64(offset), "Unit[%v]", k9)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		v9 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		s.Unit[k9] = v9
+	}
+	return offset, nil
+}
+
+func (s *Map) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"encoding"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+//go:generate go-binenc-gen marshal.go
+type Message struct {
+	ID      uint32
+	Name    string
+	Payload []byte
+	Scores  []float64
+	Labels  map[string]int16
+	Pos     [3]int64
+	Parent  *Message
+	Replies []Message
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*Message)(nil)
+	_ encoding.BinaryUnmarshaler = (*Message)(nil)
+)
+
+type Point struct {
+	X, Y int32
+}
+
+func main() {
+	s := &Message{
+		ID:      7,
+		Name:    "hello",
+		Payload: []byte{1, 2, 3},
+		Scores:  []float64{1.5, -2},
+		Labels:  map[string]int16{"a": -1}, // one entry, as map order varies
+		Pos:     [3]int64{1, -2, 3},
+		Parent:  &Message{Name: "parent"},
+		Replies: []Message{{ID: 1, Replies: []Message{{Name: "nested"}}}},
+	}
+
+	data, err := s.MarshalBinary()
+	if err != nil {
+		panic("marshal.go: " + err.Error())
+	}
+
+	if n := s.EncodedSize(); n != len(data) {
+		panic(fmt.Sprintf("marshal.go: EncodedSize() = %d, want %d", n, len(data)))
+	}
+
+	// the encoding matches WriteTo
+	var buf bytes.Buffer
+	if _, err := s.WriteTo(&buf); err != nil {
+		panic("marshal.go: " + err.Error())
+	}
+	if diff := cmp.Diff(buf.Bytes(), data); diff != "" {
+		panic("marshal.go: \n" + diff)
+	}
+
+	o := new(Message)
+	if err := o.UnmarshalBinary(data); err != nil {
+		panic("marshal.go: " + err.Error())
+	}
+	if diff := cmp.Diff(s, o, cmpopts.EquateEmpty()); diff != "" {
+		panic("marshal.go: \n" + diff)
+	}
+
+	// AppendBinary keeps the contents of dst
+	prefix := []byte("prefix")
+	appended, err := s.AppendBinary(prefix)
+	if err != nil {
+		panic("marshal.go: " + err.Error())
+	}
+	if diff := cmp.Diff(append(prefix, data...), appended); diff != "" {
+		panic("marshal.go: \n" + diff)
+	}
+
+	// and batches several values into one buffer
+	p := &Point{X: 1, Y: -1}
+	batch, _ := p.AppendBinary(nil)
+	batch, _ = s.AppendBinary(batch)
+	batch, _ = p.AppendBinary(batch)
+	op := new(Point)
+	if err := op.UnmarshalBinary(batch[len(batch)-8:]); err != nil || *op != *p {
+		panic("marshal.go: unexpected batched point")
+	}
+	if err := o.UnmarshalBinary(batch[8 : len(batch)-8]); err != nil {
+		panic("marshal.go: " + err.Error())
+	}
+
+	// DecodeFrom walks the batch value by value
+	n, err := op.DecodeFrom(batch)
+	if err != nil || n != 8 {
+		panic("marshal.go: unexpected DecodeFrom of the first point")
+	}
+	m, err := o.DecodeFrom(batch[n:])
+	if err != nil || n+m != len(batch)-8 {
+		panic("marshal.go: unexpected DecodeFrom of the message")
+	}
+	if _, err := op.DecodeFrom(batch[n+m : len(batch)-1]); !errors.Is(err, io.ErrUnexpectedEOF) {
+		panic("marshal.go: expected io.ErrUnexpectedEOF")
+	}
+
+	// reusing the buffer does not allocate
+	allocs := testing.AllocsPerRun(100, func() {
+		batch, _ = s.AppendBinary(batch[:0])
+	})
+	if allocs != 0 {
+		panic("marshal.go: AppendBinary allocates")
+	}
+
+	// every truncated input is an error
+	for i := 0; i < len(data); i++ {
+		if err := new(Message).UnmarshalBinary(data[:i]); !errors.Is(err, io.ErrUnexpectedEOF) {
+			panic("marshal.go: expected io.ErrUnexpectedEOF")
+		}
+	}
+
+	// as are trailing bytes
+	if err := new(Message).UnmarshalBinary(append(data, 0)); err == nil {
+		panic("marshal.go: expected trailing bytes error")
+	}
+}
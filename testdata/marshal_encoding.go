@@ -0,0 +1,1249 @@
+// Code generated by "gobinenc marshal.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Message) WriteTo(w io.Writer) (n int64, err error) {
+	size := 39
+	size += len(s.Name) + len(s.Payload) + 8*len(s.Scores)
+	for k := range s.Labels {
+		size += 4
+		size += len(k)
+	}
+	if s.Parent != nil {
+		size += (*s.Parent).sizeBinenc()
+	}
+	for i1 := range s.Replies {
+		size += s.Replies[i1].sizeBinenc()
+	}
+	buf := make([]byte, size)
+	offset := 0
+	buf[offset] = byte(s.ID)
+	buf[offset+1] = byte(s.ID >> 8)
+	buf[offset+2] = byte(s.ID >> 16)
+	buf[offset+3] = byte(s.ID >> 24)
+	offset += 4
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if uint64(len(s.Payload)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Payload))
+	}
+	buf[offset] = byte(len(s.Payload))
+	buf[offset+1] = byte(len(s.Payload) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Payload)
+	offset += len(s.Payload)
+	if uint64(len(s.Scores)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Scores))
+	}
+	buf[offset] = byte(len(s.Scores))
+	buf[offset+1] = byte(len(s.Scores) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Scores) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Scores[0])), 8*len(s.Scores)))
+		offset += 8 * len(s.Scores)
+	} else {
+		for _, v := range s.Scores {
+			buf[offset] = byte(math.Float64bits(v))
+			buf[offset+1] = byte(math.Float64bits(v) >> 8)
+			buf[offset+2] = byte(math.Float64bits(v) >> 16)
+			buf[offset+3] = byte(math.Float64bits(v) >> 24)
+			buf[offset+4] = byte(math.Float64bits(v) >> 32)
+			buf[offset+5] = byte(math.Float64bits(v) >> 40)
+			buf[offset+6] = byte(math.Float64bits(v) >> 48)
+			buf[offset+7] = byte(math.Float64bits(v) >> 56)
+			offset += 8
+		}
+	}
+	if uint64(len(s.Labels)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Labels))
+	}
+	buf[offset] = byte(len(s.Labels))
+	buf[offset+1] = byte(len(s.Labels) >> 8)
+	offset += 2
+	for k, v := range s.Labels {
+		if uint64(len(k)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		buf[offset] = byte(uint16(v))
+		buf[offset+1] = byte(uint16(v) >> 8)
+		offset += 2
+	}
+	if binenc.LittleEndian {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Pos[0])), 24))
+		offset += 24
+	} else {
+		for i1 := 0; i1 < 3; i1++ {
+			buf[offset] = byte(uint64(s.Pos[i1]))
+			buf[offset+1] = byte(uint64(s.Pos[i1]) >> 8)
+			buf[offset+2] = byte(uint64(s.Pos[i1]) >> 16)
+			buf[offset+3] = byte(uint64(s.Pos[i1]) >> 24)
+			buf[offset+4] = byte(uint64(s.Pos[i1]) >> 32)
+			buf[offset+5] = byte(uint64(s.Pos[i1]) >> 40)
+			buf[offset+6] = byte(uint64(s.Pos[i1]) >> 48)
+			buf[offset+7] = byte(uint64(s.Pos[i1]) >> 56)
+			offset += 8
+		}
+	}
+	if s.Parent != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Parent != nil {
+		offset, err = (*s.Parent).writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if uint64(len(s.Replies)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Replies))
+	}
+	buf[offset] = byte(len(s.Replies))
+	buf[offset+1] = byte(len(s.Replies) >> 8)
+	offset += 2
+	for i1 := range s.Replies {
+		offset, err = s.Replies[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Message) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Message) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Message) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "ID")
+	}
+	n += 4
+	s.ID = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Name")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Message", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Message", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Message", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Payload")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", n, "Payload")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Message", n, "Payload")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Message", n, "Payload")
+	}
+	s.Payload = make([]byte, size)
+	if nr, err := io.ReadFull(r, s.Payload); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Payload")
+	}
+	n += int64(size)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Scores")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", n, "Scores")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Message", n, "Scores")
+	}
+	if size > binenc.MaxAlloc/8 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Message", n, "Scores")
+	}
+	s.Scores = make([]float64, size)
+	if binenc.LittleEndian && size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Scores[0])), 8*size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Message", n, "Scores")
+		}
+		n += int64(8 * size)
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Message", n, "Scores[%d]", i)
+			}
+			n += 8
+			s.Scores[i] = math.Float64frombits(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Labels")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", n, "Labels")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Message", n, "Labels")
+	}
+	s.Labels = make(map[string]int16, binenc.MapHint(size))
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 int16
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Message", n, "Labels")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Message", n, "Labels")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Message", n, "Labels")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Message", n, "Labels")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Message", n, "Labels")
+		}
+		n += int64(size)
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Message", n, "Labels[%q]", k1)
+		}
+		n += 2
+		v1 = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		s.Labels[k1] = v1
+	}
+	if binenc.LittleEndian {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Pos[0])), 24)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Message", n, "Pos")
+		}
+		n += 24
+	} else {
+		for i2 := 0; i2 < 3; i2++ {
+			if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Message", n, "Pos[%d]", i2)
+			}
+			n += 8
+			s.Pos[i2] = int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Parent")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Parent = new(Message)
+		if n, err = (*s.Parent).readBinenc(d, n, 1); err != nil {
+			return n, binenc.FieldError(err, "Message", n, "Parent")
+		}
+	} else {
+		s.Parent = nil
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Replies")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", n, "Replies")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Message", n, "Replies")
+	}
+	if size > binenc.MaxAlloc/136 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Message", n, "Replies")
+	}
+	s.Replies = make([]Message, size)
+	si3 := size
+	for i3 := 0; i3 < si3; i3++ {
+		if n, err = s.Replies[i3].readBinenc(d, n, 1); err != nil {
+			return n, binenc.FieldError(err, "Message", n, "Replies[%d]", i3)
+		}
+	}
+	return n, nil
+}
+
+func (s *Message) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 39
+	size += len(s.Name) + len(s.Payload) + 8*len(s.Scores)
+	for k := range s.Labels {
+		size += 4
+		size += len(k)
+	}
+	if s.Parent != nil {
+		size += (*s.Parent).sizeBinenc()
+	}
+	for i1 := range s.Replies {
+		size += s.Replies[i1].sizeBinenc()
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(s.ID)
+	buf[offset+1] = byte(s.ID >> 8)
+	buf[offset+2] = byte(s.ID >> 16)
+	buf[offset+3] = byte(s.ID >> 24)
+	offset += 4
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if uint64(len(s.Payload)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Payload))
+	}
+	buf[offset] = byte(len(s.Payload))
+	buf[offset+1] = byte(len(s.Payload) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Payload)
+	offset += len(s.Payload)
+	if uint64(len(s.Scores)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Scores))
+	}
+	buf[offset] = byte(len(s.Scores))
+	buf[offset+1] = byte(len(s.Scores) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Scores) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Scores[0])), 8*len(s.Scores)))
+		offset += 8 * len(s.Scores)
+	} else {
+		for _, v := range s.Scores {
+			buf[offset] = byte(math.Float64bits(v))
+			buf[offset+1] = byte(math.Float64bits(v) >> 8)
+			buf[offset+2] = byte(math.Float64bits(v) >> 16)
+			buf[offset+3] = byte(math.Float64bits(v) >> 24)
+			buf[offset+4] = byte(math.Float64bits(v) >> 32)
+			buf[offset+5] = byte(math.Float64bits(v) >> 40)
+			buf[offset+6] = byte(math.Float64bits(v) >> 48)
+			buf[offset+7] = byte(math.Float64bits(v) >> 56)
+			offset += 8
+		}
+	}
+	if uint64(len(s.Labels)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Labels))
+	}
+	buf[offset] = byte(len(s.Labels))
+	buf[offset+1] = byte(len(s.Labels) >> 8)
+	offset += 2
+	for k, v := range s.Labels {
+		if uint64(len(k)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		buf[offset] = byte(uint16(v))
+		buf[offset+1] = byte(uint16(v) >> 8)
+		offset += 2
+	}
+	if binenc.LittleEndian {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Pos[0])), 24))
+		offset += 24
+	} else {
+		for i1 := 0; i1 < 3; i1++ {
+			buf[offset] = byte(uint64(s.Pos[i1]))
+			buf[offset+1] = byte(uint64(s.Pos[i1]) >> 8)
+			buf[offset+2] = byte(uint64(s.Pos[i1]) >> 16)
+			buf[offset+3] = byte(uint64(s.Pos[i1]) >> 24)
+			buf[offset+4] = byte(uint64(s.Pos[i1]) >> 32)
+			buf[offset+5] = byte(uint64(s.Pos[i1]) >> 40)
+			buf[offset+6] = byte(uint64(s.Pos[i1]) >> 48)
+			buf[offset+7] = byte(uint64(s.Pos[i1]) >> 56)
+			offset += 8
+		}
+	}
+	if s.Parent != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Parent != nil {
+		offset, err = (*s.Parent).writeBinenc(buf, offset)
+		if err != nil {
+			return dst, err
+		}
+	}
+	if uint64(len(s.Replies)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Replies))
+	}
+	buf[offset] = byte(len(s.Replies))
+	buf[offset+1] = byte(len(s.Replies) >> 8)
+	offset += 2
+	for i1 := range s.Replies {
+		offset, err = s.Replies[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return dst, err
+		}
+	}
+	return buf, nil
+}
+
+func (s *Message) EncodedSize() int {
+	size := 39
+	size += len(s.Name) + len(s.Payload) + 8*len(s.Scores)
+	for k := range s.Labels {
+		size += 4
+		size += len(k)
+	}
+	if s.Parent != nil {
+		size += (*s.Parent).sizeBinenc()
+	}
+	for i1 := range s.Replies {
+		size += s.Replies[i1].sizeBinenc()
+	}
+	return size
+}
+
+func (s *Message) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Message) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Message) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 6 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "ID")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.ID = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Message", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Payload")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", int64(offset), "Payload")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Payload")
+	}
+	s.Payload = make([]byte, size)
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Payload")
+	}
+	offset += copy(s.Payload, data[offset:])
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Scores")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", int64(offset), "Scores")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Scores")
+	}
+	s.Scores = make([]float64, size)
+	if binenc.LittleEndian && size > 0 {
+		if len(data)-offset < 8*size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Scores")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Scores[0])), 8*size), data[offset:])
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if len(data)-offset < 8 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Scores[%d]", i)
+			}
+			buf = data[offset : offset+8]
+			offset += 8
+			s.Scores[i] = math.Float64frombits(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
+		}
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Labels")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", int64(offset), "Labels")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Labels")
+	}
+	s.Labels = make(map[string]int16, size)
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 int16
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Labels")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Message", int64(offset), "Labels")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Labels")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Labels")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Labels[%q]", k1)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		v1 = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		s.Labels[k1] = v1
+	}
+	if binenc.LittleEndian {
+		if len(data)-offset < 24 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Pos")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Pos[0])), 24), data[offset:])
+	} else {
+		for i2 := 0; i2 < 3; i2++ {
+			if len(data)-offset < 8 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Pos[%d]", i2)
+			}
+			buf = data[offset : offset+8]
+			offset += 8
+			s.Pos[i2] = int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
+		}
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Parent")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Parent = new(Message)
+		if offset, err = (*s.Parent).decodeBinenc(data, offset, opts, 1); err != nil {
+			return offset, binenc.FieldError(err, "Message", int64(offset), "Parent")
+		}
+	} else {
+		s.Parent = nil
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Replies")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", int64(offset), "Replies")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Replies")
+	}
+	s.Replies = make([]Message, size)
+	si3 := size
+	for i3 := 0; i3 < si3; i3++ {
+		if offset, err = s.Replies[i3].decodeBinenc(data, offset, opts, 1); err != nil {
+			return offset, binenc.FieldError(err, "Message", int64(offset), "Replies[%d]", i3)
+		}
+	}
+	return offset, nil
+}
+
+func (s *Message) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Point) WriteTo(w io.Writer) (n int64, err error) {
+	size := 8
+	buf := make([]byte, size)
+	offset := 0
+	buf[offset] = byte(uint32(s.X))
+	buf[offset+1] = byte(uint32(s.X) >> 8)
+	buf[offset+2] = byte(uint32(s.X) >> 16)
+	buf[offset+3] = byte(uint32(s.X) >> 24)
+	offset += 4
+	buf[offset] = byte(uint32(s.Y))
+	buf[offset+1] = byte(uint32(s.Y) >> 8)
+	buf[offset+2] = byte(uint32(s.Y) >> 16)
+	buf[offset+3] = byte(uint32(s.Y) >> 24)
+	offset += 4
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Point) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Point) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Point) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Point", n, "X")
+	}
+	n += 4
+	s.X = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Point", n, "Y")
+	}
+	n += 4
+	s.Y = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	return n, nil
+}
+
+func (s *Point) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 8
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(uint32(s.X))
+	buf[offset+1] = byte(uint32(s.X) >> 8)
+	buf[offset+2] = byte(uint32(s.X) >> 16)
+	buf[offset+3] = byte(uint32(s.X) >> 24)
+	offset += 4
+	buf[offset] = byte(uint32(s.Y))
+	buf[offset+1] = byte(uint32(s.Y) >> 8)
+	buf[offset+2] = byte(uint32(s.Y) >> 16)
+	buf[offset+3] = byte(uint32(s.Y) >> 24)
+	offset += 4
+	return buf, nil
+}
+
+func (s *Point) EncodedSize() int {
+	size := 8
+	return size
+}
+
+func (s *Point) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Point) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Point) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	if len(data)-offset < 8 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Point", int64(offset), "X")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.X = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Y = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	return offset, nil
+}
+
+func (s *Point) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Message) sizeBinenc() int {
+	size := 39
+	size += len(s.Name) + len(s.Payload) + 8*len(s.Scores)
+	for k := range s.Labels {
+		size += 4
+		size += len(k)
+	}
+	if s.Parent != nil {
+		size += (*s.Parent).sizeBinenc()
+	}
+	for i1 := range s.Replies {
+		size += s.Replies[i1].sizeBinenc()
+	}
+	return size
+}
+
+func (s *Message) writeBinenc(buf []byte, offset int) (_ int, err error) {
+	buf[offset] = byte(s.ID)
+	buf[offset+1] = byte(s.ID >> 8)
+	buf[offset+2] = byte(s.ID >> 16)
+	buf[offset+3] = byte(s.ID >> 24)
+	offset += 4
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if uint64(len(s.Payload)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Payload))
+	}
+	buf[offset] = byte(len(s.Payload))
+	buf[offset+1] = byte(len(s.Payload) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Payload)
+	offset += len(s.Payload)
+	if uint64(len(s.Scores)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Scores))
+	}
+	buf[offset] = byte(len(s.Scores))
+	buf[offset+1] = byte(len(s.Scores) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Scores) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Scores[0])), 8*len(s.Scores)))
+		offset += 8 * len(s.Scores)
+	} else {
+		for _, v := range s.Scores {
+			buf[offset] = byte(math.Float64bits(v))
+			buf[offset+1] = byte(math.Float64bits(v) >> 8)
+			buf[offset+2] = byte(math.Float64bits(v) >> 16)
+			buf[offset+3] = byte(math.Float64bits(v) >> 24)
+			buf[offset+4] = byte(math.Float64bits(v) >> 32)
+			buf[offset+5] = byte(math.Float64bits(v) >> 40)
+			buf[offset+6] = byte(math.Float64bits(v) >> 48)
+			buf[offset+7] = byte(math.Float64bits(v) >> 56)
+			offset += 8
+		}
+	}
+	if uint64(len(s.Labels)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Labels))
+	}
+	buf[offset] = byte(len(s.Labels))
+	buf[offset+1] = byte(len(s.Labels) >> 8)
+	offset += 2
+	for k, v := range s.Labels {
+		if uint64(len(k)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		buf[offset] = byte(uint16(v))
+		buf[offset+1] = byte(uint16(v) >> 8)
+		offset += 2
+	}
+	if binenc.LittleEndian {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Pos[0])), 24))
+		offset += 24
+	} else {
+		for i1 := 0; i1 < 3; i1++ {
+			buf[offset] = byte(uint64(s.Pos[i1]))
+			buf[offset+1] = byte(uint64(s.Pos[i1]) >> 8)
+			buf[offset+2] = byte(uint64(s.Pos[i1]) >> 16)
+			buf[offset+3] = byte(uint64(s.Pos[i1]) >> 24)
+			buf[offset+4] = byte(uint64(s.Pos[i1]) >> 32)
+			buf[offset+5] = byte(uint64(s.Pos[i1]) >> 40)
+			buf[offset+6] = byte(uint64(s.Pos[i1]) >> 48)
+			buf[offset+7] = byte(uint64(s.Pos[i1]) >> 56)
+			offset += 8
+		}
+	}
+	if s.Parent != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Parent != nil {
+		offset, err = (*s.Parent).writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if uint64(len(s.Replies)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Replies))
+	}
+	buf[offset] = byte(len(s.Replies))
+	buf[offset+1] = byte(len(s.Replies) >> 8)
+	offset += 2
+	for i1 := range s.Replies {
+		offset, err = s.Replies[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return offset, nil
+}
+
+func (s *Message) readBinenc(d *binenc.Decoder, n int64, depth int) (_ int64, err error) {
+	if limit := d.Options().MaxDepth; limit > 0 && depth > limit {
+		return n, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "ID")
+	}
+	n += 4
+	s.ID = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Name")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Message", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Message", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Message", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Payload")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", n, "Payload")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Message", n, "Payload")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Message", n, "Payload")
+	}
+	s.Payload = make([]byte, size)
+	if nr, err := io.ReadFull(r, s.Payload); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Payload")
+	}
+	n += int64(size)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Scores")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", n, "Scores")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Message", n, "Scores")
+	}
+	if size > binenc.MaxAlloc/8 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Message", n, "Scores")
+	}
+	s.Scores = make([]float64, size)
+	if binenc.LittleEndian && size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Scores[0])), 8*size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Message", n, "Scores")
+		}
+		n += int64(8 * size)
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Message", n, "Scores[%d]", i)
+			}
+			n += 8
+			s.Scores[i] = math.Float64frombits(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Labels")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", n, "Labels")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Message", n, "Labels")
+	}
+	s.Labels = make(map[string]int16, binenc.MapHint(size))
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 int16
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Message", n, "Labels")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Message", n, "Labels")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Message", n, "Labels")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Message", n, "Labels")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Message", n, "Labels")
+		}
+		n += int64(size)
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Message", n, "Labels[%q]", k1)
+		}
+		n += 2
+		v1 = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		s.Labels[k1] = v1
+	}
+	if binenc.LittleEndian {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Pos[0])), 24)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Message", n, "Pos")
+		}
+		n += 24
+	} else {
+		for i2 := 0; i2 < 3; i2++ {
+			if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Message", n, "Pos[%d]", i2)
+			}
+			n += 8
+			s.Pos[i2] = int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Parent")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Parent = new(Message)
+		if n, err = (*s.Parent).readBinenc(d, n, depth+1); err != nil {
+			return n, binenc.FieldError(err, "Message", n, "Parent")
+		}
+	} else {
+		s.Parent = nil
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Message", n, "Replies")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", n, "Replies")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Message", n, "Replies")
+	}
+	if size > binenc.MaxAlloc/136 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Message", n, "Replies")
+	}
+	s.Replies = make([]Message, size)
+	si3 := size
+	for i3 := 0; i3 < si3; i3++ {
+		if n, err = s.Replies[i3].readBinenc(d, n, depth+1); err != nil {
+			return n, binenc.FieldError(err, "Message", n, "Replies[%d]", i3)
+		}
+	}
+	return n, nil
+}
+
+func (s *Message) decodeBinenc(data []byte, offset int, opts binenc.DecodeOptions, depth int) (_ int, err error) {
+	if limit := opts.MaxDepth; limit > 0 && depth > limit {
+		return offset, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 6 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "ID")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.ID = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Message", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Payload")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", int64(offset), "Payload")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Payload")
+	}
+	s.Payload = make([]byte, size)
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Payload")
+	}
+	offset += copy(s.Payload, data[offset:])
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Scores")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", int64(offset), "Scores")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Scores")
+	}
+	s.Scores = make([]float64, size)
+	if binenc.LittleEndian && size > 0 {
+		if len(data)-offset < 8*size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Scores")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Scores[0])), 8*size), data[offset:])
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if len(data)-offset < 8 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Scores[%d]", i)
+			}
+			buf = data[offset : offset+8]
+			offset += 8
+			s.Scores[i] = math.Float64frombits(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
+		}
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Labels")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", int64(offset), "Labels")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Labels")
+	}
+	s.Labels = make(map[string]int16, size)
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 int16
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Labels")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Message", int64(offset), "Labels")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Labels")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Labels")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Labels[%q]", k1)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		v1 = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		s.Labels[k1] = v1
+	}
+	if binenc.LittleEndian {
+		if len(data)-offset < 24 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Pos")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Pos[0])), 24), data[offset:])
+	} else {
+		for i2 := 0; i2 < 3; i2++ {
+			if len(data)-offset < 8 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Pos[%d]", i2)
+			}
+			buf = data[offset : offset+8]
+			offset += 8
+			s.Pos[i2] = int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
+		}
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Parent")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Parent = new(Message)
+		if offset, err = (*s.Parent).decodeBinenc(data, offset, opts, depth+1); err != nil {
+			return offset, binenc.FieldError(err, "Message", int64(offset), "Parent")
+		}
+	} else {
+		s.Parent = nil
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Replies")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Message", int64(offset), "Replies")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Message", int64(offset), "Replies")
+	}
+	s.Replies = make([]Message, size)
+	si3 := size
+	for i3 := 0; i3 < si3; i3++ {
+		if offset, err = s.Replies[i3].decodeBinenc(data, offset, opts, depth+1); err != nil {
+			return offset, binenc.FieldError(err, "Message", int64(offset), "Replies[%d]", i3)
+		}
+	}
+	return offset, nil
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"bytes"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+//go:generate go-binenc-gen -output output_custom_encoding.go output.go
+type Output struct {
+	Name string
+}
+
+func main() {
+	s := &Output{Name: "output"}
+
+	var buf bytes.Buffer
+	s.WriteTo(&buf)
+
+	o := new(Output)
+	o.ReadFrom(&buf)
+
+	if diff := cmp.Diff(s, o); diff != "" {
+		panic("output.go: \n" + diff)
+	}
+}
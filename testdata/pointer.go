@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+//go:generate go-binenc-gen pointer.go
+type Options struct {
+	Verbose bool
+	Level   *uint8
+}
+
+type Pointer struct {
+	Name    *string
+	Nil     *string
+	Opts    *Options
+	NilOpts *Options
+	Arr     *[3]int16
+	Slice   *[]string
+	PtrPtr  **int32
+	Elems   []*Options
+	Done    bool
+}
+
+func main() {
+	name := "foo"
+	level := uint8(3)
+	num := int32(-42)
+	numPtr := &num
+	s := &Pointer{
+		Name:   &name,
+		Opts:   &Options{Verbose: true, Level: &level},
+		Arr:    &[3]int16{1, -2, 3},
+		Slice:  &[]string{"a", "b"},
+		PtrPtr: &numPtr,
+		Elems:  []*Options{nil, {Verbose: false}, {Level: &level}},
+		Done:   true,
+	}
+
+	var buf bytes.Buffer
+	s.WriteTo(&buf)
+
+	// decoding into a populated value must reset nil pointers
+	o := &Pointer{Nil: &name, NilOpts: &Options{}}
+	o.ReadFrom(&buf)
+
+	if diff := cmp.Diff(s, o); diff != "" {
+		panic("pointer.go: \n" + diff)
+	}
+}
@@ -0,0 +1,1309 @@
+// Code generated by "gobinenc pointer.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Options) WriteTo(w io.Writer) (n int64, err error) {
+	size := 2
+	if s.Level != nil {
+		size += 1
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if s.Verbose {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Level != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Level != nil {
+		buf[offset] = byte(*s.Level)
+		offset += 1
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Options) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Options) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Options) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Options", n, "Verbose")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Verbose = true
+	} else {
+		s.Verbose = false
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Options", n, "Level")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Level = new(uint8)
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Options", n, "Level")
+		}
+		n += 1
+		*s.Level = uint8(buf[0])
+	} else {
+		s.Level = nil
+	}
+	return n, nil
+}
+
+func (s *Options) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 2
+	if s.Level != nil {
+		size += 1
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if s.Verbose {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Level != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Level != nil {
+		buf[offset] = byte(*s.Level)
+		offset += 1
+	}
+	return buf, nil
+}
+
+func (s *Options) EncodedSize() int {
+	size := 2
+	if s.Level != nil {
+		size += 1
+	}
+	return size
+}
+
+func (s *Options) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Options) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Options) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Options", int64(offset), "Verbose")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Verbose = true
+	} else {
+		s.Verbose = false
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Level = new(uint8)
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Options", int64(offset), "Level")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		*s.Level = uint8(buf[0])
+	} else {
+		s.Level = nil
+	}
+	return offset, nil
+}
+
+func (s *Options) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Pointer) WriteTo(w io.Writer) (n int64, err error) {
+	size := 10
+	if s.Name != nil {
+		size += 2
+		size += len(*s.Name)
+	}
+	if s.Nil != nil {
+		size += 2
+		size += len(*s.Nil)
+	}
+	if s.Opts != nil {
+		size += 2
+		if (*s.Opts).Level != nil {
+			size += 1
+		}
+	}
+	if s.NilOpts != nil {
+		size += 2
+		if (*s.NilOpts).Level != nil {
+			size += 1
+		}
+	}
+	if s.Arr != nil {
+		size += 6
+	}
+	if s.Slice != nil {
+		size += 2
+		for _, v1 := range *s.Slice {
+			size += 2
+			size += len(v1)
+		}
+	}
+	if s.PtrPtr != nil {
+		size += 1
+		if (*s.PtrPtr) != nil {
+			size += 4
+		}
+	}
+	for _, v := range s.Elems {
+		size += 1
+		if v != nil {
+			size += 2
+			if (*v).Level != nil {
+				size += 1
+			}
+		}
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if s.Name != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Name != nil {
+		if uint64(len(*s.Name)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(*s.Name))
+		}
+		buf[offset] = byte(len(*s.Name))
+		buf[offset+1] = byte(len(*s.Name) >> 8)
+		offset += 2
+		copy(buf[offset:], *s.Name)
+		offset += len(*s.Name)
+	}
+	if s.Nil != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Nil != nil {
+		if uint64(len(*s.Nil)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(*s.Nil))
+		}
+		buf[offset] = byte(len(*s.Nil))
+		buf[offset+1] = byte(len(*s.Nil) >> 8)
+		offset += 2
+		copy(buf[offset:], *s.Nil)
+		offset += len(*s.Nil)
+	}
+	if s.Opts != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Opts != nil {
+		if (*s.Opts).Verbose {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if (*s.Opts).Level != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if (*s.Opts).Level != nil {
+			buf[offset] = byte(*(*s.Opts).Level)
+			offset += 1
+		}
+	}
+	if s.NilOpts != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.NilOpts != nil {
+		if (*s.NilOpts).Verbose {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if (*s.NilOpts).Level != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if (*s.NilOpts).Level != nil {
+			buf[offset] = byte(*(*s.NilOpts).Level)
+			offset += 1
+		}
+	}
+	if s.Arr != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Arr != nil {
+		if binenc.LittleEndian {
+			copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&(*s.Arr)[0])), 6))
+			offset += 6
+		} else {
+			for i2 := 0; i2 < 3; i2++ {
+				buf[offset] = byte(uint16((*s.Arr)[i2]))
+				buf[offset+1] = byte(uint16((*s.Arr)[i2]) >> 8)
+				offset += 2
+			}
+		}
+	}
+	if s.Slice != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Slice != nil {
+		if uint64(len((*s.Slice))) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Slice)))
+		}
+		buf[offset] = byte(len((*s.Slice)))
+		buf[offset+1] = byte(len((*s.Slice)) >> 8)
+		offset += 2
+		for _, v1 := range *s.Slice {
+			if uint64(len(v1)) > math.MaxUint16 {
+				return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v1))
+			}
+			buf[offset] = byte(len(v1))
+			buf[offset+1] = byte(len(v1) >> 8)
+			offset += 2
+			copy(buf[offset:], v1)
+			offset += len(v1)
+		}
+	}
+	if s.PtrPtr != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.PtrPtr != nil {
+		if (*s.PtrPtr) != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if (*s.PtrPtr) != nil {
+			buf[offset] = byte(uint32(*(*s.PtrPtr)))
+			buf[offset+1] = byte(uint32(*(*s.PtrPtr)) >> 8)
+			buf[offset+2] = byte(uint32(*(*s.PtrPtr)) >> 16)
+			buf[offset+3] = byte(uint32(*(*s.PtrPtr)) >> 24)
+			offset += 4
+		}
+	}
+	if uint64(len(s.Elems)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Elems))
+	}
+	buf[offset] = byte(len(s.Elems))
+	buf[offset+1] = byte(len(s.Elems) >> 8)
+	offset += 2
+	for _, v := range s.Elems {
+		if v != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if v != nil {
+			if (*v).Verbose {
+				buf[offset] = byte(0x01)
+			} else {
+				buf[offset] = byte(0x00)
+			}
+			offset += 1
+			if (*v).Level != nil {
+				buf[offset] = byte(0x01)
+			} else {
+				buf[offset] = byte(0x00)
+			}
+			offset += 1
+			if (*v).Level != nil {
+				buf[offset] = byte(*(*v).Level)
+				offset += 1
+			}
+		}
+	}
+	if s.Done {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Pointer) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Pointer) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Pointer) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Name")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Name = new(string)
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Name")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Pointer", n, "Name")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Pointer", n, "Name")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Pointer", n, "Name")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Name")
+		}
+		n += int64(size)
+		*s.Name = *(*string)(unsafe.Pointer(&tmp))
+	} else {
+		s.Name = nil
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Nil")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Nil = new(string)
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Nil")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Pointer", n, "Nil")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Pointer", n, "Nil")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Pointer", n, "Nil")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Nil")
+		}
+		n += int64(size)
+		*s.Nil = *(*string)(unsafe.Pointer(&tmp))
+	} else {
+		s.Nil = nil
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Opts")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Opts = new(Options)
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Opts.Verbose")
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			(*s.Opts).Verbose = true
+		} else {
+			(*s.Opts).Verbose = false
+		}
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Opts.Level")
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			(*s.Opts).Level = new(uint8)
+			if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Opts.Level")
+			}
+			n += 1
+			*(*s.Opts).Level = uint8(buf[0])
+		} else {
+			(*s.Opts).Level = nil
+		}
+	} else {
+		s.Opts = nil
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Pointer", n, "NilOpts")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.NilOpts = new(Options)
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Pointer", n, "NilOpts.Verbose")
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			(*s.NilOpts).Verbose = true
+		} else {
+			(*s.NilOpts).Verbose = false
+		}
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Pointer", n, "NilOpts.Level")
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			(*s.NilOpts).Level = new(uint8)
+			if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Pointer", n, "NilOpts.Level")
+			}
+			n += 1
+			*(*s.NilOpts).Level = uint8(buf[0])
+		} else {
+			(*s.NilOpts).Level = nil
+		}
+	} else {
+		s.NilOpts = nil
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Arr")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Arr = new([3]int16)
+		if binenc.LittleEndian {
+			if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&(*s.Arr)[0])), 6)); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Arr")
+			}
+			n += 6
+		} else {
+			for i := 0; i < 3; i++ {
+				if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+					return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Arr[%d]", i)
+				}
+				n += 2
+				(*s.Arr)[i] = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			}
+		}
+	} else {
+		s.Arr = nil
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Slice")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Slice = new([]string)
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Slice")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Pointer", n, "Slice")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Pointer", n, "Slice")
+		}
+		if size > binenc.MaxAlloc/16 {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Pointer", n, "Slice")
+		}
+		(*s.Slice) = make([]string, size)
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Slice[%d]", i1)
+			}
+			n += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Pointer", n, "Slice[%d]", i1)
+			}
+			if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Pointer", n, "Slice[%d]", i1)
+			}
+			if size > binenc.MaxAlloc {
+				return n, binenc.FieldError(binenc.ErrTooLarge, "Pointer", n, "Slice[%d]", i1)
+			}
+			tmp = d.Arena(size)
+			if nr, err := io.ReadFull(r, tmp); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Slice[%d]", i1)
+			}
+			n += int64(size)
+			(*s.Slice)[i1] = *(*string)(unsafe.Pointer(&tmp))
+		}
+	} else {
+		s.Slice = nil
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Pointer", n, "PtrPtr")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.PtrPtr = new(*int32)
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Pointer", n, "PtrPtr")
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			(*s.PtrPtr) = new(int32)
+			if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Pointer", n, "PtrPtr")
+			}
+			n += 4
+			*(*s.PtrPtr) = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+		} else {
+			(*s.PtrPtr) = nil
+		}
+	} else {
+		s.PtrPtr = nil
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Elems")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Pointer", n, "Elems")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Pointer", n, "Elems")
+	}
+	if size > binenc.MaxAlloc/8 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Pointer", n, "Elems")
+	}
+	s.Elems = make([]*Options, size)
+	si2 := size
+	for i2 := 0; i2 < si2; i2++ {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Elems[%d]", i2)
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			s.Elems[i2] = new(Options)
+			if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Elems[%d].Verbose", i2)
+			}
+			n += 1
+			if buf[0] == byte(0x01) {
+				(*s.Elems[i2]).Verbose = true
+			} else {
+				(*s.Elems[i2]).Verbose = false
+			}
+			if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Elems[%d].Level", i2)
+			}
+			n += 1
+			if buf[0] == byte(0x01) {
+				(*s.Elems[i2]).Level = new(uint8)
+				if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+					return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Elems[%d].Level", i2)
+				}
+				n += 1
+				*(*s.Elems[i2]).Level = uint8(buf[0])
+			} else {
+				(*s.Elems[i2]).Level = nil
+			}
+		} else {
+			s.Elems[i2] = nil
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Pointer", n, "Done")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Done = true
+	} else {
+		s.Done = false
+	}
+	return n, nil
+}
+
+func (s *Pointer) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 10
+	if s.Name != nil {
+		size += 2
+		size += len(*s.Name)
+	}
+	if s.Nil != nil {
+		size += 2
+		size += len(*s.Nil)
+	}
+	if s.Opts != nil {
+		size += 2
+		if (*s.Opts).Level != nil {
+			size += 1
+		}
+	}
+	if s.NilOpts != nil {
+		size += 2
+		if (*s.NilOpts).Level != nil {
+			size += 1
+		}
+	}
+	if s.Arr != nil {
+		size += 6
+	}
+	if s.Slice != nil {
+		size += 2
+		for _, v1 := range *s.Slice {
+			size += 2
+			size += len(v1)
+		}
+	}
+	if s.PtrPtr != nil {
+		size += 1
+		if (*s.PtrPtr) != nil {
+			size += 4
+		}
+	}
+	for _, v := range s.Elems {
+		size += 1
+		if v != nil {
+			size += 2
+			if (*v).Level != nil {
+				size += 1
+			}
+		}
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if s.Name != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Name != nil {
+		if uint64(len(*s.Name)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(*s.Name))
+		}
+		buf[offset] = byte(len(*s.Name))
+		buf[offset+1] = byte(len(*s.Name) >> 8)
+		offset += 2
+		copy(buf[offset:], *s.Name)
+		offset += len(*s.Name)
+	}
+	if s.Nil != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Nil != nil {
+		if uint64(len(*s.Nil)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(*s.Nil))
+		}
+		buf[offset] = byte(len(*s.Nil))
+		buf[offset+1] = byte(len(*s.Nil) >> 8)
+		offset += 2
+		copy(buf[offset:], *s.Nil)
+		offset += len(*s.Nil)
+	}
+	if s.Opts != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Opts != nil {
+		if (*s.Opts).Verbose {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if (*s.Opts).Level != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if (*s.Opts).Level != nil {
+			buf[offset] = byte(*(*s.Opts).Level)
+			offset += 1
+		}
+	}
+	if s.NilOpts != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.NilOpts != nil {
+		if (*s.NilOpts).Verbose {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if (*s.NilOpts).Level != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if (*s.NilOpts).Level != nil {
+			buf[offset] = byte(*(*s.NilOpts).Level)
+			offset += 1
+		}
+	}
+	if s.Arr != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Arr != nil {
+		if binenc.LittleEndian {
+			copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&(*s.Arr)[0])), 6))
+			offset += 6
+		} else {
+			for i2 := 0; i2 < 3; i2++ {
+				buf[offset] = byte(uint16((*s.Arr)[i2]))
+				buf[offset+1] = byte(uint16((*s.Arr)[i2]) >> 8)
+				offset += 2
+			}
+		}
+	}
+	if s.Slice != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Slice != nil {
+		if uint64(len((*s.Slice))) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Slice)))
+		}
+		buf[offset] = byte(len((*s.Slice)))
+		buf[offset+1] = byte(len((*s.Slice)) >> 8)
+		offset += 2
+		for _, v1 := range *s.Slice {
+			if uint64(len(v1)) > math.MaxUint16 {
+				return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v1))
+			}
+			buf[offset] = byte(len(v1))
+			buf[offset+1] = byte(len(v1) >> 8)
+			offset += 2
+			copy(buf[offset:], v1)
+			offset += len(v1)
+		}
+	}
+	if s.PtrPtr != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.PtrPtr != nil {
+		if (*s.PtrPtr) != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if (*s.PtrPtr) != nil {
+			buf[offset] = byte(uint32(*(*s.PtrPtr)))
+			buf[offset+1] = byte(uint32(*(*s.PtrPtr)) >> 8)
+			buf[offset+2] = byte(uint32(*(*s.PtrPtr)) >> 16)
+			buf[offset+3] = byte(uint32(*(*s.PtrPtr)) >> 24)
+			offset += 4
+		}
+	}
+	if uint64(len(s.Elems)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Elems))
+	}
+	buf[offset] = byte(len(s.Elems))
+	buf[offset+1] = byte(len(s.Elems) >> 8)
+	offset += 2
+	for _, v := range s.Elems {
+		if v != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if v != nil {
+			if (*v).Verbose {
+				buf[offset] = byte(0x01)
+			} else {
+				buf[offset] = byte(0x00)
+			}
+			offset += 1
+			if (*v).Level != nil {
+				buf[offset] = byte(0x01)
+			} else {
+				buf[offset] = byte(0x00)
+			}
+			offset += 1
+			if (*v).Level != nil {
+				buf[offset] = byte(*(*v).Level)
+				offset += 1
+			}
+		}
+	}
+	if s.Done {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	return buf, nil
+}
+
+func (s *Pointer) EncodedSize() int {
+	size := 10
+	if s.Name != nil {
+		size += 2
+		size += len(*s.Name)
+	}
+	if s.Nil != nil {
+		size += 2
+		size += len(*s.Nil)
+	}
+	if s.Opts != nil {
+		size += 2
+		if (*s.Opts).Level != nil {
+			size += 1
+		}
+	}
+	if s.NilOpts != nil {
+		size += 2
+		if (*s.NilOpts).Level != nil {
+			size += 1
+		}
+	}
+	if s.Arr != nil {
+		size += 6
+	}
+	if s.Slice != nil {
+		size += 2
+		for _, v1 := range *s.Slice {
+			size += 2
+			size += len(v1)
+		}
+	}
+	if s.PtrPtr != nil {
+		size += 1
+		if (*s.PtrPtr) != nil {
+			size += 4
+		}
+	}
+	for _, v := range s.Elems {
+		size += 1
+		if v != nil {
+			size += 2
+			if (*v).Level != nil {
+				size += 1
+			}
+		}
+	}
+	return size
+}
+
+func (s *Pointer) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Pointer) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Pointer) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Name")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Name = new(string)
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Name")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Pointer", int64(offset), "Name")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Name")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Name")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		*s.Name = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	} else {
+		s.Name = nil
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Nil")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Nil = new(string)
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Nil")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Pointer", int64(offset), "Nil")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Nil")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Nil")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		*s.Nil = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	} else {
+		s.Nil = nil
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Opts")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Opts = new(Options)
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Opts.Verbose")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			(*s.Opts).Verbose = true
+		} else {
+			(*s.Opts).Verbose = false
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			(*s.Opts).Level = new(uint8)
+			if len(data)-offset < 1 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Opts.Level")
+			}
+			buf = data[offset : offset+1]
+			offset += 1
+			*(*s.Opts).Level = uint8(buf[0])
+		} else {
+			(*s.Opts).Level = nil
+		}
+	} else {
+		s.Opts = nil
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "NilOpts")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.NilOpts = new(Options)
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "NilOpts.Verbose")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			(*s.NilOpts).Verbose = true
+		} else {
+			(*s.NilOpts).Verbose = false
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			(*s.NilOpts).Level = new(uint8)
+			if len(data)-offset < 1 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "NilOpts.Level")
+			}
+			buf = data[offset : offset+1]
+			offset += 1
+			*(*s.NilOpts).Level = uint8(buf[0])
+		} else {
+			(*s.NilOpts).Level = nil
+		}
+	} else {
+		s.NilOpts = nil
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Arr")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Arr = new([3]int16)
+		if binenc.LittleEndian {
+			if len(data)-offset < 6 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Arr")
+			}
+			offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&(*s.Arr)[0])), 6), data[offset:])
+		} else {
+			for i := 0; i < 3; i++ {
+				if len(data)-offset < 2 {
+					return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Arr[%d]", i)
+				}
+				buf = data[offset : offset+2]
+				offset += 2
+				(*s.Arr)[i] = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			}
+		}
+	} else {
+		s.Arr = nil
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Slice")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Slice = new([]string)
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Slice")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Pointer", int64(offset), "Slice")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Slice")
+		}
+		(*s.Slice) = make([]string, size)
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if len(data)-offset < 2 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Slice[%d]", i1)
+			}
+			buf = data[offset : offset+2]
+			offset += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Pointer", int64(offset), "Slice[%d]", i1)
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Slice[%d]", i1)
+			}
+			if c-m < size {
+				c = size
+				if c < 2*cap(strBuf) {
+					c = 2 * cap(strBuf)
+				}
+				strBuf = append([]byte(nil), make([]byte, c)...)
+				m = 0
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Slice[%d]", i1)
+			}
+			offset += copy(strBuf[m:m+size], data[offset:])
+			tmp = strBuf[m : m+size]
+			(*s.Slice)[i1] = *(*string)(unsafe.Pointer(&tmp))
+			m += size
+		}
+	} else {
+		s.Slice = nil
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "PtrPtr")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.PtrPtr = new(*int32)
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "PtrPtr")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			(*s.PtrPtr) = new(int32)
+			if len(data)-offset < 4 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "PtrPtr")
+			}
+			buf = data[offset : offset+4]
+			offset += 4
+			*(*s.PtrPtr) = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+		} else {
+			(*s.PtrPtr) = nil
+		}
+	} else {
+		s.PtrPtr = nil
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Elems")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Pointer", int64(offset), "Elems")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Elems")
+	}
+	s.Elems = make([]*Options, size)
+	si2 := size
+	for i2 := 0; i2 < si2; i2++ {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Elems[%d]", i2)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			s.Elems[i2] = new(Options)
+			if len(data)-offset < 2 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Elems[%d].Verbose", i2)
+			}
+			buf = data[offset : offset+1]
+			offset += 1
+			if buf[0] == byte(0x01) {
+				(*s.Elems[i2]).Verbose = true
+			} else {
+				(*s.Elems[i2]).Verbose = false
+			}
+			buf = data[offset : offset+1]
+			offset += 1
+			if buf[0] == byte(0x01) {
+				(*s.Elems[i2]).Level = new(uint8)
+				if len(data)-offset < 1 {
+					return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Elems[%d].Level", i2)
+				}
+				buf = data[offset : offset+1]
+				offset += 1
+				*(*s.Elems[i2]).Level = uint8(buf[0])
+			} else {
+				(*s.Elems[i2]).Level = nil
+			}
+		} else {
+			s.Elems[i2] = nil
+		}
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Pointer", int64(offset), "Done")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Done = true
+	} else {
+		s.Done = false
+	}
+	return offset, nil
+}
+
+func (s *Pointer) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
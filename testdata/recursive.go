@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+//go:generate go-binenc-gen recursive.go
+type Node struct {
+	Name     string
+	Children []Node
+	Next     *Node
+	Attrs    map[string]*Node
+}
+
+// Expr and Call are mutually recursive.
+type Expr struct {
+	Value int32
+	Call  *Call
+}
+
+type Call struct {
+	Func string
+	Args []Expr
+}
+
+// Tree is a recursive non-struct type.
+type Tree []Tree
+
+type Forest struct {
+	Trees Tree
+	Root  Expr
+}
+
+func main() {
+	s := &Node{
+		Name: "root",
+		Children: []Node{
+			{Name: "a", Children: []Node{{Name: "a1"}}},
+			{Name: "b", Next: &Node{Name: "b-next"}},
+		},
+		Next:  &Node{Name: "next", Next: &Node{Name: "next-next"}},
+		Attrs: map[string]*Node{"x": {Name: "x"}, "nil": nil},
+	}
+
+	var buf bytes.Buffer
+	s.WriteTo(&buf)
+
+	o := new(Node)
+	o.ReadFrom(&buf)
+
+	if diff := cmp.Diff(s, o, cmpopts.EquateEmpty()); diff != "" {
+		panic("recursive.go: \n" + diff)
+	}
+
+	f := &Forest{
+		Trees: Tree{Tree{}, Tree{Tree{}, Tree{Tree{}}}},
+		Root: Expr{Call: &Call{
+			Func: "add",
+			Args: []Expr{{Value: 1}, {Call: &Call{Func: "neg", Args: []Expr{{Value: 2}}}}},
+		}},
+	}
+
+	buf.Reset()
+	f.WriteTo(&buf)
+
+	of := new(Forest)
+	of.ReadFrom(&buf)
+
+	if diff := cmp.Diff(f, of, cmpopts.EquateEmpty()); diff != "" {
+		panic("recursive.go: \n" + diff)
+	}
+}
@@ -0,0 +1,2148 @@
+// Code generated by "gobinenc recursive.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Node) WriteTo(w io.Writer) (n int64, err error) {
+	size := 7
+	size += len(s.Name)
+	for i1 := range s.Children {
+		size += s.Children[i1].sizeBinenc()
+	}
+	if s.Next != nil {
+		size += (*s.Next).sizeBinenc()
+	}
+	for k, v := range s.Attrs {
+		size += 3
+		size += len(k)
+		if v != nil {
+			size += (*v).sizeBinenc()
+		}
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if uint64(len(s.Children)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Children))
+	}
+	buf[offset] = byte(len(s.Children))
+	buf[offset+1] = byte(len(s.Children) >> 8)
+	offset += 2
+	for i1 := range s.Children {
+		offset, err = s.Children[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if s.Next != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Next != nil {
+		offset, err = (*s.Next).writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if uint64(len(s.Attrs)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Attrs))
+	}
+	buf[offset] = byte(len(s.Attrs))
+	buf[offset+1] = byte(len(s.Attrs) >> 8)
+	offset += 2
+	for k, v := range s.Attrs {
+		if uint64(len(k)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if v != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if v != nil {
+			offset, err = (*v).writeBinenc(buf, offset)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Node) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Node) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Node) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Name")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Node", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Node", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Node", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Children")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Node", n, "Children")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Node", n, "Children")
+	}
+	if size > binenc.MaxAlloc/56 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Node", n, "Children")
+	}
+	s.Children = make([]Node, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if n, err = s.Children[i].readBinenc(d, n, 1); err != nil {
+			return n, binenc.FieldError(err, "Node", n, "Children[%d]", i)
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Next")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Next = new(Node)
+		if n, err = (*s.Next).readBinenc(d, n, 1); err != nil {
+			return n, binenc.FieldError(err, "Node", n, "Next")
+		}
+	} else {
+		s.Next = nil
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Attrs")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Node", n, "Attrs")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Node", n, "Attrs")
+	}
+	s.Attrs = make(map[string]*Node, binenc.MapHint(size))
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 *Node
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Node", n, "Attrs")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Node", n, "Attrs")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Node", n, "Attrs")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Node", n, "Attrs")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Node", n, "Attrs")
+		}
+		n += int64(size)
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Node", n, "Attrs[%q]", k1)
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			v1 = new(Node)
+			if n, err = (*v1).readBinenc(d, n, 1); err != nil {
+				return n, binenc.FieldError(err, "Node", n, "Attrs[%q]", k1)
+			}
+		} else {
+			v1 = nil
+		}
+		s.Attrs[k1] = v1
+	}
+	return n, nil
+}
+
+func (s *Node) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 7
+	size += len(s.Name)
+	for i1 := range s.Children {
+		size += s.Children[i1].sizeBinenc()
+	}
+	if s.Next != nil {
+		size += (*s.Next).sizeBinenc()
+	}
+	for k, v := range s.Attrs {
+		size += 3
+		size += len(k)
+		if v != nil {
+			size += (*v).sizeBinenc()
+		}
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if uint64(len(s.Children)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Children))
+	}
+	buf[offset] = byte(len(s.Children))
+	buf[offset+1] = byte(len(s.Children) >> 8)
+	offset += 2
+	for i1 := range s.Children {
+		offset, err = s.Children[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return dst, err
+		}
+	}
+	if s.Next != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Next != nil {
+		offset, err = (*s.Next).writeBinenc(buf, offset)
+		if err != nil {
+			return dst, err
+		}
+	}
+	if uint64(len(s.Attrs)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Attrs))
+	}
+	buf[offset] = byte(len(s.Attrs))
+	buf[offset+1] = byte(len(s.Attrs) >> 8)
+	offset += 2
+	for k, v := range s.Attrs {
+		if uint64(len(k)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if v != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if v != nil {
+			offset, err = (*v).writeBinenc(buf, offset)
+			if err != nil {
+				return dst, err
+			}
+		}
+	}
+	return buf, nil
+}
+
+func (s *Node) EncodedSize() int {
+	size := 7
+	size += len(s.Name)
+	for i1 := range s.Children {
+		size += s.Children[i1].sizeBinenc()
+	}
+	if s.Next != nil {
+		size += (*s.Next).sizeBinenc()
+	}
+	for k, v := range s.Attrs {
+		size += 3
+		size += len(k)
+		if v != nil {
+			size += (*v).sizeBinenc()
+		}
+	}
+	return size
+}
+
+func (s *Node) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Node) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Node) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Name")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Node", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Children")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Node", int64(offset), "Children")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Children")
+	}
+	s.Children = make([]Node, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if offset, err = s.Children[i].decodeBinenc(data, offset, opts, 1); err != nil {
+			return offset, binenc.FieldError(err, "Node", int64(offset), "Children[%d]", i)
+		}
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Next")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Next = new(Node)
+		if offset, err = (*s.Next).decodeBinenc(data, offset, opts, 1); err != nil {
+			return offset, binenc.FieldError(err, "Node", int64(offset), "Next")
+		}
+	} else {
+		s.Next = nil
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Attrs")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Node", int64(offset), "Attrs")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Attrs")
+	}
+	s.Attrs = make(map[string]*Node, size)
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 *Node
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Attrs")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Node", int64(offset), "Attrs")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Attrs")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Attrs")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Attrs[%q]", k1)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			v1 = new(Node)
+			if offset, err = (*v1).decodeBinenc(data, offset, opts, 1); err != nil {
+				return offset, binenc.FieldError(err, "Node", int64(offset), "Attrs[%q]", k1)
+			}
+		} else {
+			v1 = nil
+		}
+		s.Attrs[k1] = v1
+	}
+	return offset, nil
+}
+
+func (s *Node) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Expr) WriteTo(w io.Writer) (n int64, err error) {
+	size := 5
+	if s.Call != nil {
+		size += 4
+		size += len((*s.Call).Func)
+		for i2 := range (*s.Call).Args {
+			size += (*s.Call).Args[i2].sizeBinenc()
+		}
+	}
+	buf := make([]byte, size)
+	offset := 0
+	buf[offset] = byte(uint32(s.Value))
+	buf[offset+1] = byte(uint32(s.Value) >> 8)
+	buf[offset+2] = byte(uint32(s.Value) >> 16)
+	buf[offset+3] = byte(uint32(s.Value) >> 24)
+	offset += 4
+	if s.Call != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Call != nil {
+		if uint64(len((*s.Call).Func)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Call).Func))
+		}
+		buf[offset] = byte(len((*s.Call).Func))
+		buf[offset+1] = byte(len((*s.Call).Func) >> 8)
+		offset += 2
+		copy(buf[offset:], (*s.Call).Func)
+		offset += len((*s.Call).Func)
+		if uint64(len((*s.Call).Args)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Call).Args))
+		}
+		buf[offset] = byte(len((*s.Call).Args))
+		buf[offset+1] = byte(len((*s.Call).Args) >> 8)
+		offset += 2
+		for i2 := range (*s.Call).Args {
+			offset, err = (*s.Call).Args[i2].writeBinenc(buf, offset)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Expr) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Expr) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Expr) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Expr", n, "Value")
+	}
+	n += 4
+	s.Value = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Expr", n, "Call")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Call = new(Call)
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Expr", n, "Call.Func")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Expr", n, "Call.Func")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Expr", n, "Call.Func")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Expr", n, "Call.Func")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Expr", n, "Call.Func")
+		}
+		n += int64(size)
+		(*s.Call).Func = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Expr", n, "Call.Args")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Expr", n, "Call.Args")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Expr", n, "Call.Args")
+		}
+		if size > binenc.MaxAlloc/16 {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Expr", n, "Call.Args")
+		}
+		(*s.Call).Args = make([]Expr, size)
+		si := size
+		for i := 0; i < si; i++ {
+			if n, err = (*s.Call).Args[i].readBinenc(d, n, 1); err != nil {
+				return n, binenc.FieldError(err, "Expr", n, "Call.Args[%d]", i)
+			}
+		}
+	} else {
+		s.Call = nil
+	}
+	return n, nil
+}
+
+func (s *Expr) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 5
+	if s.Call != nil {
+		size += 4
+		size += len((*s.Call).Func)
+		for i2 := range (*s.Call).Args {
+			size += (*s.Call).Args[i2].sizeBinenc()
+		}
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(uint32(s.Value))
+	buf[offset+1] = byte(uint32(s.Value) >> 8)
+	buf[offset+2] = byte(uint32(s.Value) >> 16)
+	buf[offset+3] = byte(uint32(s.Value) >> 24)
+	offset += 4
+	if s.Call != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Call != nil {
+		if uint64(len((*s.Call).Func)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Call).Func))
+		}
+		buf[offset] = byte(len((*s.Call).Func))
+		buf[offset+1] = byte(len((*s.Call).Func) >> 8)
+		offset += 2
+		copy(buf[offset:], (*s.Call).Func)
+		offset += len((*s.Call).Func)
+		if uint64(len((*s.Call).Args)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Call).Args))
+		}
+		buf[offset] = byte(len((*s.Call).Args))
+		buf[offset+1] = byte(len((*s.Call).Args) >> 8)
+		offset += 2
+		for i2 := range (*s.Call).Args {
+			offset, err = (*s.Call).Args[i2].writeBinenc(buf, offset)
+			if err != nil {
+				return dst, err
+			}
+		}
+	}
+	return buf, nil
+}
+
+func (s *Expr) EncodedSize() int {
+	size := 5
+	if s.Call != nil {
+		size += 4
+		size += len((*s.Call).Func)
+		for i2 := range (*s.Call).Args {
+			size += (*s.Call).Args[i2].sizeBinenc()
+		}
+	}
+	return size
+}
+
+func (s *Expr) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Expr) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Expr) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 5 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Expr", int64(offset), "Value")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Value = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Call = new(Call)
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Expr", int64(offset), "Call.Func")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Expr", int64(offset), "Call.Func")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Expr", int64(offset), "Call.Func")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Expr", int64(offset), "Call.Func")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		(*s.Call).Func = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Expr", int64(offset), "Call.Args")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Expr", int64(offset), "Call.Args")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Expr", int64(offset), "Call.Args")
+		}
+		(*s.Call).Args = make([]Expr, size)
+		si := size
+		for i := 0; i < si; i++ {
+			if offset, err = (*s.Call).Args[i].decodeBinenc(data, offset, opts, 1); err != nil {
+				return offset, binenc.FieldError(err, "Expr", int64(offset), "Call.Args[%d]", i)
+			}
+		}
+	} else {
+		s.Call = nil
+	}
+	return offset, nil
+}
+
+func (s *Expr) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Call) WriteTo(w io.Writer) (n int64, err error) {
+	size := 4
+	size += len(s.Func)
+	for _, v := range s.Args {
+		size += 5
+		if v.Call != nil {
+			size += (*v.Call).sizeBinenc()
+		}
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Func)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Func))
+	}
+	buf[offset] = byte(len(s.Func))
+	buf[offset+1] = byte(len(s.Func) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Func)
+	offset += len(s.Func)
+	if uint64(len(s.Args)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Args))
+	}
+	buf[offset] = byte(len(s.Args))
+	buf[offset+1] = byte(len(s.Args) >> 8)
+	offset += 2
+	for _, v := range s.Args {
+		buf[offset] = byte(uint32(v.Value))
+		buf[offset+1] = byte(uint32(v.Value) >> 8)
+		buf[offset+2] = byte(uint32(v.Value) >> 16)
+		buf[offset+3] = byte(uint32(v.Value) >> 24)
+		offset += 4
+		if v.Call != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if v.Call != nil {
+			offset, err = (*v.Call).writeBinenc(buf, offset)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Call) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Call) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Call) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Call", n, "Func")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Call", n, "Func")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Call", n, "Func")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Call", n, "Func")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Call", n, "Func")
+	}
+	n += int64(size)
+	s.Func = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Call", n, "Args")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Call", n, "Args")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Call", n, "Args")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Call", n, "Args")
+	}
+	s.Args = make([]Expr, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Call", n, "Args[%d].Value", i)
+		}
+		n += 4
+		s.Args[i].Value = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Call", n, "Args[%d].Call", i)
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			s.Args[i].Call = new(Call)
+			if n, err = (*s.Args[i].Call).readBinenc(d, n, 1); err != nil {
+				return n, binenc.FieldError(err, "Call", n, "Args[%d].Call", i)
+			}
+		} else {
+			s.Args[i].Call = nil
+		}
+	}
+	return n, nil
+}
+
+func (s *Call) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 4
+	size += len(s.Func)
+	for _, v := range s.Args {
+		size += 5
+		if v.Call != nil {
+			size += (*v.Call).sizeBinenc()
+		}
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Func)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Func))
+	}
+	buf[offset] = byte(len(s.Func))
+	buf[offset+1] = byte(len(s.Func) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Func)
+	offset += len(s.Func)
+	if uint64(len(s.Args)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Args))
+	}
+	buf[offset] = byte(len(s.Args))
+	buf[offset+1] = byte(len(s.Args) >> 8)
+	offset += 2
+	for _, v := range s.Args {
+		buf[offset] = byte(uint32(v.Value))
+		buf[offset+1] = byte(uint32(v.Value) >> 8)
+		buf[offset+2] = byte(uint32(v.Value) >> 16)
+		buf[offset+3] = byte(uint32(v.Value) >> 24)
+		offset += 4
+		if v.Call != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if v.Call != nil {
+			offset, err = (*v.Call).writeBinenc(buf, offset)
+			if err != nil {
+				return dst, err
+			}
+		}
+	}
+	return buf, nil
+}
+
+func (s *Call) EncodedSize() int {
+	size := 4
+	size += len(s.Func)
+	for _, v := range s.Args {
+		size += 5
+		if v.Call != nil {
+			size += (*v.Call).sizeBinenc()
+		}
+	}
+	return size
+}
+
+func (s *Call) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Call) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Call) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Call", int64(offset), "Func")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Call", int64(offset), "Func")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Call", int64(offset), "Func")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Call", int64(offset), "Func")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Func = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Call", int64(offset), "Args")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Call", int64(offset), "Args")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Call", int64(offset), "Args")
+	}
+	s.Args = make([]Expr, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 5 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Call", int64(offset), "Args[%d].Value", i)
+		}
+		buf = data[offset : offset+4]
+		offset += 4
+		s.Args[i].Value = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			s.Args[i].Call = new(Call)
+			if offset, err = (*s.Args[i].Call).decodeBinenc(data, offset, opts, 1); err != nil {
+				return offset, binenc.FieldError(err, "Call", int64(offset), "Args[%d].Call", i)
+			}
+		} else {
+			s.Args[i].Call = nil
+		}
+	}
+	return offset, nil
+}
+
+func (s *Call) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Forest) WriteTo(w io.Writer) (n int64, err error) {
+	size := 7
+	for i1 := range s.Trees {
+		size += s.Trees[i1].sizeBinenc()
+	}
+	if s.Root.Call != nil {
+		size += 4
+		size += len((*s.Root.Call).Func)
+		for i2 := range (*s.Root.Call).Args {
+			size += (*s.Root.Call).Args[i2].sizeBinenc()
+		}
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Trees)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Trees))
+	}
+	buf[offset] = byte(len(s.Trees))
+	buf[offset+1] = byte(len(s.Trees) >> 8)
+	offset += 2
+	for i1 := range s.Trees {
+		offset, err = s.Trees[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	buf[offset] = byte(uint32(s.Root.Value))
+	buf[offset+1] = byte(uint32(s.Root.Value) >> 8)
+	buf[offset+2] = byte(uint32(s.Root.Value) >> 16)
+	buf[offset+3] = byte(uint32(s.Root.Value) >> 24)
+	offset += 4
+	if s.Root.Call != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Root.Call != nil {
+		if uint64(len((*s.Root.Call).Func)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Root.Call).Func))
+		}
+		buf[offset] = byte(len((*s.Root.Call).Func))
+		buf[offset+1] = byte(len((*s.Root.Call).Func) >> 8)
+		offset += 2
+		copy(buf[offset:], (*s.Root.Call).Func)
+		offset += len((*s.Root.Call).Func)
+		if uint64(len((*s.Root.Call).Args)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Root.Call).Args))
+		}
+		buf[offset] = byte(len((*s.Root.Call).Args))
+		buf[offset+1] = byte(len((*s.Root.Call).Args) >> 8)
+		offset += 2
+		for i2 := range (*s.Root.Call).Args {
+			offset, err = (*s.Root.Call).Args[i2].writeBinenc(buf, offset)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Forest) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Forest) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Forest) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Forest", n, "Trees")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Forest", n, "Trees")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Forest", n, "Trees")
+	}
+	if size > binenc.MaxAlloc/24 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Forest", n, "Trees")
+	}
+	s.Trees = make([]Tree, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if n, err = s.Trees[i].readBinenc(d, n, 1); err != nil {
+			return n, binenc.FieldError(err, "Forest", n, "Trees[%d]", i)
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Forest", n, "Root.Value")
+	}
+	n += 4
+	s.Root.Value = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Forest", n, "Root.Call")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Root.Call = new(Call)
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Forest", n, "Root.Call.Func")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Forest", n, "Root.Call.Func")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Forest", n, "Root.Call.Func")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Forest", n, "Root.Call.Func")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Forest", n, "Root.Call.Func")
+		}
+		n += int64(size)
+		(*s.Root.Call).Func = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Forest", n, "Root.Call.Args")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Forest", n, "Root.Call.Args")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Forest", n, "Root.Call.Args")
+		}
+		if size > binenc.MaxAlloc/16 {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Forest", n, "Root.Call.Args")
+		}
+		(*s.Root.Call).Args = make([]Expr, size)
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if n, err = (*s.Root.Call).Args[i1].readBinenc(d, n, 1); err != nil {
+				return n, binenc.FieldError(err, "Forest", n, "Root.Call.Args[%d]", i1)
+			}
+		}
+	} else {
+		s.Root.Call = nil
+	}
+	return n, nil
+}
+
+func (s *Forest) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 7
+	for i1 := range s.Trees {
+		size += s.Trees[i1].sizeBinenc()
+	}
+	if s.Root.Call != nil {
+		size += 4
+		size += len((*s.Root.Call).Func)
+		for i2 := range (*s.Root.Call).Args {
+			size += (*s.Root.Call).Args[i2].sizeBinenc()
+		}
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Trees)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Trees))
+	}
+	buf[offset] = byte(len(s.Trees))
+	buf[offset+1] = byte(len(s.Trees) >> 8)
+	offset += 2
+	for i1 := range s.Trees {
+		offset, err = s.Trees[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return dst, err
+		}
+	}
+	buf[offset] = byte(uint32(s.Root.Value))
+	buf[offset+1] = byte(uint32(s.Root.Value) >> 8)
+	buf[offset+2] = byte(uint32(s.Root.Value) >> 16)
+	buf[offset+3] = byte(uint32(s.Root.Value) >> 24)
+	offset += 4
+	if s.Root.Call != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Root.Call != nil {
+		if uint64(len((*s.Root.Call).Func)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Root.Call).Func))
+		}
+		buf[offset] = byte(len((*s.Root.Call).Func))
+		buf[offset+1] = byte(len((*s.Root.Call).Func) >> 8)
+		offset += 2
+		copy(buf[offset:], (*s.Root.Call).Func)
+		offset += len((*s.Root.Call).Func)
+		if uint64(len((*s.Root.Call).Args)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Root.Call).Args))
+		}
+		buf[offset] = byte(len((*s.Root.Call).Args))
+		buf[offset+1] = byte(len((*s.Root.Call).Args) >> 8)
+		offset += 2
+		for i2 := range (*s.Root.Call).Args {
+			offset, err = (*s.Root.Call).Args[i2].writeBinenc(buf, offset)
+			if err != nil {
+				return dst, err
+			}
+		}
+	}
+	return buf, nil
+}
+
+func (s *Forest) EncodedSize() int {
+	size := 7
+	for i1 := range s.Trees {
+		size += s.Trees[i1].sizeBinenc()
+	}
+	if s.Root.Call != nil {
+		size += 4
+		size += len((*s.Root.Call).Func)
+		for i2 := range (*s.Root.Call).Args {
+			size += (*s.Root.Call).Args[i2].sizeBinenc()
+		}
+	}
+	return size
+}
+
+func (s *Forest) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Forest) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Forest) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Forest", int64(offset), "Trees")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Forest", int64(offset), "Trees")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Forest", int64(offset), "Trees")
+	}
+	s.Trees = make([]Tree, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if offset, err = s.Trees[i].decodeBinenc(data, offset, opts, 1); err != nil {
+			return offset, binenc.FieldError(err, "Forest", int64(offset), "Trees[%d]", i)
+		}
+	}
+	if len(data)-offset < 5 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Forest", int64(offset), "Root.Value")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Root.Value = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Root.Call = new(Call)
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Forest", int64(offset), "Root.Call.Func")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Forest", int64(offset), "Root.Call.Func")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Forest", int64(offset), "Root.Call.Func")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Forest", int64(offset), "Root.Call.Func")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		(*s.Root.Call).Func = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Forest", int64(offset), "Root.Call.Args")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Forest", int64(offset), "Root.Call.Args")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Forest", int64(offset), "Root.Call.Args")
+		}
+		(*s.Root.Call).Args = make([]Expr, size)
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if offset, err = (*s.Root.Call).Args[i1].decodeBinenc(data, offset, opts, 1); err != nil {
+				return offset, binenc.FieldError(err, "Forest", int64(offset), "Root.Call.Args[%d]", i1)
+			}
+		}
+	} else {
+		s.Root.Call = nil
+	}
+	return offset, nil
+}
+
+func (s *Forest) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Node) sizeBinenc() int {
+	size := 7
+	size += len(s.Name)
+	for i1 := range s.Children {
+		size += s.Children[i1].sizeBinenc()
+	}
+	if s.Next != nil {
+		size += (*s.Next).sizeBinenc()
+	}
+	for k, v := range s.Attrs {
+		size += 3
+		size += len(k)
+		if v != nil {
+			size += (*v).sizeBinenc()
+		}
+	}
+	return size
+}
+
+func (s *Node) writeBinenc(buf []byte, offset int) (_ int, err error) {
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if uint64(len(s.Children)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Children))
+	}
+	buf[offset] = byte(len(s.Children))
+	buf[offset+1] = byte(len(s.Children) >> 8)
+	offset += 2
+	for i1 := range s.Children {
+		offset, err = s.Children[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if s.Next != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Next != nil {
+		offset, err = (*s.Next).writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if uint64(len(s.Attrs)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Attrs))
+	}
+	buf[offset] = byte(len(s.Attrs))
+	buf[offset+1] = byte(len(s.Attrs) >> 8)
+	offset += 2
+	for k, v := range s.Attrs {
+		if uint64(len(k)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if v != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if v != nil {
+			offset, err = (*v).writeBinenc(buf, offset)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	return offset, nil
+}
+
+func (s *Node) readBinenc(d *binenc.Decoder, n int64, depth int) (_ int64, err error) {
+	if limit := d.Options().MaxDepth; limit > 0 && depth > limit {
+		return n, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Name")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Node", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Node", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Node", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Children")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Node", n, "Children")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Node", n, "Children")
+	}
+	if size > binenc.MaxAlloc/56 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Node", n, "Children")
+	}
+	s.Children = make([]Node, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if n, err = s.Children[i].readBinenc(d, n, depth+1); err != nil {
+			return n, binenc.FieldError(err, "Node", n, "Children[%d]", i)
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Next")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Next = new(Node)
+		if n, err = (*s.Next).readBinenc(d, n, depth+1); err != nil {
+			return n, binenc.FieldError(err, "Node", n, "Next")
+		}
+	} else {
+		s.Next = nil
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Node", n, "Attrs")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Node", n, "Attrs")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Node", n, "Attrs")
+	}
+	s.Attrs = make(map[string]*Node, binenc.MapHint(size))
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 *Node
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Node", n, "Attrs")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Node", n, "Attrs")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Node", n, "Attrs")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Node", n, "Attrs")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Node", n, "Attrs")
+		}
+		n += int64(size)
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Node", n, "Attrs[%q]", k1)
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			v1 = new(Node)
+			if n, err = (*v1).readBinenc(d, n, depth+1); err != nil {
+				return n, binenc.FieldError(err, "Node", n, "Attrs[%q]", k1)
+			}
+		} else {
+			v1 = nil
+		}
+		s.Attrs[k1] = v1
+	}
+	return n, nil
+}
+
+func (s *Node) decodeBinenc(data []byte, offset int, opts binenc.DecodeOptions, depth int) (_ int, err error) {
+	if limit := opts.MaxDepth; limit > 0 && depth > limit {
+		return offset, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Name")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Node", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Children")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Node", int64(offset), "Children")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Children")
+	}
+	s.Children = make([]Node, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if offset, err = s.Children[i].decodeBinenc(data, offset, opts, depth+1); err != nil {
+			return offset, binenc.FieldError(err, "Node", int64(offset), "Children[%d]", i)
+		}
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Next")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Next = new(Node)
+		if offset, err = (*s.Next).decodeBinenc(data, offset, opts, depth+1); err != nil {
+			return offset, binenc.FieldError(err, "Node", int64(offset), "Next")
+		}
+	} else {
+		s.Next = nil
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Attrs")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Node", int64(offset), "Attrs")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Attrs")
+	}
+	s.Attrs = make(map[string]*Node, size)
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 *Node
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Attrs")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Node", int64(offset), "Attrs")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Attrs")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Attrs")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Node", int64(offset), "Attrs[%q]", k1)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			v1 = new(Node)
+			if offset, err = (*v1).decodeBinenc(data, offset, opts, depth+1); err != nil {
+				return offset, binenc.FieldError(err, "Node", int64(offset), "Attrs[%q]", k1)
+			}
+		} else {
+			v1 = nil
+		}
+		s.Attrs[k1] = v1
+	}
+	return offset, nil
+}
+
+func (s *Expr) sizeBinenc() int {
+	size := 5
+	if s.Call != nil {
+		size += 4
+		size += len((*s.Call).Func)
+		for i2 := range (*s.Call).Args {
+			size += (*s.Call).Args[i2].sizeBinenc()
+		}
+	}
+	return size
+}
+
+func (s *Expr) writeBinenc(buf []byte, offset int) (_ int, err error) {
+	buf[offset] = byte(uint32(s.Value))
+	buf[offset+1] = byte(uint32(s.Value) >> 8)
+	buf[offset+2] = byte(uint32(s.Value) >> 16)
+	buf[offset+3] = byte(uint32(s.Value) >> 24)
+	offset += 4
+	if s.Call != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Call != nil {
+		if uint64(len((*s.Call).Func)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Call).Func))
+		}
+		buf[offset] = byte(len((*s.Call).Func))
+		buf[offset+1] = byte(len((*s.Call).Func) >> 8)
+		offset += 2
+		copy(buf[offset:], (*s.Call).Func)
+		offset += len((*s.Call).Func)
+		if uint64(len((*s.Call).Args)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Call).Args))
+		}
+		buf[offset] = byte(len((*s.Call).Args))
+		buf[offset+1] = byte(len((*s.Call).Args) >> 8)
+		offset += 2
+		for i2 := range (*s.Call).Args {
+			offset, err = (*s.Call).Args[i2].writeBinenc(buf, offset)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	return offset, nil
+}
+
+func (s *Expr) readBinenc(d *binenc.Decoder, n int64, depth int) (_ int64, err error) {
+	if limit := d.Options().MaxDepth; limit > 0 && depth > limit {
+		return n, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Expr", n, "Value")
+	}
+	n += 4
+	s.Value = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Expr", n, "Call")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Call = new(Call)
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Expr", n, "Call.Func")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Expr", n, "Call.Func")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Expr", n, "Call.Func")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Expr", n, "Call.Func")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Expr", n, "Call.Func")
+		}
+		n += int64(size)
+		(*s.Call).Func = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Expr", n, "Call.Args")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Expr", n, "Call.Args")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Expr", n, "Call.Args")
+		}
+		if size > binenc.MaxAlloc/16 {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Expr", n, "Call.Args")
+		}
+		(*s.Call).Args = make([]Expr, size)
+		si := size
+		for i := 0; i < si; i++ {
+			if n, err = (*s.Call).Args[i].readBinenc(d, n, depth+1); err != nil {
+				return n, binenc.FieldError(err, "Expr", n, "Call.Args[%d]", i)
+			}
+		}
+	} else {
+		s.Call = nil
+	}
+	return n, nil
+}
+
+func (s *Expr) decodeBinenc(data []byte, offset int, opts binenc.DecodeOptions, depth int) (_ int, err error) {
+	if limit := opts.MaxDepth; limit > 0 && depth > limit {
+		return offset, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 5 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Expr", int64(offset), "Value")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Value = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Call = new(Call)
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Expr", int64(offset), "Call.Func")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Expr", int64(offset), "Call.Func")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Expr", int64(offset), "Call.Func")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Expr", int64(offset), "Call.Func")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		(*s.Call).Func = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Expr", int64(offset), "Call.Args")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Expr", int64(offset), "Call.Args")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Expr", int64(offset), "Call.Args")
+		}
+		(*s.Call).Args = make([]Expr, size)
+		si := size
+		for i := 0; i < si; i++ {
+			if offset, err = (*s.Call).Args[i].decodeBinenc(data, offset, opts, depth+1); err != nil {
+				return offset, binenc.FieldError(err, "Expr", int64(offset), "Call.Args[%d]", i)
+			}
+		}
+	} else {
+		s.Call = nil
+	}
+	return offset, nil
+}
+
+func (s *Call) sizeBinenc() int {
+	size := 4
+	size += len(s.Func)
+	for _, v := range s.Args {
+		size += 5
+		if v.Call != nil {
+			size += (*v.Call).sizeBinenc()
+		}
+	}
+	return size
+}
+
+func (s *Call) writeBinenc(buf []byte, offset int) (_ int, err error) {
+	if uint64(len(s.Func)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Func))
+	}
+	buf[offset] = byte(len(s.Func))
+	buf[offset+1] = byte(len(s.Func) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Func)
+	offset += len(s.Func)
+	if uint64(len(s.Args)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Args))
+	}
+	buf[offset] = byte(len(s.Args))
+	buf[offset+1] = byte(len(s.Args) >> 8)
+	offset += 2
+	for _, v := range s.Args {
+		buf[offset] = byte(uint32(v.Value))
+		buf[offset+1] = byte(uint32(v.Value) >> 8)
+		buf[offset+2] = byte(uint32(v.Value) >> 16)
+		buf[offset+3] = byte(uint32(v.Value) >> 24)
+		offset += 4
+		if v.Call != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if v.Call != nil {
+			offset, err = (*v.Call).writeBinenc(buf, offset)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	return offset, nil
+}
+
+func (s *Call) readBinenc(d *binenc.Decoder, n int64, depth int) (_ int64, err error) {
+	if limit := d.Options().MaxDepth; limit > 0 && depth > limit {
+		return n, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Call", n, "Func")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Call", n, "Func")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Call", n, "Func")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Call", n, "Func")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Call", n, "Func")
+	}
+	n += int64(size)
+	s.Func = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Call", n, "Args")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Call", n, "Args")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Call", n, "Args")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Call", n, "Args")
+	}
+	s.Args = make([]Expr, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Call", n, "Args[%d].Value", i)
+		}
+		n += 4
+		s.Args[i].Value = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Call", n, "Args[%d].Call", i)
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			s.Args[i].Call = new(Call)
+			if n, err = (*s.Args[i].Call).readBinenc(d, n, depth+1); err != nil {
+				return n, binenc.FieldError(err, "Call", n, "Args[%d].Call", i)
+			}
+		} else {
+			s.Args[i].Call = nil
+		}
+	}
+	return n, nil
+}
+
+func (s *Call) decodeBinenc(data []byte, offset int, opts binenc.DecodeOptions, depth int) (_ int, err error) {
+	if limit := opts.MaxDepth; limit > 0 && depth > limit {
+		return offset, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Call", int64(offset), "Func")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Call", int64(offset), "Func")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Call", int64(offset), "Func")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Call", int64(offset), "Func")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Func = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Call", int64(offset), "Args")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Call", int64(offset), "Args")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Call", int64(offset), "Args")
+	}
+	s.Args = make([]Expr, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 5 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Call", int64(offset), "Args[%d].Value", i)
+		}
+		buf = data[offset : offset+4]
+		offset += 4
+		s.Args[i].Value = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			s.Args[i].Call = new(Call)
+			if offset, err = (*s.Args[i].Call).decodeBinenc(data, offset, opts, depth+1); err != nil {
+				return offset, binenc.FieldError(err, "Call", int64(offset), "Args[%d].Call", i)
+			}
+		} else {
+			s.Args[i].Call = nil
+		}
+	}
+	return offset, nil
+}
+
+func (s *Tree) sizeBinenc() int {
+	size := 2
+	for i1 := range *s {
+		size += (*s)[i1].sizeBinenc()
+	}
+	return size
+}
+
+func (s *Tree) writeBinenc(buf []byte, offset int) (_ int, err error) {
+	if uint64(len((*s))) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s)))
+	}
+	buf[offset] = byte(len((*s)))
+	buf[offset+1] = byte(len((*s)) >> 8)
+	offset += 2
+	for i1 := range *s {
+		offset, err = (*s)[i1].writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return offset, nil
+}
+
+func (s *Tree) readBinenc(d *binenc.Decoder, n int64, depth int) (_ int64, err error) {
+	if limit := d.Options().MaxDepth; limit > 0 && depth > limit {
+		return n, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Tree", n, "")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Tree", n, "")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Tree", n, "")
+	}
+	if size > binenc.MaxAlloc/24 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Tree", n, "")
+	}
+	(*s) = make([]Tree, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if n, err = (*s)[i].readBinenc(d, n, depth+1); err != nil {
+			return n, binenc.FieldError(err, "Tree", n, "[%d]", i)
+		}
+	}
+	return n, nil
+}
+
+func (s *Tree) decodeBinenc(data []byte, offset int, opts binenc.DecodeOptions, depth int) (_ int, err error) {
+	if limit := opts.MaxDepth; limit > 0 && depth > limit {
+		return offset, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	var buf []byte
+	var size int
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Tree", int64(offset), "")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Tree", int64(offset), "")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Tree", int64(offset), "")
+	}
+	(*s) = make([]Tree, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if offset, err = (*s)[i].decodeBinenc(data, offset, opts, depth+1); err != nil {
+			return offset, binenc.FieldError(err, "Tree", int64(offset), "[%d]", i)
+		}
+	}
+	return offset, nil
+}
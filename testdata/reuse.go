@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type Meta struct {
+	Source string
+	Flags  []bool
+}
+
+//go:generate go-binenc-gen -reuse -zerocopy reuse.go
+type Frame struct {
+	Seq     uint64
+	Payload []byte
+	Samples []int32
+	Meta    *Meta
+	Labels  map[string]uint8
+	Grid    [2][]uint16
+	Name    string
+}
+
+func main() {
+	a := &Frame{
+		Seq:     1,
+		Payload: []byte("payload"),
+		Samples: []int32{1, -2, 3, -4},
+		Meta:    &Meta{Source: "a", Flags: []bool{true, false}},
+		Labels:  map[string]uint8{"x": 1, "y": 2},
+		Grid:    [2][]uint16{{1, 2}, {3}},
+		Name:    "a",
+	}
+	b := &Frame{
+		Seq:     2,
+		Samples: []int32{5},
+		Meta:    &Meta{Source: "b"},
+		Labels:  map[string]uint8{"z": 3},
+		Grid:    [2][]uint16{nil, {4, 5}},
+	}
+	dataA, _ := a.MarshalBinary()
+	dataB, _ := b.MarshalBinary()
+
+	o := new(Frame)
+	if err := o.UnmarshalBinary(dataA); err != nil {
+		panic("reuse.go: " + err.Error())
+	}
+	samples, meta := &o.Samples[0], o.Meta
+
+	// decoding a smaller value leaves nothing of the previous one
+	if _, err := o.ReadFrom(bytes.NewReader(dataB)); err != nil {
+		panic("reuse.go: " + err.Error())
+	}
+	if diff := cmp.Diff(b, o, cmpopts.EquateEmpty()); diff != "" {
+		panic("reuse.go: \n" + diff)
+	}
+	// but reuses its memory
+	if &o.Samples[0] != samples || o.Meta != meta {
+		panic("reuse.go: slice or pointer not reused")
+	}
+
+	// decoding into the same value stops allocating
+	if err := o.UnmarshalBinary(dataA); err != nil {
+		panic("reuse.go: " + err.Error())
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		if err := o.UnmarshalBinary(dataA); err != nil {
+			panic("reuse.go: " + err.Error())
+		}
+	})
+	if allocs != 0 {
+		panic(fmt.Sprintf("reuse.go: UnmarshalBinary allocates %v times", allocs))
+	}
+	if diff := cmp.Diff(a, o); diff != "" {
+		panic("reuse.go: \n" + diff)
+	}
+
+	// Reset keeps the capacity of the slices, and encodes as the zero value
+	o.Reset()
+	if diff := cmp.Diff(&Frame{}, o, cmpopts.EquateEmpty()); diff != "" {
+		panic("reuse.go: \n" + diff)
+	}
+	if cap(o.Samples) != len(a.Samples) || cap(o.Grid[0]) != len(a.Grid[0]) || o.Labels == nil {
+		panic("reuse.go: Reset drops memory")
+	}
+	zero, _ := new(Frame).MarshalBinary()
+	data, _ := o.MarshalBinary()
+	if !bytes.Equal(zero, data) {
+		panic("reuse.go: Reset value does not encode as the zero value")
+	}
+}
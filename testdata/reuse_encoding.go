@@ -0,0 +1,1001 @@
+// Code generated by "gobinenc -reuse -zerocopy reuse.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Meta) WriteTo(w io.Writer) (n int64, err error) {
+	size := 4
+	size += len(s.Source) + 1*len(s.Flags)
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Source)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Source))
+	}
+	buf[offset] = byte(len(s.Source))
+	buf[offset+1] = byte(len(s.Source) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Source)
+	offset += len(s.Source)
+	if uint64(len(s.Flags)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Flags))
+	}
+	buf[offset] = byte(len(s.Flags))
+	buf[offset+1] = byte(len(s.Flags) >> 8)
+	offset += 2
+	for _, v := range s.Flags {
+		if v {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Meta) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Meta) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Meta) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Meta", n, "Source")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Meta", n, "Source")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Meta", n, "Source")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Meta", n, "Source")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Meta", n, "Source")
+	}
+	n += int64(size)
+	s.Source = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Meta", n, "Flags")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Meta", n, "Flags")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Meta", n, "Flags")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Meta", n, "Flags")
+	}
+	if cap(s.Flags) < size {
+		s.Flags = make([]bool, size)
+	} else {
+		s.Flags = s.Flags[:size]
+	}
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Meta", n, "Flags[%d]", i)
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			s.Flags[i] = true
+		} else {
+			s.Flags[i] = false
+		}
+	}
+	return n, nil
+}
+
+func (s *Meta) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 4
+	size += len(s.Source) + 1*len(s.Flags)
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Source)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Source))
+	}
+	buf[offset] = byte(len(s.Source))
+	buf[offset+1] = byte(len(s.Source) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Source)
+	offset += len(s.Source)
+	if uint64(len(s.Flags)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Flags))
+	}
+	buf[offset] = byte(len(s.Flags))
+	buf[offset+1] = byte(len(s.Flags) >> 8)
+	offset += 2
+	for _, v := range s.Flags {
+		if v {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+	}
+	return buf, nil
+}
+
+func (s *Meta) EncodedSize() int {
+	size := 4
+	size += len(s.Source) + 1*len(s.Flags)
+	return size
+}
+
+func (s *Meta) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Meta) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Meta) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Meta", int64(offset), "Source")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Meta", int64(offset), "Source")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Meta", int64(offset), "Source")
+	}
+	tmp = data[offset : offset+size]
+	s.Source = *(*string)(unsafe.Pointer(&tmp))
+	offset += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Meta", int64(offset), "Flags")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Meta", int64(offset), "Flags")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Meta", int64(offset), "Flags")
+	}
+	if cap(s.Flags) < size {
+		s.Flags = make([]bool, size)
+	} else {
+		s.Flags = s.Flags[:size]
+	}
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Meta", int64(offset), "Flags[%d]", i)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			s.Flags[i] = true
+		} else {
+			s.Flags[i] = false
+		}
+	}
+	return offset, nil
+}
+
+func (s *Meta) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Meta) Reset() {
+	s.Source = ""
+	s.Flags = s.Flags[:0]
+}
+
+func (s *Frame) WriteTo(w io.Writer) (n int64, err error) {
+	size := 17
+	size += len(s.Payload) + 4*len(s.Samples) + len(s.Name)
+	if s.Meta != nil {
+		size += 4
+		size += len((*s.Meta).Source) + 1*len((*s.Meta).Flags)
+	}
+	for k := range s.Labels {
+		size += 3
+		size += len(k)
+	}
+	for i1 := 0; i1 < 2; i1++ {
+		size += 2
+		size += 2 * len(s.Grid[i1])
+	}
+	buf := make([]byte, size)
+	offset := 0
+	buf[offset] = byte(s.Seq)
+	buf[offset+1] = byte(s.Seq >> 8)
+	buf[offset+2] = byte(s.Seq >> 16)
+	buf[offset+3] = byte(s.Seq >> 24)
+	buf[offset+4] = byte(s.Seq >> 32)
+	buf[offset+5] = byte(s.Seq >> 40)
+	buf[offset+6] = byte(s.Seq >> 48)
+	buf[offset+7] = byte(s.Seq >> 56)
+	offset += 8
+	if uint64(len(s.Payload)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Payload))
+	}
+	buf[offset] = byte(len(s.Payload))
+	buf[offset+1] = byte(len(s.Payload) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Payload)
+	offset += len(s.Payload)
+	if uint64(len(s.Samples)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Samples))
+	}
+	buf[offset] = byte(len(s.Samples))
+	buf[offset+1] = byte(len(s.Samples) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Samples) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Samples[0])), 4*len(s.Samples)))
+		offset += 4 * len(s.Samples)
+	} else {
+		for _, v := range s.Samples {
+			buf[offset] = byte(uint32(v))
+			buf[offset+1] = byte(uint32(v) >> 8)
+			buf[offset+2] = byte(uint32(v) >> 16)
+			buf[offset+3] = byte(uint32(v) >> 24)
+			offset += 4
+		}
+	}
+	if s.Meta != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Meta != nil {
+		if uint64(len((*s.Meta).Source)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Meta).Source))
+		}
+		buf[offset] = byte(len((*s.Meta).Source))
+		buf[offset+1] = byte(len((*s.Meta).Source) >> 8)
+		offset += 2
+		copy(buf[offset:], (*s.Meta).Source)
+		offset += len((*s.Meta).Source)
+		if uint64(len((*s.Meta).Flags)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Meta).Flags))
+		}
+		buf[offset] = byte(len((*s.Meta).Flags))
+		buf[offset+1] = byte(len((*s.Meta).Flags) >> 8)
+		offset += 2
+		for _, v1 := range (*s.Meta).Flags {
+			if v1 {
+				buf[offset] = byte(0x01)
+			} else {
+				buf[offset] = byte(0x00)
+			}
+			offset += 1
+		}
+	}
+	if uint64(len(s.Labels)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Labels))
+	}
+	buf[offset] = byte(len(s.Labels))
+	buf[offset+1] = byte(len(s.Labels) >> 8)
+	offset += 2
+	for k, v := range s.Labels {
+		if uint64(len(k)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		buf[offset] = byte(v)
+		offset += 1
+	}
+	for i1 := 0; i1 < 2; i1++ {
+		if uint64(len(s.Grid[i1])) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Grid[i1]))
+		}
+		buf[offset] = byte(len(s.Grid[i1]))
+		buf[offset+1] = byte(len(s.Grid[i1]) >> 8)
+		offset += 2
+		if binenc.LittleEndian && len(s.Grid[i1]) > 0 {
+			copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Grid[i1][0])), 2*len(s.Grid[i1])))
+			offset += 2 * len(s.Grid[i1])
+		} else {
+			for _, v1 := range s.Grid[i1] {
+				buf[offset] = byte(v1)
+				buf[offset+1] = byte(v1 >> 8)
+				offset += 2
+			}
+		}
+	}
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Frame) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Frame) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Frame) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Frame", n, "Seq")
+	}
+	n += 8
+	s.Seq = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Frame", n, "Payload")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Frame", n, "Payload")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Frame", n, "Payload")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Frame", n, "Payload")
+	}
+	if cap(s.Payload) < size {
+		s.Payload = make([]byte, size)
+	} else {
+		s.Payload = s.Payload[:size]
+	}
+	if nr, err := io.ReadFull(r, s.Payload); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Frame", n, "Payload")
+	}
+	n += int64(size)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Frame", n, "Samples")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Frame", n, "Samples")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Frame", n, "Samples")
+	}
+	if size > binenc.MaxAlloc/4 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Frame", n, "Samples")
+	}
+	if cap(s.Samples) < size {
+		s.Samples = make([]int32, size)
+	} else {
+		s.Samples = s.Samples[:size]
+	}
+	if binenc.LittleEndian && size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Samples[0])), 4*size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Frame", n, "Samples")
+		}
+		n += int64(4 * size)
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Frame", n, "Samples[%d]", i)
+			}
+			n += 4
+			s.Samples[i] = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Frame", n, "Meta")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		if s.Meta == nil {
+			s.Meta = new(Meta)
+		}
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Frame", n, "Meta.Source")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Frame", n, "Meta.Source")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Frame", n, "Meta.Source")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Frame", n, "Meta.Source")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Frame", n, "Meta.Source")
+		}
+		n += int64(size)
+		(*s.Meta).Source = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Frame", n, "Meta.Flags")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Frame", n, "Meta.Flags")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Frame", n, "Meta.Flags")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Frame", n, "Meta.Flags")
+		}
+		if cap((*s.Meta).Flags) < size {
+			(*s.Meta).Flags = make([]bool, size)
+		} else {
+			(*s.Meta).Flags = (*s.Meta).Flags[:size]
+		}
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Frame", n, "Meta.Flags[%d]", i1)
+			}
+			n += 1
+			if buf[0] == byte(0x01) {
+				(*s.Meta).Flags[i1] = true
+			} else {
+				(*s.Meta).Flags[i1] = false
+			}
+		}
+	} else {
+		s.Meta = nil
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Frame", n, "Labels")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Frame", n, "Labels")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Frame", n, "Labels")
+	}
+	if s.Labels == nil {
+		s.Labels = make(map[string]uint8, binenc.MapHint(size))
+	} else {
+		for k2 := range s.Labels {
+			delete(s.Labels, k2)
+		}
+	}
+	si2 := size
+	for i2 := 0; i2 < si2; i2++ {
+		var k2 string
+		var v2 uint8
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Frame", n, "Labels")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Frame", n, "Labels")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Frame", n, "Labels")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Frame", n, "Labels")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Frame", n, "Labels")
+		}
+		n += int64(size)
+		k2 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Frame", n, "Labels[%q]", k2)
+		}
+		n += 1
+		v2 = uint8(buf[0])
+		s.Labels[k2] = v2
+	}
+	for i3 := 0; i3 < 2; i3++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Frame", n, "Grid[%d]", i3)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Frame", n, "Grid[%d]", i3)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Frame", n, "Grid[%d]", i3)
+		}
+		if size > binenc.MaxAlloc/2 {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Frame", n, "Grid[%d]", i3)
+		}
+		if cap(s.Grid[i3]) < size {
+			s.Grid[i3] = make([]uint16, size)
+		} else {
+			s.Grid[i3] = s.Grid[i3][:size]
+		}
+		if binenc.LittleEndian && size > 0 {
+			if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Grid[i3][0])), 2*size)); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Frame", n, "Grid[%d]", i3)
+			}
+			n += int64(2 * size)
+		} else {
+			si4 := size
+			for i4 := 0; i4 < si4; i4++ {
+				if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+					return n + int64(nr), binenc.FieldError(err, "Frame", n, "Grid[%d][%d]", i3, i4)
+				}
+				n += 2
+				s.Grid[i3][i4] = uint16(buf[0]) | (uint16(buf[1]) << 8)
+			}
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Frame", n, "Name")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Frame", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Frame", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Frame", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Frame", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	return n, nil
+}
+
+func (s *Frame) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 17
+	size += len(s.Payload) + 4*len(s.Samples) + len(s.Name)
+	if s.Meta != nil {
+		size += 4
+		size += len((*s.Meta).Source) + 1*len((*s.Meta).Flags)
+	}
+	for k := range s.Labels {
+		size += 3
+		size += len(k)
+	}
+	for i1 := 0; i1 < 2; i1++ {
+		size += 2
+		size += 2 * len(s.Grid[i1])
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(s.Seq)
+	buf[offset+1] = byte(s.Seq >> 8)
+	buf[offset+2] = byte(s.Seq >> 16)
+	buf[offset+3] = byte(s.Seq >> 24)
+	buf[offset+4] = byte(s.Seq >> 32)
+	buf[offset+5] = byte(s.Seq >> 40)
+	buf[offset+6] = byte(s.Seq >> 48)
+	buf[offset+7] = byte(s.Seq >> 56)
+	offset += 8
+	if uint64(len(s.Payload)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Payload))
+	}
+	buf[offset] = byte(len(s.Payload))
+	buf[offset+1] = byte(len(s.Payload) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Payload)
+	offset += len(s.Payload)
+	if uint64(len(s.Samples)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Samples))
+	}
+	buf[offset] = byte(len(s.Samples))
+	buf[offset+1] = byte(len(s.Samples) >> 8)
+	offset += 2
+	if binenc.LittleEndian && len(s.Samples) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Samples[0])), 4*len(s.Samples)))
+		offset += 4 * len(s.Samples)
+	} else {
+		for _, v := range s.Samples {
+			buf[offset] = byte(uint32(v))
+			buf[offset+1] = byte(uint32(v) >> 8)
+			buf[offset+2] = byte(uint32(v) >> 16)
+			buf[offset+3] = byte(uint32(v) >> 24)
+			offset += 4
+		}
+	}
+	if s.Meta != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Meta != nil {
+		if uint64(len((*s.Meta).Source)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Meta).Source))
+		}
+		buf[offset] = byte(len((*s.Meta).Source))
+		buf[offset+1] = byte(len((*s.Meta).Source) >> 8)
+		offset += 2
+		copy(buf[offset:], (*s.Meta).Source)
+		offset += len((*s.Meta).Source)
+		if uint64(len((*s.Meta).Flags)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len((*s.Meta).Flags))
+		}
+		buf[offset] = byte(len((*s.Meta).Flags))
+		buf[offset+1] = byte(len((*s.Meta).Flags) >> 8)
+		offset += 2
+		for _, v1 := range (*s.Meta).Flags {
+			if v1 {
+				buf[offset] = byte(0x01)
+			} else {
+				buf[offset] = byte(0x00)
+			}
+			offset += 1
+		}
+	}
+	if uint64(len(s.Labels)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Labels))
+	}
+	buf[offset] = byte(len(s.Labels))
+	buf[offset+1] = byte(len(s.Labels) >> 8)
+	offset += 2
+	for k, v := range s.Labels {
+		if uint64(len(k)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		buf[offset] = byte(v)
+		offset += 1
+	}
+	for i1 := 0; i1 < 2; i1++ {
+		if uint64(len(s.Grid[i1])) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Grid[i1]))
+		}
+		buf[offset] = byte(len(s.Grid[i1]))
+		buf[offset+1] = byte(len(s.Grid[i1]) >> 8)
+		offset += 2
+		if binenc.LittleEndian && len(s.Grid[i1]) > 0 {
+			copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Grid[i1][0])), 2*len(s.Grid[i1])))
+			offset += 2 * len(s.Grid[i1])
+		} else {
+			for _, v1 := range s.Grid[i1] {
+				buf[offset] = byte(v1)
+				buf[offset+1] = byte(v1 >> 8)
+				offset += 2
+			}
+		}
+	}
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	return buf, nil
+}
+
+func (s *Frame) EncodedSize() int {
+	size := 17
+	size += len(s.Payload) + 4*len(s.Samples) + len(s.Name)
+	if s.Meta != nil {
+		size += 4
+		size += len((*s.Meta).Source) + 1*len((*s.Meta).Flags)
+	}
+	for k := range s.Labels {
+		size += 3
+		size += len(k)
+	}
+	for i1 := 0; i1 < 2; i1++ {
+		size += 2
+		size += 2 * len(s.Grid[i1])
+	}
+	return size
+}
+
+func (s *Frame) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Frame) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Frame) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	if len(data)-offset < 10 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Seq")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	s.Seq = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Frame", int64(offset), "Payload")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Payload")
+	}
+	s.Payload = data[offset : offset+size : offset+size]
+	offset += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Samples")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Frame", int64(offset), "Samples")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Samples")
+	}
+	if cap(s.Samples) < size {
+		s.Samples = make([]int32, size)
+	} else {
+		s.Samples = s.Samples[:size]
+	}
+	if binenc.LittleEndian && size > 0 {
+		if len(data)-offset < 4*size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Samples")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Samples[0])), 4*size), data[offset:])
+	} else {
+		si := size
+		for i := 0; i < si; i++ {
+			if len(data)-offset < 4 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Samples[%d]", i)
+			}
+			buf = data[offset : offset+4]
+			offset += 4
+			s.Samples[i] = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+		}
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Meta")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		if s.Meta == nil {
+			s.Meta = new(Meta)
+		}
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Meta.Source")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Frame", int64(offset), "Meta.Source")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Meta.Source")
+		}
+		tmp = data[offset : offset+size]
+		(*s.Meta).Source = *(*string)(unsafe.Pointer(&tmp))
+		offset += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Meta.Flags")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Frame", int64(offset), "Meta.Flags")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Meta.Flags")
+		}
+		if cap((*s.Meta).Flags) < size {
+			(*s.Meta).Flags = make([]bool, size)
+		} else {
+			(*s.Meta).Flags = (*s.Meta).Flags[:size]
+		}
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if len(data)-offset < 1 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Meta.Flags[%d]", i1)
+			}
+			buf = data[offset : offset+1]
+			offset += 1
+			if buf[0] == byte(0x01) {
+				(*s.Meta).Flags[i1] = true
+			} else {
+				(*s.Meta).Flags[i1] = false
+			}
+		}
+	} else {
+		s.Meta = nil
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Labels")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Frame", int64(offset), "Labels")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Labels")
+	}
+	if s.Labels == nil {
+		s.Labels = make(map[string]uint8, size)
+	} else {
+		for k2 := range s.Labels {
+			delete(s.Labels, k2)
+		}
+	}
+	si2 := size
+	for i2 := 0; i2 < si2; i2++ {
+		var k2 string
+		var v2 uint8
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Labels")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Frame", int64(offset), "Labels")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Labels")
+		}
+		tmp = data[offset : offset+size]
+		k2 = *(*string)(unsafe.Pointer(&tmp))
+		offset += size
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Labels[%q]", k2)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		v2 = uint8(buf[0])
+		s.Labels[k2] = v2
+	}
+	for i3 := 0; i3 < 2; i3++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Grid[%d]", i3)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Frame", int64(offset), "Grid[%d]", i3)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Grid[%d]", i3)
+		}
+		if cap(s.Grid[i3]) < size {
+			s.Grid[i3] = make([]uint16, size)
+		} else {
+			s.Grid[i3] = s.Grid[i3][:size]
+		}
+		if binenc.LittleEndian && size > 0 {
+			if len(data)-offset < 2*size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Grid[%d]", i3)
+			}
+			offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Grid[i3][0])), 2*size), data[offset:])
+		} else {
+			si4 := size
+			for i4 := 0; i4 < si4; i4++ {
+				if len(data)-offset < 2 {
+					return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Grid[%d][%d]", i3, i4)
+				}
+				buf = data[offset : offset+2]
+				offset += 2
+				s.Grid[i3][i4] = uint16(buf[0]) | (uint16(buf[1]) << 8)
+			}
+		}
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Name")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Frame", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Frame", int64(offset), "Name")
+	}
+	tmp = data[offset : offset+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	offset += size
+	return offset, nil
+}
+
+func (s *Frame) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Frame) Reset() {
+	s.Seq = 0
+	s.Payload = s.Payload[:0]
+	s.Samples = s.Samples[:0]
+	s.Meta = nil
+	for k := range s.Labels {
+		delete(s.Labels, k)
+	}
+	for i := range s.Grid {
+		s.Grid[i] = s.Grid[i][:0]
+	}
+	s.Name = ""
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+//go:generate go-binenc-gen -safe safe.go
+type Safe struct {
+	Name   string
+	Ratio  float32
+	Deltas []int16
+	Small  [2]int8
+	Raw    []byte
+	Labels map[string]string
+	Point  complex64
+}
+
+func main() {
+	s := &Safe{
+		Name:   "ab",
+		Ratio:  1,
+		Deltas: []int16{-1, 0x0102},
+		Small:  [2]int8{-2, 3},
+		Raw:    []byte{9},
+		Labels: map[string]string{"k": "v"},
+		Point:  complex(0, 1),
+	}
+
+	var buf bytes.Buffer
+	if _, err := s.WriteTo(&buf); err != nil {
+		panic("safe.go: " + err.Error())
+	}
+	// the encoding is the same as without -safe
+	want := []byte{
+		0x02, 0x00, 'a', 'b', // Name
+		0x00, 0x00, 0x80, 0x3f, // Ratio
+		0x02, 0x00, 0xff, 0xff, 0x02, 0x01, // Deltas
+		0xfe, 0x03, // Small
+		0x01, 0x00, 0x09, // Raw
+		0x01, 0x00, 0x01, 0x00, 'k', 0x01, 0x00, 'v', // Labels
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x3f, // Point
+	}
+	if diff := cmp.Diff(want, buf.Bytes()); diff != "" {
+		panic("safe.go: \n" + diff)
+	}
+
+	o := new(Safe)
+	if _, err := o.ReadFrom(&buf); err != nil {
+		panic("safe.go: " + err.Error())
+	}
+	if diff := cmp.Diff(s, o); diff != "" {
+		panic("safe.go: \n" + diff)
+	}
+
+	o = new(Safe)
+	if err := o.UnmarshalBinary(want); err != nil {
+		panic("safe.go: " + err.Error())
+	}
+	if diff := cmp.Diff(s, o); diff != "" {
+		panic("safe.go: \n" + diff)
+	}
+}
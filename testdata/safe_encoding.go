@@ -0,0 +1,496 @@
+// Code generated by "gobinenc -safe safe.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Safe) WriteTo(w io.Writer) (n int64, err error) {
+	size := 22
+	size += len(s.Name) + 2*len(s.Deltas) + len(s.Raw)
+	for k, v := range s.Labels {
+		size += 4
+		size += len(k) + len(v)
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	buf[offset] = byte(math.Float32bits(s.Ratio))
+	buf[offset+1] = byte(math.Float32bits(s.Ratio) >> 8)
+	buf[offset+2] = byte(math.Float32bits(s.Ratio) >> 16)
+	buf[offset+3] = byte(math.Float32bits(s.Ratio) >> 24)
+	offset += 4
+	if uint64(len(s.Deltas)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Deltas))
+	}
+	buf[offset] = byte(len(s.Deltas))
+	buf[offset+1] = byte(len(s.Deltas) >> 8)
+	offset += 2
+	for _, v := range s.Deltas {
+		buf[offset] = byte(uint16(v))
+		buf[offset+1] = byte(uint16(v) >> 8)
+		offset += 2
+	}
+	for i1 := 0; i1 < 2; i1++ {
+		buf[offset] = byte(uint8(s.Small[i1]))
+		offset += 1
+	}
+	if uint64(len(s.Raw)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Raw))
+	}
+	buf[offset] = byte(len(s.Raw))
+	buf[offset+1] = byte(len(s.Raw) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Raw)
+	offset += len(s.Raw)
+	if uint64(len(s.Labels)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Labels))
+	}
+	buf[offset] = byte(len(s.Labels))
+	buf[offset+1] = byte(len(s.Labels) >> 8)
+	offset += 2
+	for k, v := range s.Labels {
+		if uint64(len(k)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if uint64(len(v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	buf[offset] = byte(math.Float32bits(real(s.Point)))
+	buf[offset+1] = byte(math.Float32bits(real(s.Point)) >> 8)
+	buf[offset+2] = byte(math.Float32bits(real(s.Point)) >> 16)
+	buf[offset+3] = byte(math.Float32bits(real(s.Point)) >> 24)
+	offset += 4
+	buf[offset] = byte(math.Float32bits(imag(s.Point)))
+	buf[offset+1] = byte(math.Float32bits(imag(s.Point)) >> 8)
+	buf[offset+2] = byte(math.Float32bits(imag(s.Point)) >> 16)
+	buf[offset+3] = byte(math.Float32bits(imag(s.Point)) >> 24)
+	offset += 4
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Safe) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Safe) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Safe) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Safe", n, "Name")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Safe", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Safe", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Safe", n, "Name")
+	}
+	tmp = d.Scratch(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Safe", n, "Name")
+	}
+	n += int64(size)
+	s.Name = string(tmp)
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Safe", n, "Ratio")
+	}
+	n += 4
+	s.Ratio = math.Float32frombits(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Safe", n, "Deltas")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Safe", n, "Deltas")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Safe", n, "Deltas")
+	}
+	if size > binenc.MaxAlloc/2 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Safe", n, "Deltas")
+	}
+	s.Deltas = make([]int16, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Safe", n, "Deltas[%d]", i)
+		}
+		n += 2
+		s.Deltas[i] = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	}
+	for i1 := 0; i1 < 2; i1++ {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Safe", n, "Small[%d]", i1)
+		}
+		n += 1
+		s.Small[i1] = int8(uint8(buf[0]))
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Safe", n, "Raw")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Safe", n, "Raw")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Safe", n, "Raw")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Safe", n, "Raw")
+	}
+	s.Raw = make([]byte, size)
+	if nr, err := io.ReadFull(r, s.Raw); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Safe", n, "Raw")
+	}
+	n += int64(size)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Safe", n, "Labels")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Safe", n, "Labels")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Safe", n, "Labels")
+	}
+	s.Labels = make(map[string]string, binenc.MapHint(size))
+	si2 := size
+	for i2 := 0; i2 < si2; i2++ {
+		var k2 string
+		var v2 string
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Safe", n, "Labels")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Safe", n, "Labels")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Safe", n, "Labels")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Safe", n, "Labels")
+		}
+		tmp = d.Scratch(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Safe", n, "Labels")
+		}
+		n += int64(size)
+		k2 = string(tmp)
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Safe", n, "Labels[%q]", k2)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Safe", n, "Labels[%q]", k2)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Safe", n, "Labels[%q]", k2)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Safe", n, "Labels[%q]", k2)
+		}
+		tmp = d.Scratch(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Safe", n, "Labels[%q]", k2)
+		}
+		n += int64(size)
+		v2 = string(tmp)
+		s.Labels[k2] = v2
+	}
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Safe", n, "Point")
+	}
+	n += 4
+	s.Point = complex(math.Float32frombits(uint32(buf[0])|(uint32(buf[1])<<8)|(uint32(buf[2])<<16)|(uint32(buf[3])<<24)), 0)
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Safe", n, "Point")
+	}
+	n += 4
+	s.Point = complex(real(s.Point), math.Float32frombits(uint32(buf[0])|(uint32(buf[1])<<8)|(uint32(buf[2])<<16)|(uint32(buf[3])<<24)))
+	return n, nil
+}
+
+func (s *Safe) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 22
+	size += len(s.Name) + 2*len(s.Deltas) + len(s.Raw)
+	for k, v := range s.Labels {
+		size += 4
+		size += len(k) + len(v)
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	buf[offset] = byte(math.Float32bits(s.Ratio))
+	buf[offset+1] = byte(math.Float32bits(s.Ratio) >> 8)
+	buf[offset+2] = byte(math.Float32bits(s.Ratio) >> 16)
+	buf[offset+3] = byte(math.Float32bits(s.Ratio) >> 24)
+	offset += 4
+	if uint64(len(s.Deltas)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Deltas))
+	}
+	buf[offset] = byte(len(s.Deltas))
+	buf[offset+1] = byte(len(s.Deltas) >> 8)
+	offset += 2
+	for _, v := range s.Deltas {
+		buf[offset] = byte(uint16(v))
+		buf[offset+1] = byte(uint16(v) >> 8)
+		offset += 2
+	}
+	for i1 := 0; i1 < 2; i1++ {
+		buf[offset] = byte(uint8(s.Small[i1]))
+		offset += 1
+	}
+	if uint64(len(s.Raw)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Raw))
+	}
+	buf[offset] = byte(len(s.Raw))
+	buf[offset+1] = byte(len(s.Raw) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Raw)
+	offset += len(s.Raw)
+	if uint64(len(s.Labels)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Labels))
+	}
+	buf[offset] = byte(len(s.Labels))
+	buf[offset+1] = byte(len(s.Labels) >> 8)
+	offset += 2
+	for k, v := range s.Labels {
+		if uint64(len(k)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if uint64(len(v)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	buf[offset] = byte(math.Float32bits(real(s.Point)))
+	buf[offset+1] = byte(math.Float32bits(real(s.Point)) >> 8)
+	buf[offset+2] = byte(math.Float32bits(real(s.Point)) >> 16)
+	buf[offset+3] = byte(math.Float32bits(real(s.Point)) >> 24)
+	offset += 4
+	buf[offset] = byte(math.Float32bits(imag(s.Point)))
+	buf[offset+1] = byte(math.Float32bits(imag(s.Point)) >> 8)
+	buf[offset+2] = byte(math.Float32bits(imag(s.Point)) >> 16)
+	buf[offset+3] = byte(math.Float32bits(imag(s.Point)) >> 24)
+	offset += 4
+	return buf, nil
+}
+
+func (s *Safe) EncodedSize() int {
+	size := 22
+	size += len(s.Name) + 2*len(s.Deltas) + len(s.Raw)
+	for k, v := range s.Labels {
+		size += 4
+		size += len(k) + len(v)
+	}
+	return size
+}
+
+func (s *Safe) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Safe) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Safe) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Name")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Safe", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Name")
+	}
+	s.Name = string(data[offset : offset+size])
+	offset += size
+	if len(data)-offset < 6 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Ratio")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Ratio = math.Float32frombits(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Safe", int64(offset), "Deltas")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Deltas")
+	}
+	s.Deltas = make([]int16, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Deltas[%d]", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		s.Deltas[i] = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	}
+	for i1 := 0; i1 < 2; i1++ {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Small[%d]", i1)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		s.Small[i1] = int8(uint8(buf[0]))
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Raw")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Safe", int64(offset), "Raw")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Raw")
+	}
+	s.Raw = make([]byte, size)
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Raw")
+	}
+	offset += copy(s.Raw, data[offset:])
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Labels")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Safe", int64(offset), "Labels")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Labels")
+	}
+	s.Labels = make(map[string]string, size)
+	si2 := size
+	for i2 := 0; i2 < si2; i2++ {
+		var k2 string
+		var v2 string
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Labels")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Safe", int64(offset), "Labels")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Labels")
+		}
+		k2 = string(data[offset : offset+size])
+		offset += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Labels[%q]", k2)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Safe", int64(offset), "Labels[%q]", k2)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Labels[%q]", k2)
+		}
+		v2 = string(data[offset : offset+size])
+		offset += size
+		s.Labels[k2] = v2
+	}
+	if len(data)-offset < 8 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Safe", int64(offset), "Point")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Point = complex(math.Float32frombits(uint32(buf[0])|(uint32(buf[1])<<8)|(uint32(buf[2])<<16)|(uint32(buf[3])<<24)), 0)
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Point = complex(real(s.Point), math.Float32frombits(uint32(buf[0])|(uint32(buf[1])<<8)|(uint32(buf[2])<<16)|(uint32(buf[3])<<24)))
+	return offset, nil
+}
+
+func (s *Safe) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
@@ -9,11 +9,14 @@ import (
 //go:generate go-binenc-gen slice.go
 type Slice struct {
 	Int8Slice []int8
+	// only the length of slices of empty values is encoded
+	Marks []struct{}
 }
 
 func main() {
 	s := &Slice{
 		Int8Slice: []int8{1, 2, 3, 4},
+		Marks:     make([]struct{}, 3),
 	}
 
 	var buf bytes.Buffer
@@ -3,34 +3,181 @@
 package main
 
 import (
+	"fmt"
 	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
 )
 
-func (s *Slice) WriteTo(w io.Writer) (n int, err error) {
-	size := 2
-	size += 1 * len(s.Int8Slice)
+func (s *Slice) WriteTo(w io.Writer) (n int64, err error) {
+	size := 4
+	size += len(s.Int8Slice)
 	buf := make([]byte, size)
 	offset := 0
+	if uint64(len(s.Int8Slice)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Int8Slice))
+	}
 	buf[offset] = byte(len(s.Int8Slice))
 	buf[offset+1] = byte(len(s.Int8Slice) >> 8)
 	offset += 2
-	for _, v := range s.Int8Slice {
-		buf[offset] = byte(uint8(v))
-		offset += 1
+	if len(s.Int8Slice) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Int8Slice[0])), len(s.Int8Slice)))
 	}
-	return w.Write(buf)
+	offset += len(s.Int8Slice)
+	if uint64(len(s.Marks)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Marks))
+	}
+	buf[offset] = byte(len(s.Marks))
+	buf[offset+1] = byte(len(s.Marks) >> 8)
+	offset += 2
+	for range s.Marks {
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Slice) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
 }
 
-func (s *Slice) ReadFrom(r io.Reader) error {
-	buf := make([]byte, 8)
-	var size uint16
-	r.Read(buf[:2])
-	size = uint16(buf[0]) | (uint16(buf[1]) << 8)
+func (s *Slice) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Slice) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Slice", n, "Int8Slice")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Slice", n, "Int8Slice")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Slice", n, "Int8Slice")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Slice", n, "Int8Slice")
+	}
 	s.Int8Slice = make([]int8, size)
-	si := int(size)
+	if size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Int8Slice[0])), size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Slice", n, "Int8Slice")
+		}
+		n += int64(size)
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Slice", n, "Marks")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Slice", n, "Marks")
+	}
+	s.Marks = make([]struct{}, size)
+	si := size
 	for i := 0; i < si; i++ {
-		r.Read(buf[:1])
-		s.Int8Slice[i] = int8(uint8(buf[0]))
+	}
+	return n, nil
+}
+
+func (s *Slice) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 4
+	size += len(s.Int8Slice)
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Int8Slice)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Int8Slice))
+	}
+	buf[offset] = byte(len(s.Int8Slice))
+	buf[offset+1] = byte(len(s.Int8Slice) >> 8)
+	offset += 2
+	if len(s.Int8Slice) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Int8Slice[0])), len(s.Int8Slice)))
+	}
+	offset += len(s.Int8Slice)
+	if uint64(len(s.Marks)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Marks))
+	}
+	buf[offset] = byte(len(s.Marks))
+	buf[offset+1] = byte(len(s.Marks) >> 8)
+	offset += 2
+	for range s.Marks {
+	}
+	return buf, nil
+}
+
+func (s *Slice) EncodedSize() int {
+	size := 4
+	size += len(s.Int8Slice)
+	return size
+}
+
+func (s *Slice) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Slice) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Slice) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Slice", int64(offset), "Int8Slice")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Slice", int64(offset), "Int8Slice")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Slice", int64(offset), "Int8Slice")
+	}
+	s.Int8Slice = make([]int8, size)
+	if size > 0 {
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Slice", int64(offset), "Int8Slice")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Int8Slice[0])), size), data[offset:])
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Slice", int64(offset), "Marks")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Slice", int64(offset), "Marks")
+	}
+	s.Marks = make([]struct{}, size)
+	si := size
+	for i := 0; i < si; i++ {
+	}
+	return offset, nil
+}
+
+func (s *Slice) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
 	}
 	return nil
 }
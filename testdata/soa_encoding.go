@@ -3,102 +3,335 @@
 package main
 
 import (
+	"fmt"
 	"io"
+	"math"
 	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
 )
 
-func (s *Inner) WriteTo(w io.Writer) (n int, err error) {
+func (s *Inner) WriteTo(w io.Writer) (n int64, err error) {
 	size := 6
-	size += len(s.Str) + 1*len(s.Arr3)
+	size += len(s.Str) + len(s.Arr3)
 	for _, v := range s.Arr4 {
 		size += 2
 		size += len(v)
 	}
 	buf := make([]byte, size)
 	offset := 0
+	if uint64(len(s.Str)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Str))
+	}
 	buf[offset] = byte(len(s.Str))
 	buf[offset+1] = byte(len(s.Str) >> 8)
 	offset += 2
 	copy(buf[offset:], s.Str)
 	offset += len(s.Str)
+	if uint64(len(s.Arr3)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Arr3))
+	}
 	buf[offset] = byte(len(s.Arr3))
 	buf[offset+1] = byte(len(s.Arr3) >> 8)
 	offset += 2
-	for _, v := range s.Arr3 {
-		buf[offset] = byte(v)
-		offset += 1
+	copy(buf[offset:], s.Arr3)
+	offset += len(s.Arr3)
+	if uint64(len(s.Arr4)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Arr4))
 	}
 	buf[offset] = byte(len(s.Arr4))
 	buf[offset+1] = byte(len(s.Arr4) >> 8)
 	offset += 2
 	for _, v := range s.Arr4 {
+		if uint64(len(v)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
 		buf[offset] = byte(len(v))
 		buf[offset+1] = byte(len(v) >> 8)
 		offset += 2
 		copy(buf[offset:], v)
 		offset += len(v)
 	}
-	return w.Write(buf)
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Inner) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Inner) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
 }
 
-func (s *Inner) ReadFrom(r io.Reader) error {
-	buf := make([]byte, 8)
-	var size uint16
+func (s *Inner) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Inner", n, "Str")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Inner", n, "Str")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Inner", n, "Str")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Inner", n, "Str")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Inner", n, "Str")
+	}
+	n += int64(size)
+	s.Str = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Inner", n, "Arr3")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Inner", n, "Arr3")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Inner", n, "Arr3")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Inner", n, "Arr3")
+	}
+	s.Arr3 = make([]uint8, size)
+	if nr, err := io.ReadFull(r, s.Arr3); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Inner", n, "Arr3")
+	}
+	n += int64(size)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Inner", n, "Arr4")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Inner", n, "Arr4")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Inner", n, "Arr4")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Inner", n, "Arr4")
+	}
+	s.Arr4 = make([]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Inner", n, "Arr4[%d]", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Inner", n, "Arr4[%d]", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Inner", n, "Arr4[%d]", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Inner", n, "Arr4[%d]", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Inner", n, "Arr4[%d]", i)
+		}
+		n += int64(size)
+		s.Arr4[i] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	return n, nil
+}
+
+func (s *Inner) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 6
+	size += len(s.Str) + len(s.Arr3)
+	for _, v := range s.Arr4 {
+		size += 2
+		size += len(v)
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Str)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Str))
+	}
+	buf[offset] = byte(len(s.Str))
+	buf[offset+1] = byte(len(s.Str) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Str)
+	offset += len(s.Str)
+	if uint64(len(s.Arr3)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Arr3))
+	}
+	buf[offset] = byte(len(s.Arr3))
+	buf[offset+1] = byte(len(s.Arr3) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Arr3)
+	offset += len(s.Arr3)
+	if uint64(len(s.Arr4)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Arr4))
+	}
+	buf[offset] = byte(len(s.Arr4))
+	buf[offset+1] = byte(len(s.Arr4) >> 8)
+	offset += 2
+	for _, v := range s.Arr4 {
+		if uint64(len(v)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v))
+		}
+		buf[offset] = byte(len(v))
+		buf[offset+1] = byte(len(v) >> 8)
+		offset += 2
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	return buf, nil
+}
+
+func (s *Inner) EncodedSize() int {
+	size := 6
+	size += len(s.Str) + len(s.Arr3)
+	for _, v := range s.Arr4 {
+		size += 2
+		size += len(v)
+	}
+	return size
+}
+
+func (s *Inner) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Inner) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Inner) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
 	var tmp []byte
 	m := 0
 	c := 64
 	strBuf := make([]byte, c)
-	r.Read(buf[:2])
-	size = uint16(buf[0]) | (uint16(buf[1]) << 8)
-	if c-m < int(size) {
-		c = int(size)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Str")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Inner", int64(offset), "Str")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Str")
+	}
+	if c-m < size {
+		c = size
 		if c < 2*cap(strBuf) {
 			c = 2 * cap(strBuf)
 		}
 		strBuf = append([]byte(nil), make([]byte, c)...)
 		m = 0
 	}
-	r.Read(strBuf[m : m+int(size)])
-	tmp = strBuf[m : m+int(size)]
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Str")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
 	s.Str = *(*string)(unsafe.Pointer(&tmp))
-	m += int(size)
-	r.Read(buf[:2])
-	size = uint16(buf[0]) | (uint16(buf[1]) << 8)
+	m += size
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Arr3")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Inner", int64(offset), "Arr3")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Arr3")
+	}
 	s.Arr3 = make([]uint8, size)
-	si := int(size)
-	for i := 0; i < si; i++ {
-		r.Read(buf[:1])
-		s.Arr3[i] = uint8(buf[0])
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Arr3")
+	}
+	offset += copy(s.Arr3, data[offset:])
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Arr4")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Inner", int64(offset), "Arr4")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Arr4")
 	}
-	r.Read(buf[:2])
-	size = uint16(buf[0]) | (uint16(buf[1]) << 8)
 	s.Arr4 = make([]string, size)
-	si1 := int(size)
-	for i1 := 0; i1 < si1; i1++ {
-		r.Read(buf[:2])
-		size = uint16(buf[0]) | (uint16(buf[1]) << 8)
-		if c-m < int(size) {
-			c = int(size)
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Arr4[%d]", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Inner", int64(offset), "Arr4[%d]", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Arr4[%d]", i)
+		}
+		if c-m < size {
+			c = size
 			if c < 2*cap(strBuf) {
 				c = 2 * cap(strBuf)
 			}
 			strBuf = append([]byte(nil), make([]byte, c)...)
 			m = 0
 		}
-		r.Read(strBuf[m : m+int(size)])
-		tmp = strBuf[m : m+int(size)]
-		s.Arr4[i1] = *(*string)(unsafe.Pointer(&tmp))
-		m += int(size)
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Inner", int64(offset), "Arr4[%d]", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Arr4[i] = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+	}
+	return offset, nil
+}
+
+func (s *Inner) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
 	}
 	return nil
 }
 
-func (s *Outer) WriteTo(w io.Writer) (n int, err error) {
+func (s *Outer) WriteTo(w io.Writer) (n int64, err error) {
 	size := 6
-	size += 1*len(s.Arr1) + 1*len(s.Arr2)
+	size += len(s.Arr1) + len(s.Arr2)
 	for _, v := range s.Inners {
 		size += 6
-		size += len(v.Str) + 1*len(v.Arr3)
+		size += len(v.Str) + len(v.Arr3)
 		for _, v1 := range v.Arr4 {
 			size += 2
 			size += len(v1)
@@ -106,33 +339,277 @@ func (s *Outer) WriteTo(w io.Writer) (n int, err error) {
 	}
 	buf := make([]byte, size)
 	offset := 0
+	if uint64(len(s.Arr1)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Arr1))
+	}
+	buf[offset] = byte(len(s.Arr1))
+	buf[offset+1] = byte(len(s.Arr1) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Arr1)
+	offset += len(s.Arr1)
+	if uint64(len(s.Inners)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Inners))
+	}
+	buf[offset] = byte(len(s.Inners))
+	buf[offset+1] = byte(len(s.Inners) >> 8)
+	offset += 2
+	for _, v := range s.Inners {
+		if uint64(len(v.Str)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Str))
+		}
+		buf[offset] = byte(len(v.Str))
+		buf[offset+1] = byte(len(v.Str) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Str)
+		offset += len(v.Str)
+		if uint64(len(v.Arr3)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Arr3))
+		}
+		buf[offset] = byte(len(v.Arr3))
+		buf[offset+1] = byte(len(v.Arr3) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Arr3)
+		offset += len(v.Arr3)
+		if uint64(len(v.Arr4)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Arr4))
+		}
+		buf[offset] = byte(len(v.Arr4))
+		buf[offset+1] = byte(len(v.Arr4) >> 8)
+		offset += 2
+		for _, v1 := range v.Arr4 {
+			if uint64(len(v1)) > math.MaxUint16 {
+				return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v1))
+			}
+			buf[offset] = byte(len(v1))
+			buf[offset+1] = byte(len(v1) >> 8)
+			offset += 2
+			copy(buf[offset:], v1)
+			offset += len(v1)
+		}
+	}
+	if uint64(len(s.Arr2)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Arr2))
+	}
+	buf[offset] = byte(len(s.Arr2))
+	buf[offset+1] = byte(len(s.Arr2) >> 8)
+	offset += 2
+	if len(s.Arr2) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Arr2[0])), len(s.Arr2)))
+	}
+	offset += len(s.Arr2)
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Outer) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Outer) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Outer) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Outer", n, "Arr1")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", n, "Arr1")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Arr1")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Arr1")
+	}
+	s.Arr1 = make([]uint8, size)
+	if nr, err := io.ReadFull(r, s.Arr1); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Outer", n, "Arr1")
+	}
+	n += int64(size)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inners")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", n, "Inners")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Inners")
+	}
+	if size > binenc.MaxAlloc/64 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Inners")
+	}
+	s.Inners = make([]Inner, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inners[%d].Str", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", n, "Inners[%d].Str", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Inners[%d].Str", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Inners[%d].Str", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inners[%d].Str", i)
+		}
+		n += int64(size)
+		s.Inners[i].Str = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inners[%d].Arr3", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", n, "Inners[%d].Arr3", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Inners[%d].Arr3", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Inners[%d].Arr3", i)
+		}
+		s.Inners[i].Arr3 = make([]uint8, size)
+		if nr, err := io.ReadFull(r, s.Inners[i].Arr3); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inners[%d].Arr3", i)
+		}
+		n += int64(size)
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inners[%d].Arr4", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", n, "Inners[%d].Arr4", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Inners[%d].Arr4", i)
+		}
+		if size > binenc.MaxAlloc/16 {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Inners[%d].Arr4", i)
+		}
+		s.Inners[i].Arr4 = make([]string, size)
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inners[%d].Arr4[%d]", i, i1)
+			}
+			n += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", n, "Inners[%d].Arr4[%d]", i, i1)
+			}
+			if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+				return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Inners[%d].Arr4[%d]", i, i1)
+			}
+			if size > binenc.MaxAlloc {
+				return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Inners[%d].Arr4[%d]", i, i1)
+			}
+			tmp = d.Arena(size)
+			if nr, err := io.ReadFull(r, tmp); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Outer", n, "Inners[%d].Arr4[%d]", i, i1)
+			}
+			n += int64(size)
+			s.Inners[i].Arr4[i1] = *(*string)(unsafe.Pointer(&tmp))
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Outer", n, "Arr2")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", n, "Arr2")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Outer", n, "Arr2")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Outer", n, "Arr2")
+	}
+	s.Arr2 = make([]int8, size)
+	if size > 0 {
+		if nr, err := io.ReadFull(r, unsafe.Slice((*byte)(unsafe.Pointer(&s.Arr2[0])), size)); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Outer", n, "Arr2")
+		}
+		n += int64(size)
+	}
+	return n, nil
+}
+
+func (s *Outer) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 6
+	size += len(s.Arr1) + len(s.Arr2)
+	for _, v := range s.Inners {
+		size += 6
+		size += len(v.Str) + len(v.Arr3)
+		for _, v1 := range v.Arr4 {
+			size += 2
+			size += len(v1)
+		}
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Arr1)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Arr1))
+	}
 	buf[offset] = byte(len(s.Arr1))
 	buf[offset+1] = byte(len(s.Arr1) >> 8)
 	offset += 2
-	for _, v := range s.Arr1 {
-		buf[offset] = byte(v)
-		offset += 1
+	copy(buf[offset:], s.Arr1)
+	offset += len(s.Arr1)
+	if uint64(len(s.Inners)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Inners))
 	}
 	buf[offset] = byte(len(s.Inners))
 	buf[offset+1] = byte(len(s.Inners) >> 8)
 	offset += 2
 	for _, v := range s.Inners {
+		if uint64(len(v.Str)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Str))
+		}
 		buf[offset] = byte(len(v.Str))
 		buf[offset+1] = byte(len(v.Str) >> 8)
 		offset += 2
 		copy(buf[offset:], v.Str)
 		offset += len(v.Str)
+		if uint64(len(v.Arr3)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Arr3))
+		}
 		buf[offset] = byte(len(v.Arr3))
 		buf[offset+1] = byte(len(v.Arr3) >> 8)
 		offset += 2
-		for _, v1 := range v.Arr3 {
-			buf[offset] = byte(v1)
-			offset += 1
+		copy(buf[offset:], v.Arr3)
+		offset += len(v.Arr3)
+		if uint64(len(v.Arr4)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Arr4))
 		}
 		buf[offset] = byte(len(v.Arr4))
 		buf[offset+1] = byte(len(v.Arr4) >> 8)
 		offset += 2
 		for _, v1 := range v.Arr4 {
+			if uint64(len(v1)) > math.MaxUint16 {
+				return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v1))
+			}
 			buf[offset] = byte(len(v1))
 			buf[offset+1] = byte(len(v1) >> 8)
 			offset += 2
@@ -140,86 +617,200 @@ func (s *Outer) WriteTo(w io.Writer) (n int, err error) {
 			offset += len(v1)
 		}
 	}
+	if uint64(len(s.Arr2)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Arr2))
+	}
 	buf[offset] = byte(len(s.Arr2))
 	buf[offset+1] = byte(len(s.Arr2) >> 8)
 	offset += 2
-	for _, v := range s.Arr2 {
-		buf[offset] = byte(uint8(v))
-		offset += 1
+	if len(s.Arr2) > 0 {
+		copy(buf[offset:], unsafe.Slice((*byte)(unsafe.Pointer(&s.Arr2[0])), len(s.Arr2)))
+	}
+	offset += len(s.Arr2)
+	return buf, nil
+}
+
+func (s *Outer) EncodedSize() int {
+	size := 6
+	size += len(s.Arr1) + len(s.Arr2)
+	for _, v := range s.Inners {
+		size += 6
+		size += len(v.Str) + len(v.Arr3)
+		for _, v1 := range v.Arr4 {
+			size += 2
+			size += len(v1)
+		}
 	}
-	return w.Write(buf)
+	return size
+}
+
+func (s *Outer) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Outer) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
 }
 
-func (s *Outer) ReadFrom(r io.Reader) error {
-	buf := make([]byte, 8)
-	var size uint16
+func (s *Outer) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
 	var tmp []byte
 	m := 0
 	c := 64
 	strBuf := make([]byte, c)
-	r.Read(buf[:2])
-	size = uint16(buf[0]) | (uint16(buf[1]) << 8)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Arr1")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", int64(offset), "Arr1")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Arr1")
+	}
 	s.Arr1 = make([]uint8, size)
-	si := int(size)
-	for i := 0; i < si; i++ {
-		r.Read(buf[:1])
-		s.Arr1[i] = uint8(buf[0])
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Arr1")
+	}
+	offset += copy(s.Arr1, data[offset:])
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", int64(offset), "Inners")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners")
 	}
-	r.Read(buf[:2])
-	size = uint16(buf[0]) | (uint16(buf[1]) << 8)
 	s.Inners = make([]Inner, size)
-	si1 := int(size)
-	for i1 := 0; i1 < si1; i1++ {
-		r.Read(buf[:2])
-		size = uint16(buf[0]) | (uint16(buf[1]) << 8)
-		if c-m < int(size) {
-			c = int(size)
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Str", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", int64(offset), "Inners[%d].Str", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Str", i)
+		}
+		if c-m < size {
+			c = size
 			if c < 2*cap(strBuf) {
 				c = 2 * cap(strBuf)
 			}
 			strBuf = append([]byte(nil), make([]byte, c)...)
 			m = 0
 		}
-		r.Read(strBuf[m : m+int(size)])
-		tmp = strBuf[m : m+int(size)]
-		s.Inners[i1].Str = *(*string)(unsafe.Pointer(&tmp))
-		m += int(size)
-		r.Read(buf[:2])
-		size = uint16(buf[0]) | (uint16(buf[1]) << 8)
-		s.Inners[i1].Arr3 = make([]uint8, size)
-		si2 := int(size)
-		for i2 := 0; i2 < si2; i2++ {
-			r.Read(buf[:1])
-			s.Inners[i1].Arr3[i2] = uint8(buf[0])
-		}
-		r.Read(buf[:2])
-		size = uint16(buf[0]) | (uint16(buf[1]) << 8)
-		s.Inners[i1].Arr4 = make([]string, size)
-		si3 := int(size)
-		for i3 := 0; i3 < si3; i3++ {
-			r.Read(buf[:2])
-			size = uint16(buf[0]) | (uint16(buf[1]) << 8)
-			if c-m < int(size) {
-				c = int(size)
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Str", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Inners[i].Str = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Arr3", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", int64(offset), "Inners[%d].Arr3", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Arr3", i)
+		}
+		s.Inners[i].Arr3 = make([]uint8, size)
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Arr3", i)
+		}
+		offset += copy(s.Inners[i].Arr3, data[offset:])
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Arr4", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", int64(offset), "Inners[%d].Arr4", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Arr4", i)
+		}
+		s.Inners[i].Arr4 = make([]string, size)
+		si1 := size
+		for i1 := 0; i1 < si1; i1++ {
+			if len(data)-offset < 2 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Arr4[%d]", i, i1)
+			}
+			buf = data[offset : offset+2]
+			offset += 2
+			size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+			if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+				return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Outer", int64(offset), "Inners[%d].Arr4[%d]", i, i1)
+			}
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Arr4[%d]", i, i1)
+			}
+			if c-m < size {
+				c = size
 				if c < 2*cap(strBuf) {
 					c = 2 * cap(strBuf)
 				}
 				strBuf = append([]byte(nil), make([]byte, c)...)
 				m = 0
 			}
-			r.Read(strBuf[m : m+int(size)])
-			tmp = strBuf[m : m+int(size)]
-			s.Inners[i1].Arr4[i3] = *(*string)(unsafe.Pointer(&tmp))
-			m += int(size)
+			if len(data)-offset < size {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Inners[%d].Arr4[%d]", i, i1)
+			}
+			offset += copy(strBuf[m:m+size], data[offset:])
+			tmp = strBuf[m : m+size]
+			s.Inners[i].Arr4[i1] = *(*string)(unsafe.Pointer(&tmp))
+			m += size
 		}
 	}
-	r.Read(buf[:2])
-	size = uint16(buf[0]) | (uint16(buf[1]) << 8)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Arr2")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Outer", int64(offset), "Arr2")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Arr2")
+	}
 	s.Arr2 = make([]int8, size)
-	si4 := int(size)
-	for i4 := 0; i4 < si4; i4++ {
-		r.Read(buf[:1])
-		s.Arr2[i4] = int8(uint8(buf[0]))
+	if size > 0 {
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Outer", int64(offset), "Arr2")
+		}
+		offset += copy(unsafe.Slice((*byte)(unsafe.Pointer(&s.Arr2[0])), size), data[offset:])
+	}
+	return offset, nil
+}
+
+func (s *Outer) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
 	}
 	return nil
 }
@@ -3,10 +3,13 @@
 package main
 
 import (
+	"fmt"
 	"io"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
 )
 
-func (s *Static) WriteTo(w io.Writer) (n int, err error) {
+func (s *Static) WriteTo(w io.Writer) (n int64, err error) {
 	size := 34
 	buf := make([]byte, size)
 	offset := 0
@@ -48,34 +51,179 @@ func (s *Static) WriteTo(w io.Writer) (n int, err error) {
 	buf[offset+6] = byte(uint64(s.Int64) >> 48)
 	buf[offset+7] = byte(uint64(s.Int64) >> 56)
 	offset += 8
-	for i1 := 0; i1 < 4; i1++ {
-		buf[offset] = byte(s.Arr[i1])
-		offset += 1
+	copy(buf[offset:], s.Arr[:])
+	offset += 4
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Static) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Static) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Static) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Static", n, "Uint8")
+	}
+	n += 1
+	s.Uint8 = uint8(buf[0])
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Static", n, "Uint16")
+	}
+	n += 2
+	s.Uint16 = uint16(buf[0]) | (uint16(buf[1]) << 8)
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Static", n, "Uint32")
+	}
+	n += 4
+	s.Uint32 = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Static", n, "Uint64")
+	}
+	n += 8
+	s.Uint64 = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Static", n, "Int8")
+	}
+	n += 1
+	s.Int8 = int8(uint8(buf[0]))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Static", n, "Int16")
+	}
+	n += 2
+	s.Int16 = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Static", n, "Int32")
+	}
+	n += 4
+	s.Int32 = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Static", n, "Int64")
 	}
-	return w.Write(buf)
+	n += 8
+	s.Int64 = int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
+	if nr, err := io.ReadFull(r, s.Arr[:]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Static", n, "Arr")
+	}
+	n += 4
+	return n, nil
+}
+
+func (s *Static) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 34
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(s.Uint8)
+	offset += 1
+	buf[offset] = byte(s.Uint16)
+	buf[offset+1] = byte(s.Uint16 >> 8)
+	offset += 2
+	buf[offset] = byte(s.Uint32)
+	buf[offset+1] = byte(s.Uint32 >> 8)
+	buf[offset+2] = byte(s.Uint32 >> 16)
+	buf[offset+3] = byte(s.Uint32 >> 24)
+	offset += 4
+	buf[offset] = byte(s.Uint64)
+	buf[offset+1] = byte(s.Uint64 >> 8)
+	buf[offset+2] = byte(s.Uint64 >> 16)
+	buf[offset+3] = byte(s.Uint64 >> 24)
+	buf[offset+4] = byte(s.Uint64 >> 32)
+	buf[offset+5] = byte(s.Uint64 >> 40)
+	buf[offset+6] = byte(s.Uint64 >> 48)
+	buf[offset+7] = byte(s.Uint64 >> 56)
+	offset += 8
+	buf[offset] = byte(uint8(s.Int8))
+	offset += 1
+	buf[offset] = byte(uint16(s.Int16))
+	buf[offset+1] = byte(uint16(s.Int16) >> 8)
+	offset += 2
+	buf[offset] = byte(uint32(s.Int32))
+	buf[offset+1] = byte(uint32(s.Int32) >> 8)
+	buf[offset+2] = byte(uint32(s.Int32) >> 16)
+	buf[offset+3] = byte(uint32(s.Int32) >> 24)
+	offset += 4
+	buf[offset] = byte(uint64(s.Int64))
+	buf[offset+1] = byte(uint64(s.Int64) >> 8)
+	buf[offset+2] = byte(uint64(s.Int64) >> 16)
+	buf[offset+3] = byte(uint64(s.Int64) >> 24)
+	buf[offset+4] = byte(uint64(s.Int64) >> 32)
+	buf[offset+5] = byte(uint64(s.Int64) >> 40)
+	buf[offset+6] = byte(uint64(s.Int64) >> 48)
+	buf[offset+7] = byte(uint64(s.Int64) >> 56)
+	offset += 8
+	copy(buf[offset:], s.Arr[:])
+	offset += 4
+	return buf, nil
+}
+
+func (s *Static) EncodedSize() int {
+	size := 34
+	return size
+}
+
+func (s *Static) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
 }
 
-func (s *Static) ReadFrom(r io.Reader) error {
-	buf := make([]byte, 8)
-	r.Read(buf[:1])
+func (s *Static) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Static) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	if len(data)-offset < 30 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Static", int64(offset), "Uint8")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
 	s.Uint8 = uint8(buf[0])
-	r.Read(buf[:2])
+	buf = data[offset : offset+2]
+	offset += 2
 	s.Uint16 = uint16(buf[0]) | (uint16(buf[1]) << 8)
-	r.Read(buf[:4])
+	buf = data[offset : offset+4]
+	offset += 4
 	s.Uint32 = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
-	r.Read(buf[:8])
+	buf = data[offset : offset+8]
+	offset += 8
 	s.Uint64 = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
-	r.Read(buf[:1])
+	buf = data[offset : offset+1]
+	offset += 1
 	s.Int8 = int8(uint8(buf[0]))
-	r.Read(buf[:2])
+	buf = data[offset : offset+2]
+	offset += 2
 	s.Int16 = int16(uint16(buf[0]) | (uint16(buf[1]) << 8))
-	r.Read(buf[:4])
+	buf = data[offset : offset+4]
+	offset += 4
 	s.Int32 = int32(uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24))
-	r.Read(buf[:8])
+	buf = data[offset : offset+8]
+	offset += 8
 	s.Int64 = int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56))
-	for i := 0; i < 4; i++ {
-		r.Read(buf[:1])
-		s.Arr[i] = uint8(buf[0])
+	if len(data)-offset < 4 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Static", int64(offset), "Arr")
+	}
+	offset += copy(s.Arr[:], data[offset:])
+	return offset, nil
+}
+
+func (s *Static) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
 	}
 	return nil
 }
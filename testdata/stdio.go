@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+//go:generate go-binenc-gen stdio.go
+type Stdio struct {
+	Name  string
+	Items []uint16
+}
+
+var (
+	_ io.WriterTo   = (*Stdio)(nil)
+	_ io.ReaderFrom = (*Stdio)(nil)
+)
+
+func main() {
+	s := &Stdio{
+		Name:  "stdio",
+		Items: []uint16{1, 2, 3},
+	}
+
+	var buf bytes.Buffer
+	var w io.WriterTo = s
+	n, err := w.WriteTo(&buf)
+	if err != nil || n != int64(buf.Len()) {
+		panic("stdio.go: unexpected WriteTo result")
+	}
+	want := n
+
+	var o Stdio
+	var r io.ReaderFrom = &o
+	n, err = r.ReadFrom(&buf)
+	if err != nil || n != want {
+		panic("stdio.go: unexpected ReadFrom result")
+	}
+
+	if diff := cmp.Diff(s, &o); diff != "" {
+		panic("stdio.go: \n" + diff)
+	}
+}
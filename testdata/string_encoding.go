@@ -3,43 +3,148 @@
 package main
 
 import (
+	"fmt"
 	"io"
+	"math"
 	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
 )
 
-func (s *String) WriteTo(w io.Writer) (n int, err error) {
+func (s *String) WriteTo(w io.Writer) (n int64, err error) {
 	size := 2
 	size += len(s.S)
 	buf := make([]byte, size)
 	offset := 0
+	if uint64(len(s.S)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.S))
+	}
+	buf[offset] = byte(len(s.S))
+	buf[offset+1] = byte(len(s.S) >> 8)
+	offset += 2
+	copy(buf[offset:], s.S)
+	offset += len(s.S)
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *String) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *String) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *String) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "String", n, "S")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "String", n, "S")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "String", n, "S")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "String", n, "S")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "String", n, "S")
+	}
+	n += int64(size)
+	s.S = *(*string)(unsafe.Pointer(&tmp))
+	return n, nil
+}
+
+func (s *String) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 2
+	size += len(s.S)
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.S)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.S))
+	}
 	buf[offset] = byte(len(s.S))
 	buf[offset+1] = byte(len(s.S) >> 8)
 	offset += 2
 	copy(buf[offset:], s.S)
 	offset += len(s.S)
-	return w.Write(buf)
+	return buf, nil
+}
+
+func (s *String) EncodedSize() int {
+	size := 2
+	size += len(s.S)
+	return size
+}
+
+func (s *String) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *String) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
 }
 
-func (s *String) ReadFrom(r io.Reader) error {
-	buf := make([]byte, 8)
-	var size uint16
+func (s *String) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
 	var tmp []byte
 	m := 0
 	c := 64
 	strBuf := make([]byte, c)
-	r.Read(buf[:2])
-	size = uint16(buf[0]) | (uint16(buf[1]) << 8)
-	if c-m < int(size) {
-		c = int(size)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "String", int64(offset), "S")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "String", int64(offset), "S")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "String", int64(offset), "S")
+	}
+	if c-m < size {
+		c = size
 		if c < 2*cap(strBuf) {
 			c = 2 * cap(strBuf)
 		}
 		strBuf = append([]byte(nil), make([]byte, c)...)
 		m = 0
 	}
-	r.Read(strBuf[m : m+int(size)])
-	tmp = strBuf[m : m+int(size)]
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "String", int64(offset), "S")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
 	s.S = *(*string)(unsafe.Pointer(&tmp))
-	m += int(size)
+	m += size
+	return offset, nil
+}
+
+func (s *String) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
 	return nil
 }
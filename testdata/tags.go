@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+//go:generate go-binenc-gen tags.go
+type Tags struct {
+	Name   string            `binenc:"order=2"`
+	Seq    uint64            `binenc:"order=0,width=4,endian=big"`
+	Cache  map[string]func() `binenc:"-"`
+	Deltas []int32           `binenc:"order=1,width=2,len=1"`
+	Count  int               `binenc:"order=3,width=1,enc=fixed"`
+	Small  int8              `binenc:"order=4,width=2"`
+}
+
+func main() {
+	s := &Tags{
+		Name:   "ab",
+		Seq:    0x01020304,
+		Cache:  map[string]func(){"f": func() {}},
+		Deltas: []int32{-1, 0x0506},
+		Count:  -7,
+		Small:  -2,
+	}
+
+	var buf bytes.Buffer
+	if _, err := s.WriteTo(&buf); err != nil {
+		panic("tags.go: " + err.Error())
+	}
+	want := []byte{
+		0x01, 0x02, 0x03, 0x04, // Seq
+		0x02, 0xff, 0xff, 0x06, 0x05, // Deltas
+		0x02, 0x00, 'a', 'b', // Name
+		0xf9,       // Count
+		0xfe, 0xff, // Small
+	}
+	if diff := cmp.Diff(want, buf.Bytes()); diff != "" {
+		panic("tags.go: \n" + diff)
+	}
+
+	o := new(Tags)
+	if _, err := o.ReadFrom(&buf); err != nil {
+		panic("tags.go: " + err.Error())
+	}
+	if diff := cmp.Diff(s, o, cmpopts.IgnoreFields(Tags{}, "Cache")); diff != "" {
+		panic("tags.go: \n" + diff)
+	}
+	if o.Cache != nil {
+		panic("tags.go: skipped field was decoded")
+	}
+
+	// values that do not fit their width are errors
+	for _, s := range []*Tags{
+		{Seq: 1 << 32},
+		{Deltas: []int32{1 << 15}},
+		{Count: 128},
+	} {
+		buf.Reset()
+		if _, err := s.WriteTo(&buf); err == nil {
+			panic("tags.go: expected overflow error")
+		}
+		if buf.Len() != 0 {
+			panic("tags.go: partial write on error")
+		}
+	}
+	wide := append(want[:len(want)-2:len(want)-2], 0x00, 0x01)
+	if _, err := o.ReadFrom(bytes.NewReader(wide)); err == nil {
+		panic("tags.go: expected overflow error")
+	}
+}
@@ -0,0 +1,288 @@
+// Code generated by "gobinenc tags.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Tags) WriteTo(w io.Writer) (n int64, err error) {
+	size := 10
+	size += 2*len(s.Deltas) + len(s.Name)
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(s.Seq) > math.MaxUint32 {
+		return 0, fmt.Errorf("binenc: %d overflows 4 bytes", s.Seq)
+	}
+	buf[offset] = byte(s.Seq >> 24)
+	buf[offset+1] = byte(s.Seq >> 16)
+	buf[offset+2] = byte(s.Seq >> 8)
+	buf[offset+3] = byte(s.Seq)
+	offset += 4
+	if uint64(len(s.Deltas)) > math.MaxUint8 {
+		return 0, fmt.Errorf("binenc: length %d overflows 1-byte prefix", len(s.Deltas))
+	}
+	buf[offset] = byte(len(s.Deltas))
+	offset += 1
+	for _, v := range s.Deltas {
+		if v < math.MinInt16 || v > math.MaxInt16 {
+			return 0, fmt.Errorf("binenc: %d overflows 2 bytes", v)
+		}
+		buf[offset] = byte(uint16(v))
+		buf[offset+1] = byte(uint16(v) >> 8)
+		offset += 2
+	}
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if s.Count < math.MinInt8 || s.Count > math.MaxInt8 {
+		return 0, fmt.Errorf("binenc: %d overflows 1 bytes", s.Count)
+	}
+	buf[offset] = byte(uint8(s.Count))
+	offset += 1
+	buf[offset] = byte(uint16(s.Small))
+	buf[offset+1] = byte(uint16(s.Small) >> 8)
+	offset += 2
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Tags) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Tags) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Tags) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Tags", n, "Seq")
+	}
+	n += 4
+	s.Seq = uint64((uint32(buf[0]) << 24) | (uint32(buf[1]) << 16) | (uint32(buf[2]) << 8) | uint32(buf[3]))
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Tags", n, "Deltas")
+	}
+	n += 1
+	size = int(uint8(buf[0]))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Tags", n, "Deltas")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Tags", n, "Deltas")
+	}
+	if size > binenc.MaxAlloc/4 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Tags", n, "Deltas")
+	}
+	s.Deltas = make([]int32, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Tags", n, "Deltas[%d]", i)
+		}
+		n += 2
+		s.Deltas[i] = int32(int16(uint16(buf[0]) | (uint16(buf[1]) << 8)))
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Tags", n, "Name")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Tags", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Tags", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Tags", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Tags", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Tags", n, "Count")
+	}
+	n += 1
+	s.Count = int(int8(uint8(buf[0])))
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Tags", n, "Small")
+	}
+	n += 2
+	if x := int16(uint16(buf[0]) | (uint16(buf[1]) << 8)); int16(int8(x)) != x {
+		return n, binenc.FieldError(fmt.Errorf("binenc: %d overflows int8", x), "Tags", n, "Small")
+	} else {
+		s.Small = int8(x)
+	}
+	return n, nil
+}
+
+func (s *Tags) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 10
+	size += 2*len(s.Deltas) + len(s.Name)
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(s.Seq) > math.MaxUint32 {
+		return dst, fmt.Errorf("binenc: %d overflows 4 bytes", s.Seq)
+	}
+	buf[offset] = byte(s.Seq >> 24)
+	buf[offset+1] = byte(s.Seq >> 16)
+	buf[offset+2] = byte(s.Seq >> 8)
+	buf[offset+3] = byte(s.Seq)
+	offset += 4
+	if uint64(len(s.Deltas)) > math.MaxUint8 {
+		return dst, fmt.Errorf("binenc: length %d overflows 1-byte prefix", len(s.Deltas))
+	}
+	buf[offset] = byte(len(s.Deltas))
+	offset += 1
+	for _, v := range s.Deltas {
+		if v < math.MinInt16 || v > math.MaxInt16 {
+			return dst, fmt.Errorf("binenc: %d overflows 2 bytes", v)
+		}
+		buf[offset] = byte(uint16(v))
+		buf[offset+1] = byte(uint16(v) >> 8)
+		offset += 2
+	}
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if s.Count < math.MinInt8 || s.Count > math.MaxInt8 {
+		return dst, fmt.Errorf("binenc: %d overflows 1 bytes", s.Count)
+	}
+	buf[offset] = byte(uint8(s.Count))
+	offset += 1
+	buf[offset] = byte(uint16(s.Small))
+	buf[offset+1] = byte(uint16(s.Small) >> 8)
+	offset += 2
+	return buf, nil
+}
+
+func (s *Tags) EncodedSize() int {
+	size := 10
+	size += 2*len(s.Deltas) + len(s.Name)
+	return size
+}
+
+func (s *Tags) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Tags) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Tags) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 5 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Tags", int64(offset), "Seq")
+	}
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Seq = uint64((uint32(buf[0]) << 24) | (uint32(buf[1]) << 16) | (uint32(buf[2]) << 8) | uint32(buf[3]))
+	buf = data[offset : offset+1]
+	offset += 1
+	size = int(uint8(buf[0]))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Tags", int64(offset), "Deltas")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Tags", int64(offset), "Deltas")
+	}
+	s.Deltas = make([]int32, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Tags", int64(offset), "Deltas[%d]", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		s.Deltas[i] = int32(int16(uint16(buf[0]) | (uint16(buf[1]) << 8)))
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Tags", int64(offset), "Name")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Tags", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Tags", int64(offset), "Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Tags", int64(offset), "Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 3 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Tags", int64(offset), "Count")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	s.Count = int(int8(uint8(buf[0])))
+	buf = data[offset : offset+2]
+	offset += 2
+	if x := int16(uint16(buf[0]) | (uint16(buf[1]) << 8)); int16(int8(x)) != x {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: %d overflows int8", x), "Tags", int64(offset), "Small")
+	} else {
+		s.Small = int8(x)
+	}
+	return offset, nil
+}
+
+func (s *Tags) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
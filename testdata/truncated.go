@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"testing/iotest"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type Item struct {
+	Name  string
+	Count *uint32
+}
+
+//go:generate go-binenc-gen truncated.go
+type Truncated struct {
+	ID    uint64
+	Items []Item
+	Tags  map[string]bool
+	Next  *Truncated
+}
+
+func main() {
+	count := uint32(3)
+	s := &Truncated{
+		ID: 42,
+		Items: []Item{
+			{Name: "first", Count: &count},
+			{Name: "second"},
+		},
+		Tags: map[string]bool{"a": true},
+		Next: &Truncated{ID: 7},
+	}
+
+	var buf bytes.Buffer
+	if _, err := s.WriteTo(&buf); err != nil {
+		panic("truncated.go: " + err.Error())
+	}
+	data := buf.Bytes()
+
+	// short reads are retried until the value is complete
+	o := new(Truncated)
+	n, err := o.ReadFrom(iotest.OneByteReader(bytes.NewReader(data)))
+	if err != nil || n != int64(len(data)) {
+		panic(fmt.Sprintf("truncated.go: ReadFrom = %d, %v; want %d, nil", n, err, len(data)))
+	}
+	// nil slices and maps are decoded as empty ones
+	if diff := cmp.Diff(s, o, cmpopts.EquateEmpty()); diff != "" {
+		panic("truncated.go: \n" + diff)
+	}
+
+	// an empty input is a clean end of stream
+	n, err = new(Truncated).ReadFrom(bytes.NewReader(nil))
+	if err != io.EOF || n != 0 {
+		panic(fmt.Sprintf("truncated.go: ReadFrom(empty) = %d, %v; want 0, EOF", n, err))
+	}
+
+	// truncated input is reported along with the bytes consumed
+	for i := 1; i < len(data); i++ {
+		n, err := new(Truncated).ReadFrom(bytes.NewReader(data[:i]))
+		if !errors.Is(err, io.ErrUnexpectedEOF) || n != int64(i) {
+			panic(fmt.Sprintf("truncated.go: ReadFrom(data[:%d]) = %d, %v; want %d, ErrUnexpectedEOF", i, n, err, i))
+		}
+	}
+}
@@ -0,0 +1,1087 @@
+// Code generated by "gobinenc truncated.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Item) WriteTo(w io.Writer) (n int64, err error) {
+	size := 3
+	size += len(s.Name)
+	if s.Count != nil {
+		size += 4
+	}
+	buf := make([]byte, size)
+	offset := 0
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if s.Count != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Count != nil {
+		buf[offset] = byte(*s.Count)
+		buf[offset+1] = byte(*s.Count >> 8)
+		buf[offset+2] = byte(*s.Count >> 16)
+		buf[offset+3] = byte(*s.Count >> 24)
+		offset += 4
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Item) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Item) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Item) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Item", n, "Name")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Item", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Item", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Item", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Item", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Item", n, "Count")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Count = new(uint32)
+		if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Item", n, "Count")
+		}
+		n += 4
+		*s.Count = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	} else {
+		s.Count = nil
+	}
+	return n, nil
+}
+
+func (s *Item) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 3
+	size += len(s.Name)
+	if s.Count != nil {
+		size += 4
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	if uint64(len(s.Name)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Name))
+	}
+	buf[offset] = byte(len(s.Name))
+	buf[offset+1] = byte(len(s.Name) >> 8)
+	offset += 2
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	if s.Count != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Count != nil {
+		buf[offset] = byte(*s.Count)
+		buf[offset+1] = byte(*s.Count >> 8)
+		buf[offset+2] = byte(*s.Count >> 16)
+		buf[offset+3] = byte(*s.Count >> 24)
+		offset += 4
+	}
+	return buf, nil
+}
+
+func (s *Item) EncodedSize() int {
+	size := 3
+	size += len(s.Name)
+	if s.Count != nil {
+		size += 4
+	}
+	return size
+}
+
+func (s *Item) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Item) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Item) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Item", int64(offset), "Name")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Item", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Item", int64(offset), "Name")
+	}
+	if c-m < size {
+		c = size
+		if c < 2*cap(strBuf) {
+			c = 2 * cap(strBuf)
+		}
+		strBuf = append([]byte(nil), make([]byte, c)...)
+		m = 0
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Item", int64(offset), "Name")
+	}
+	offset += copy(strBuf[m:m+size], data[offset:])
+	tmp = strBuf[m : m+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	m += size
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Item", int64(offset), "Count")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Count = new(uint32)
+		if len(data)-offset < 4 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Item", int64(offset), "Count")
+		}
+		buf = data[offset : offset+4]
+		offset += 4
+		*s.Count = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	} else {
+		s.Count = nil
+	}
+	return offset, nil
+}
+
+func (s *Item) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Truncated) WriteTo(w io.Writer) (n int64, err error) {
+	size := 13
+	for _, v := range s.Items {
+		size += 3
+		size += len(v.Name)
+		if v.Count != nil {
+			size += 4
+		}
+	}
+	for k := range s.Tags {
+		size += 3
+		size += len(k)
+	}
+	if s.Next != nil {
+		size += (*s.Next).sizeBinenc()
+	}
+	buf := make([]byte, size)
+	offset := 0
+	buf[offset] = byte(s.ID)
+	buf[offset+1] = byte(s.ID >> 8)
+	buf[offset+2] = byte(s.ID >> 16)
+	buf[offset+3] = byte(s.ID >> 24)
+	buf[offset+4] = byte(s.ID >> 32)
+	buf[offset+5] = byte(s.ID >> 40)
+	buf[offset+6] = byte(s.ID >> 48)
+	buf[offset+7] = byte(s.ID >> 56)
+	offset += 8
+	if uint64(len(s.Items)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Items))
+	}
+	buf[offset] = byte(len(s.Items))
+	buf[offset+1] = byte(len(s.Items) >> 8)
+	offset += 2
+	for _, v := range s.Items {
+		if uint64(len(v.Name)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Name))
+		}
+		buf[offset] = byte(len(v.Name))
+		buf[offset+1] = byte(len(v.Name) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Name)
+		offset += len(v.Name)
+		if v.Count != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if v.Count != nil {
+			buf[offset] = byte(*v.Count)
+			buf[offset+1] = byte(*v.Count >> 8)
+			buf[offset+2] = byte(*v.Count >> 16)
+			buf[offset+3] = byte(*v.Count >> 24)
+			offset += 4
+		}
+	}
+	if uint64(len(s.Tags)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Tags))
+	}
+	buf[offset] = byte(len(s.Tags))
+	buf[offset+1] = byte(len(s.Tags) >> 8)
+	offset += 2
+	for k, v := range s.Tags {
+		if uint64(len(k)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if v {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+	}
+	if s.Next != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Next != nil {
+		offset, err = (*s.Next).writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Truncated) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Truncated) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Truncated) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Truncated", n, "ID")
+	}
+	n += 8
+	s.ID = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Items")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Truncated", n, "Items")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Truncated", n, "Items")
+	}
+	if size > binenc.MaxAlloc/24 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Truncated", n, "Items")
+	}
+	s.Items = make([]Item, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Items[%d].Name", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Truncated", n, "Items[%d].Name", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Truncated", n, "Items[%d].Name", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Truncated", n, "Items[%d].Name", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Items[%d].Name", i)
+		}
+		n += int64(size)
+		s.Items[i].Name = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Items[%d].Count", i)
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			s.Items[i].Count = new(uint32)
+			if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Items[%d].Count", i)
+			}
+			n += 4
+			*s.Items[i].Count = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		} else {
+			s.Items[i].Count = nil
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Tags")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Truncated", n, "Tags")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Truncated", n, "Tags")
+	}
+	s.Tags = make(map[string]bool, binenc.MapHint(size))
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 bool
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Tags")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Truncated", n, "Tags")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Truncated", n, "Tags")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Truncated", n, "Tags")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Tags")
+		}
+		n += int64(size)
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Tags[%q]", k1)
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			v1 = true
+		} else {
+			v1 = false
+		}
+		s.Tags[k1] = v1
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Next")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Next = new(Truncated)
+		if n, err = (*s.Next).readBinenc(d, n, 1); err != nil {
+			return n, binenc.FieldError(err, "Truncated", n, "Next")
+		}
+	} else {
+		s.Next = nil
+	}
+	return n, nil
+}
+
+func (s *Truncated) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 13
+	for _, v := range s.Items {
+		size += 3
+		size += len(v.Name)
+		if v.Count != nil {
+			size += 4
+		}
+	}
+	for k := range s.Tags {
+		size += 3
+		size += len(k)
+	}
+	if s.Next != nil {
+		size += (*s.Next).sizeBinenc()
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(s.ID)
+	buf[offset+1] = byte(s.ID >> 8)
+	buf[offset+2] = byte(s.ID >> 16)
+	buf[offset+3] = byte(s.ID >> 24)
+	buf[offset+4] = byte(s.ID >> 32)
+	buf[offset+5] = byte(s.ID >> 40)
+	buf[offset+6] = byte(s.ID >> 48)
+	buf[offset+7] = byte(s.ID >> 56)
+	offset += 8
+	if uint64(len(s.Items)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Items))
+	}
+	buf[offset] = byte(len(s.Items))
+	buf[offset+1] = byte(len(s.Items) >> 8)
+	offset += 2
+	for _, v := range s.Items {
+		if uint64(len(v.Name)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Name))
+		}
+		buf[offset] = byte(len(v.Name))
+		buf[offset+1] = byte(len(v.Name) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Name)
+		offset += len(v.Name)
+		if v.Count != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if v.Count != nil {
+			buf[offset] = byte(*v.Count)
+			buf[offset+1] = byte(*v.Count >> 8)
+			buf[offset+2] = byte(*v.Count >> 16)
+			buf[offset+3] = byte(*v.Count >> 24)
+			offset += 4
+		}
+	}
+	if uint64(len(s.Tags)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Tags))
+	}
+	buf[offset] = byte(len(s.Tags))
+	buf[offset+1] = byte(len(s.Tags) >> 8)
+	offset += 2
+	for k, v := range s.Tags {
+		if uint64(len(k)) > math.MaxUint16 {
+			return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if v {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+	}
+	if s.Next != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Next != nil {
+		offset, err = (*s.Next).writeBinenc(buf, offset)
+		if err != nil {
+			return dst, err
+		}
+	}
+	return buf, nil
+}
+
+func (s *Truncated) EncodedSize() int {
+	size := 13
+	for _, v := range s.Items {
+		size += 3
+		size += len(v.Name)
+		if v.Count != nil {
+			size += 4
+		}
+	}
+	for k := range s.Tags {
+		size += 3
+		size += len(k)
+	}
+	if s.Next != nil {
+		size += (*s.Next).sizeBinenc()
+	}
+	return size
+}
+
+func (s *Truncated) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Truncated) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Truncated) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 10 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "ID")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	s.ID = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Truncated", int64(offset), "Items")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Items")
+	}
+	s.Items = make([]Item, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Items[%d].Name", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Truncated", int64(offset), "Items[%d].Name", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Items[%d].Name", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Items[%d].Name", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Items[i].Name = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Items[%d].Count", i)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			s.Items[i].Count = new(uint32)
+			if len(data)-offset < 4 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Items[%d].Count", i)
+			}
+			buf = data[offset : offset+4]
+			offset += 4
+			*s.Items[i].Count = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		} else {
+			s.Items[i].Count = nil
+		}
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Tags")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Truncated", int64(offset), "Tags")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Tags")
+	}
+	s.Tags = make(map[string]bool, size)
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 bool
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Tags")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Truncated", int64(offset), "Tags")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Tags")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Tags")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Tags[%q]", k1)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			v1 = true
+		} else {
+			v1 = false
+		}
+		s.Tags[k1] = v1
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Next")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Next = new(Truncated)
+		if offset, err = (*s.Next).decodeBinenc(data, offset, opts, 1); err != nil {
+			return offset, binenc.FieldError(err, "Truncated", int64(offset), "Next")
+		}
+	} else {
+		s.Next = nil
+	}
+	return offset, nil
+}
+
+func (s *Truncated) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *Truncated) sizeBinenc() int {
+	size := 13
+	for _, v := range s.Items {
+		size += 3
+		size += len(v.Name)
+		if v.Count != nil {
+			size += 4
+		}
+	}
+	for k := range s.Tags {
+		size += 3
+		size += len(k)
+	}
+	if s.Next != nil {
+		size += (*s.Next).sizeBinenc()
+	}
+	return size
+}
+
+func (s *Truncated) writeBinenc(buf []byte, offset int) (_ int, err error) {
+	buf[offset] = byte(s.ID)
+	buf[offset+1] = byte(s.ID >> 8)
+	buf[offset+2] = byte(s.ID >> 16)
+	buf[offset+3] = byte(s.ID >> 24)
+	buf[offset+4] = byte(s.ID >> 32)
+	buf[offset+5] = byte(s.ID >> 40)
+	buf[offset+6] = byte(s.ID >> 48)
+	buf[offset+7] = byte(s.ID >> 56)
+	offset += 8
+	if uint64(len(s.Items)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Items))
+	}
+	buf[offset] = byte(len(s.Items))
+	buf[offset+1] = byte(len(s.Items) >> 8)
+	offset += 2
+	for _, v := range s.Items {
+		if uint64(len(v.Name)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(v.Name))
+		}
+		buf[offset] = byte(len(v.Name))
+		buf[offset+1] = byte(len(v.Name) >> 8)
+		offset += 2
+		copy(buf[offset:], v.Name)
+		offset += len(v.Name)
+		if v.Count != nil {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+		if v.Count != nil {
+			buf[offset] = byte(*v.Count)
+			buf[offset+1] = byte(*v.Count >> 8)
+			buf[offset+2] = byte(*v.Count >> 16)
+			buf[offset+3] = byte(*v.Count >> 24)
+			offset += 4
+		}
+	}
+	if uint64(len(s.Tags)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Tags))
+	}
+	buf[offset] = byte(len(s.Tags))
+	buf[offset+1] = byte(len(s.Tags) >> 8)
+	offset += 2
+	for k, v := range s.Tags {
+		if uint64(len(k)) > math.MaxUint16 {
+			return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(k))
+		}
+		buf[offset] = byte(len(k))
+		buf[offset+1] = byte(len(k) >> 8)
+		offset += 2
+		copy(buf[offset:], k)
+		offset += len(k)
+		if v {
+			buf[offset] = byte(0x01)
+		} else {
+			buf[offset] = byte(0x00)
+		}
+		offset += 1
+	}
+	if s.Next != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Next != nil {
+		offset, err = (*s.Next).writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return offset, nil
+}
+
+func (s *Truncated) readBinenc(d *binenc.Decoder, n int64, depth int) (_ int64, err error) {
+	if limit := d.Options().MaxDepth; limit > 0 && depth > limit {
+		return n, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var tmp []byte
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Truncated", n, "ID")
+	}
+	n += 8
+	s.ID = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Items")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Truncated", n, "Items")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Truncated", n, "Items")
+	}
+	if size > binenc.MaxAlloc/24 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Truncated", n, "Items")
+	}
+	s.Items = make([]Item, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Items[%d].Name", i)
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Truncated", n, "Items[%d].Name", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Truncated", n, "Items[%d].Name", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Truncated", n, "Items[%d].Name", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Items[%d].Name", i)
+		}
+		n += int64(size)
+		s.Items[i].Name = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Items[%d].Count", i)
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			s.Items[i].Count = new(uint32)
+			if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Items[%d].Count", i)
+			}
+			n += 4
+			*s.Items[i].Count = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		} else {
+			s.Items[i].Count = nil
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Tags")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Truncated", n, "Tags")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Truncated", n, "Tags")
+	}
+	s.Tags = make(map[string]bool, binenc.MapHint(size))
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 bool
+		if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Tags")
+		}
+		n += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Truncated", n, "Tags")
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Truncated", n, "Tags")
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "Truncated", n, "Tags")
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Tags")
+		}
+		n += int64(size)
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Tags[%q]", k1)
+		}
+		n += 1
+		if buf[0] == byte(0x01) {
+			v1 = true
+		} else {
+			v1 = false
+		}
+		s.Tags[k1] = v1
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Truncated", n, "Next")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Next = new(Truncated)
+		if n, err = (*s.Next).readBinenc(d, n, depth+1); err != nil {
+			return n, binenc.FieldError(err, "Truncated", n, "Next")
+		}
+	} else {
+		s.Next = nil
+	}
+	return n, nil
+}
+
+func (s *Truncated) decodeBinenc(data []byte, offset int, opts binenc.DecodeOptions, depth int) (_ int, err error) {
+	if limit := opts.MaxDepth; limit > 0 && depth > limit {
+		return offset, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	var buf []byte
+	var size int
+	var tmp []byte
+	m := 0
+	c := 64
+	strBuf := make([]byte, c)
+	if len(data)-offset < 10 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "ID")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	s.ID = uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Truncated", int64(offset), "Items")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Items")
+	}
+	s.Items = make([]Item, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Items[%d].Name", i)
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Truncated", int64(offset), "Items[%d].Name", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Items[%d].Name", i)
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Items[%d].Name", i)
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		s.Items[i].Name = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Items[%d].Count", i)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			s.Items[i].Count = new(uint32)
+			if len(data)-offset < 4 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Items[%d].Count", i)
+			}
+			buf = data[offset : offset+4]
+			offset += 4
+			*s.Items[i].Count = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+		} else {
+			s.Items[i].Count = nil
+		}
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Tags")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Truncated", int64(offset), "Tags")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Tags")
+	}
+	s.Tags = make(map[string]bool, size)
+	si1 := size
+	for i1 := 0; i1 < si1; i1++ {
+		var k1 string
+		var v1 bool
+		if len(data)-offset < 2 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Tags")
+		}
+		buf = data[offset : offset+2]
+		offset += 2
+		size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "Truncated", int64(offset), "Tags")
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Tags")
+		}
+		if c-m < size {
+			c = size
+			if c < 2*cap(strBuf) {
+				c = 2 * cap(strBuf)
+			}
+			strBuf = append([]byte(nil), make([]byte, c)...)
+			m = 0
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Tags")
+		}
+		offset += copy(strBuf[m:m+size], data[offset:])
+		tmp = strBuf[m : m+size]
+		k1 = *(*string)(unsafe.Pointer(&tmp))
+		m += size
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Tags[%q]", k1)
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if buf[0] == byte(0x01) {
+			v1 = true
+		} else {
+			v1 = false
+		}
+		s.Tags[k1] = v1
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Truncated", int64(offset), "Next")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Next = new(Truncated)
+		if offset, err = (*s.Next).decodeBinenc(data, offset, opts, depth+1); err != nil {
+			return offset, binenc.FieldError(err, "Truncated", int64(offset), "Next")
+		}
+	} else {
+		s.Next = nil
+	}
+	return offset, nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type Child struct {
+	Name string
+}
+
+type Sibling struct {
+	Value uint8
+}
+
+// Excluded is not referenced by Root, so it gets no methods.
+type Excluded struct {
+	Callback func()
+}
+
+//go:generate go-binenc-gen -type Root typefilter.go
+type Root struct {
+	Children []Child
+	Sibling  *Sibling
+}
+
+func main() {
+	// Child and Sibling are needed by Root
+	for _, v := range []interface{}{&Root{}, &Child{}, &Sibling{}} {
+		if _, ok := v.(io.WriterTo); !ok {
+			panic("typefilter.go: missing WriteTo")
+		}
+	}
+	if _, ok := interface{}(&Excluded{}).(io.WriterTo); ok {
+		panic("typefilter.go: unexpected WriteTo")
+	}
+
+	s := &Root{
+		Children: []Child{{Name: "a"}, {Name: "b"}},
+		Sibling:  &Sibling{Value: 1},
+	}
+
+	var buf bytes.Buffer
+	s.WriteTo(&buf)
+
+	o := new(Root)
+	o.ReadFrom(&buf)
+
+	if diff := cmp.Diff(s, o); diff != "" {
+		panic("typefilter.go: \n" + diff)
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type Counter int32
+
+//go:generate go-binenc-gen -enc varint varint.go
+type Varint struct {
+	Timestamp uint64
+	Delta     int64
+	Count     Counter
+	Values    []int
+	Flags     uint8
+	Fixed     uint32 `binenc:"enc=fixed"`
+	Narrow    int16
+}
+
+func main() {
+	for _, s := range []*Varint{
+		{},
+		{Timestamp: 300, Delta: -1, Count: 63, Values: []int{-64, 64}, Flags: 0xff, Fixed: 1, Narrow: math.MinInt16},
+		{Timestamp: math.MaxUint64, Delta: math.MinInt64, Count: math.MaxInt32, Values: []int{math.MaxInt64}, Narrow: math.MaxInt16},
+	} {
+		var buf bytes.Buffer
+		n, err := s.WriteTo(&buf)
+		if err != nil {
+			panic("varint.go: " + err.Error())
+		}
+		if n != int64(buf.Len()) || s.EncodedSize() != buf.Len() {
+			panic("varint.go: unexpected byte count")
+		}
+		o := new(Varint)
+		if _, err := o.ReadFrom(&buf); err != nil {
+			panic("varint.go: " + err.Error())
+		}
+		if diff := cmp.Diff(s, o, cmpopts.EquateEmpty()); diff != "" {
+			panic("varint.go: \n" + diff)
+		}
+	}
+
+	// small values take a byte each, whatever their sign
+	var buf bytes.Buffer
+	(&Varint{Timestamp: 127, Delta: -64, Count: 63, Values: []int{-1}, Fixed: 7, Narrow: -2}).WriteTo(&buf)
+	want := []byte{
+		0x7f,       // Timestamp
+		0x7f,       // Delta
+		0x7e,       // Count
+		0x01, 0x00, // len(Values)
+		0x01,                   // Values[0]
+		0x00,                   // Flags
+		0x07, 0x00, 0x00, 0x00, // Fixed
+		0x03, // Narrow
+	}
+	if diff := cmp.Diff(want, buf.Bytes()); diff != "" {
+		panic("varint.go: \n" + diff)
+	}
+
+	// decoded values that do not fit their type are errors
+	wide := append([]byte{}, want[:11]...)
+	wide = append(wide, 0x80, 0x80, 0x04) // 1<<16, zigzag encoded 1<<15
+	if _, err := new(Varint).ReadFrom(bytes.NewReader(wide)); err == nil {
+		panic("varint.go: expected overflow error")
+	}
+	long := bytes.Repeat([]byte{0xff}, 10)
+	if _, err := new(Varint).ReadFrom(bytes.NewReader(append(long, 0x01))); err == nil {
+		panic("varint.go: expected overflow error")
+	}
+}
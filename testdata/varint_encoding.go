@@ -0,0 +1,366 @@
+// Code generated by "gobinenc -enc varint varint.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Varint) WriteTo(w io.Writer) (n int64, err error) {
+	size := 7
+	size += (bits.Len64(uint64(s.Timestamp)|1)+6)/7 + (bits.Len64(uint64(s.Delta)<<1^uint64(int64(s.Delta)>>63)|1)+6)/7 + (bits.Len64(uint64(s.Count)<<1^uint64(int64(s.Count)>>63)|1)+6)/7 + (bits.Len64(uint64(s.Narrow)<<1^uint64(int64(s.Narrow)>>63)|1)+6)/7
+	for _, v := range s.Values {
+		size += (bits.Len64(uint64(v)<<1^uint64(int64(v)>>63)|1) + 6) / 7
+	}
+	buf := make([]byte, size)
+	offset := 0
+	offset += binary.PutUvarint(buf[offset:], uint64(s.Timestamp))
+	offset += binary.PutVarint(buf[offset:], int64(s.Delta))
+	offset += binary.PutVarint(buf[offset:], int64(s.Count))
+	if uint64(len(s.Values)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Values))
+	}
+	buf[offset] = byte(len(s.Values))
+	buf[offset+1] = byte(len(s.Values) >> 8)
+	offset += 2
+	for _, v := range s.Values {
+		offset += binary.PutVarint(buf[offset:], int64(v))
+	}
+	buf[offset] = byte(s.Flags)
+	offset += 1
+	buf[offset] = byte(s.Fixed)
+	buf[offset+1] = byte(s.Fixed >> 8)
+	buf[offset+2] = byte(s.Fixed >> 16)
+	buf[offset+3] = byte(s.Fixed >> 24)
+	offset += 4
+	offset += binary.PutVarint(buf[offset:], int64(s.Narrow))
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Varint) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Varint) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Varint) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var usize uint64
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Varint", n, "Timestamp")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: varint overflows 64 bits"), "Varint", n, "Timestamp")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	s.Timestamp = usize
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Varint", n, "Delta")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: varint overflows 64 bits"), "Varint", n, "Delta")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	s.Delta = int64(usize>>1) ^ -int64(usize&1)
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Varint", n, "Count")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: varint overflows 64 bits"), "Varint", n, "Count")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if x := int64(usize>>1) ^ -int64(usize&1); int64(int32(x)) != x {
+		return n, binenc.FieldError(fmt.Errorf("binenc: %d overflows int32", x), "Varint", n, "Count")
+	} else {
+		s.Count = Counter(x)
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Varint", n, "Values")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Varint", n, "Values")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Varint", n, "Values")
+	}
+	if size > binenc.MaxAlloc/8 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Varint", n, "Values")
+	}
+	s.Values = make([]int, size)
+	si := size
+	for i := 0; i < si; i++ {
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "Varint", n, "Values[%d]", i)
+			}
+			n += 1
+			if shift == 63 && buf[0] > 1 {
+				return n, binenc.FieldError(fmt.Errorf("binenc: varint overflows 64 bits"), "Varint", n, "Values[%d]", i)
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if x := int64(usize>>1) ^ -int64(usize&1); int64(int(x)) != x {
+			return n, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "Varint", n, "Values[%d]", i)
+		} else {
+			s.Values[i] = int(x)
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Varint", n, "Flags")
+	}
+	n += 1
+	s.Flags = uint8(buf[0])
+	if nr, err := io.ReadFull(r, buf[:4]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Varint", n, "Fixed")
+	}
+	n += 4
+	s.Fixed = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Varint", n, "Narrow")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: varint overflows 64 bits"), "Varint", n, "Narrow")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if x := int64(usize>>1) ^ -int64(usize&1); int64(int16(x)) != x {
+		return n, binenc.FieldError(fmt.Errorf("binenc: %d overflows int16", x), "Varint", n, "Narrow")
+	} else {
+		s.Narrow = int16(x)
+	}
+	return n, nil
+}
+
+func (s *Varint) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 7
+	size += (bits.Len64(uint64(s.Timestamp)|1)+6)/7 + (bits.Len64(uint64(s.Delta)<<1^uint64(int64(s.Delta)>>63)|1)+6)/7 + (bits.Len64(uint64(s.Count)<<1^uint64(int64(s.Count)>>63)|1)+6)/7 + (bits.Len64(uint64(s.Narrow)<<1^uint64(int64(s.Narrow)>>63)|1)+6)/7
+	for _, v := range s.Values {
+		size += (bits.Len64(uint64(v)<<1^uint64(int64(v)>>63)|1) + 6) / 7
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	offset += binary.PutUvarint(buf[offset:], uint64(s.Timestamp))
+	offset += binary.PutVarint(buf[offset:], int64(s.Delta))
+	offset += binary.PutVarint(buf[offset:], int64(s.Count))
+	if uint64(len(s.Values)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Values))
+	}
+	buf[offset] = byte(len(s.Values))
+	buf[offset+1] = byte(len(s.Values) >> 8)
+	offset += 2
+	for _, v := range s.Values {
+		offset += binary.PutVarint(buf[offset:], int64(v))
+	}
+	buf[offset] = byte(s.Flags)
+	offset += 1
+	buf[offset] = byte(s.Fixed)
+	buf[offset+1] = byte(s.Fixed >> 8)
+	buf[offset+2] = byte(s.Fixed >> 16)
+	buf[offset+3] = byte(s.Fixed >> 24)
+	offset += 4
+	offset += binary.PutVarint(buf[offset:], int64(s.Narrow))
+	return buf, nil
+}
+
+func (s *Varint) EncodedSize() int {
+	size := 7
+	size += (bits.Len64(uint64(s.Timestamp)|1)+6)/7 + (bits.Len64(uint64(s.Delta)<<1^uint64(int64(s.Delta)>>63)|1)+6)/7 + (bits.Len64(uint64(s.Count)<<1^uint64(int64(s.Count)>>63)|1)+6)/7 + (bits.Len64(uint64(s.Narrow)<<1^uint64(int64(s.Narrow)>>63)|1)+6)/7
+	for _, v := range s.Values {
+		size += (bits.Len64(uint64(v)<<1^uint64(int64(v)>>63)|1) + 6) / 7
+	}
+	return size
+}
+
+func (s *Varint) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Varint) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Varint) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var usize uint64
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Varint", int64(offset), "Timestamp")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: varint overflows 64 bits"), "Varint", int64(offset), "Timestamp")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	s.Timestamp = usize
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Varint", int64(offset), "Delta")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: varint overflows 64 bits"), "Varint", int64(offset), "Delta")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	s.Delta = int64(usize>>1) ^ -int64(usize&1)
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Varint", int64(offset), "Count")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: varint overflows 64 bits"), "Varint", int64(offset), "Count")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if x := int64(usize>>1) ^ -int64(usize&1); int64(int32(x)) != x {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: %d overflows int32", x), "Varint", int64(offset), "Count")
+	} else {
+		s.Count = Counter(x)
+	}
+	if len(data)-offset < 2 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Varint", int64(offset), "Values")
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Varint", int64(offset), "Values")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Varint", int64(offset), "Values")
+	}
+	s.Values = make([]int, size)
+	si := size
+	for i := 0; i < si; i++ {
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if len(data)-offset < 1 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "Varint", int64(offset), "Values[%d]", i)
+			}
+			buf = data[offset : offset+1]
+			offset += 1
+			if shift == 63 && buf[0] > 1 {
+				return offset, binenc.FieldError(fmt.Errorf("binenc: varint overflows 64 bits"), "Varint", int64(offset), "Values[%d]", i)
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if x := int64(usize>>1) ^ -int64(usize&1); int64(int(x)) != x {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "Varint", int64(offset), "Values[%d]", i)
+		} else {
+			s.Values[i] = int(x)
+		}
+	}
+	if len(data)-offset < 5 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Varint", int64(offset), "Flags")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	s.Flags = uint8(buf[0])
+	buf = data[offset : offset+4]
+	offset += 4
+	s.Fixed = uint32(buf[0]) | (uint32(buf[1]) << 8) | (uint32(buf[2]) << 16) | (uint32(buf[3]) << 24)
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Varint", int64(offset), "Narrow")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: varint overflows 64 bits"), "Varint", int64(offset), "Narrow")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if x := int64(usize>>1) ^ -int64(usize&1); int64(int16(x)) != x {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: %d overflows int16", x), "Varint", int64(offset), "Narrow")
+	} else {
+		s.Narrow = int16(x)
+	}
+	return offset, nil
+}
+
+func (s *Varint) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
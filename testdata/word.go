@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type Celsius float64
+
+type Color uint8
+
+//go:generate go-binenc-gen word.go
+type Word struct {
+	Int     int
+	Uint    uint
+	Uintptr uintptr
+	Ints    []int
+	Temp    Celsius
+	Color   Color
+}
+
+func main() {
+	s := &Word{
+		Int:     math.MinInt64,
+		Uint:    math.MaxUint64,
+		Uintptr: 0xdeadbeef,
+		Ints:    []int{-1, 0, 1 << 40},
+		Temp:    -273.15,
+		Color:   7,
+	}
+
+	var buf bytes.Buffer
+	s.WriteTo(&buf)
+
+	// int, uint and uintptr are always encoded in 8 bytes
+	if want := 8 + 8 + 8 + 2 + 3*8 + 8 + 1; buf.Len() != want {
+		panic("word.go: unexpected encoded size")
+	}
+
+	o := new(Word)
+	o.ReadFrom(&buf)
+
+	if diff := cmp.Diff(s, o); diff != "" {
+		panic("word.go: \n" + diff)
+	}
+}
@@ -0,0 +1,311 @@
+// Code generated by "gobinenc word.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *Word) WriteTo(w io.Writer) (n int64, err error) {
+	size := 35
+	size += 8 * len(s.Ints)
+	buf := make([]byte, size)
+	offset := 0
+	buf[offset] = byte(uint64(s.Int))
+	buf[offset+1] = byte(uint64(s.Int) >> 8)
+	buf[offset+2] = byte(uint64(s.Int) >> 16)
+	buf[offset+3] = byte(uint64(s.Int) >> 24)
+	buf[offset+4] = byte(uint64(s.Int) >> 32)
+	buf[offset+5] = byte(uint64(s.Int) >> 40)
+	buf[offset+6] = byte(uint64(s.Int) >> 48)
+	buf[offset+7] = byte(uint64(s.Int) >> 56)
+	offset += 8
+	buf[offset] = byte(s.Uint)
+	buf[offset+1] = byte(s.Uint >> 8)
+	buf[offset+2] = byte(s.Uint >> 16)
+	buf[offset+3] = byte(s.Uint >> 24)
+	buf[offset+4] = byte(s.Uint >> 32)
+	buf[offset+5] = byte(s.Uint >> 40)
+	buf[offset+6] = byte(s.Uint >> 48)
+	buf[offset+7] = byte(s.Uint >> 56)
+	offset += 8
+	buf[offset] = byte(s.Uintptr)
+	buf[offset+1] = byte(s.Uintptr >> 8)
+	buf[offset+2] = byte(s.Uintptr >> 16)
+	buf[offset+3] = byte(s.Uintptr >> 24)
+	buf[offset+4] = byte(s.Uintptr >> 32)
+	buf[offset+5] = byte(s.Uintptr >> 40)
+	buf[offset+6] = byte(s.Uintptr >> 48)
+	buf[offset+7] = byte(s.Uintptr >> 56)
+	offset += 8
+	if uint64(len(s.Ints)) > math.MaxUint16 {
+		return 0, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Ints))
+	}
+	buf[offset] = byte(len(s.Ints))
+	buf[offset+1] = byte(len(s.Ints) >> 8)
+	offset += 2
+	for _, v := range s.Ints {
+		buf[offset] = byte(uint64(v))
+		buf[offset+1] = byte(uint64(v) >> 8)
+		buf[offset+2] = byte(uint64(v) >> 16)
+		buf[offset+3] = byte(uint64(v) >> 24)
+		buf[offset+4] = byte(uint64(v) >> 32)
+		buf[offset+5] = byte(uint64(v) >> 40)
+		buf[offset+6] = byte(uint64(v) >> 48)
+		buf[offset+7] = byte(uint64(v) >> 56)
+		offset += 8
+	}
+	buf[offset] = byte(math.Float64bits(float64(s.Temp)))
+	buf[offset+1] = byte(math.Float64bits(float64(s.Temp)) >> 8)
+	buf[offset+2] = byte(math.Float64bits(float64(s.Temp)) >> 16)
+	buf[offset+3] = byte(math.Float64bits(float64(s.Temp)) >> 24)
+	buf[offset+4] = byte(math.Float64bits(float64(s.Temp)) >> 32)
+	buf[offset+5] = byte(math.Float64bits(float64(s.Temp)) >> 40)
+	buf[offset+6] = byte(math.Float64bits(float64(s.Temp)) >> 48)
+	buf[offset+7] = byte(math.Float64bits(float64(s.Temp)) >> 56)
+	offset += 8
+	buf[offset] = byte(s.Color)
+	offset += 1
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *Word) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *Word) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *Word) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Word", n, "Int")
+	}
+	n += 8
+	if x := int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)); int64(int(x)) != x {
+		return n, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "Word", n, "Int")
+	} else {
+		s.Int = int(x)
+	}
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Word", n, "Uint")
+	}
+	n += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); uint64(uint(x)) != x {
+		return n, binenc.FieldError(fmt.Errorf("binenc: %d overflows uint", x), "Word", n, "Uint")
+	} else {
+		s.Uint = uint(x)
+	}
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Word", n, "Uintptr")
+	}
+	n += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); uint64(uintptr(x)) != x {
+		return n, binenc.FieldError(fmt.Errorf("binenc: %d overflows uintptr", x), "Word", n, "Uintptr")
+	} else {
+		s.Uintptr = uintptr(x)
+	}
+	if nr, err := io.ReadFull(r, buf[:2]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Word", n, "Ints")
+	}
+	n += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Word", n, "Ints")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "Word", n, "Ints")
+	}
+	if size > binenc.MaxAlloc/8 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "Word", n, "Ints")
+	}
+	s.Ints = make([]int, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "Word", n, "Ints[%d]", i)
+		}
+		n += 8
+		if x := int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)); int64(int(x)) != x {
+			return n, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "Word", n, "Ints[%d]", i)
+		} else {
+			s.Ints[i] = int(x)
+		}
+	}
+	if nr, err := io.ReadFull(r, buf[:8]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Word", n, "Temp")
+	}
+	n += 8
+	s.Temp = Celsius(math.Float64frombits(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)))
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "Word", n, "Color")
+	}
+	n += 1
+	s.Color = Color(uint8(buf[0]))
+	return n, nil
+}
+
+func (s *Word) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 35
+	size += 8 * len(s.Ints)
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	buf[offset] = byte(uint64(s.Int))
+	buf[offset+1] = byte(uint64(s.Int) >> 8)
+	buf[offset+2] = byte(uint64(s.Int) >> 16)
+	buf[offset+3] = byte(uint64(s.Int) >> 24)
+	buf[offset+4] = byte(uint64(s.Int) >> 32)
+	buf[offset+5] = byte(uint64(s.Int) >> 40)
+	buf[offset+6] = byte(uint64(s.Int) >> 48)
+	buf[offset+7] = byte(uint64(s.Int) >> 56)
+	offset += 8
+	buf[offset] = byte(s.Uint)
+	buf[offset+1] = byte(s.Uint >> 8)
+	buf[offset+2] = byte(s.Uint >> 16)
+	buf[offset+3] = byte(s.Uint >> 24)
+	buf[offset+4] = byte(s.Uint >> 32)
+	buf[offset+5] = byte(s.Uint >> 40)
+	buf[offset+6] = byte(s.Uint >> 48)
+	buf[offset+7] = byte(s.Uint >> 56)
+	offset += 8
+	buf[offset] = byte(s.Uintptr)
+	buf[offset+1] = byte(s.Uintptr >> 8)
+	buf[offset+2] = byte(s.Uintptr >> 16)
+	buf[offset+3] = byte(s.Uintptr >> 24)
+	buf[offset+4] = byte(s.Uintptr >> 32)
+	buf[offset+5] = byte(s.Uintptr >> 40)
+	buf[offset+6] = byte(s.Uintptr >> 48)
+	buf[offset+7] = byte(s.Uintptr >> 56)
+	offset += 8
+	if uint64(len(s.Ints)) > math.MaxUint16 {
+		return dst, fmt.Errorf("binenc: length %d overflows 2-byte prefix", len(s.Ints))
+	}
+	buf[offset] = byte(len(s.Ints))
+	buf[offset+1] = byte(len(s.Ints) >> 8)
+	offset += 2
+	for _, v := range s.Ints {
+		buf[offset] = byte(uint64(v))
+		buf[offset+1] = byte(uint64(v) >> 8)
+		buf[offset+2] = byte(uint64(v) >> 16)
+		buf[offset+3] = byte(uint64(v) >> 24)
+		buf[offset+4] = byte(uint64(v) >> 32)
+		buf[offset+5] = byte(uint64(v) >> 40)
+		buf[offset+6] = byte(uint64(v) >> 48)
+		buf[offset+7] = byte(uint64(v) >> 56)
+		offset += 8
+	}
+	buf[offset] = byte(math.Float64bits(float64(s.Temp)))
+	buf[offset+1] = byte(math.Float64bits(float64(s.Temp)) >> 8)
+	buf[offset+2] = byte(math.Float64bits(float64(s.Temp)) >> 16)
+	buf[offset+3] = byte(math.Float64bits(float64(s.Temp)) >> 24)
+	buf[offset+4] = byte(math.Float64bits(float64(s.Temp)) >> 32)
+	buf[offset+5] = byte(math.Float64bits(float64(s.Temp)) >> 40)
+	buf[offset+6] = byte(math.Float64bits(float64(s.Temp)) >> 48)
+	buf[offset+7] = byte(math.Float64bits(float64(s.Temp)) >> 56)
+	offset += 8
+	buf[offset] = byte(s.Color)
+	offset += 1
+	return buf, nil
+}
+
+func (s *Word) EncodedSize() int {
+	size := 35
+	size += 8 * len(s.Ints)
+	return size
+}
+
+func (s *Word) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *Word) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *Word) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	if len(data)-offset < 26 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Word", int64(offset), "Int")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	if x := int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)); int64(int(x)) != x {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "Word", int64(offset), "Int")
+	} else {
+		s.Int = int(x)
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); uint64(uint(x)) != x {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: %d overflows uint", x), "Word", int64(offset), "Uint")
+	} else {
+		s.Uint = uint(x)
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	if x := uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56); uint64(uintptr(x)) != x {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: %d overflows uintptr", x), "Word", int64(offset), "Uintptr")
+	} else {
+		s.Uintptr = uintptr(x)
+	}
+	buf = data[offset : offset+2]
+	offset += 2
+	size = int(uint16(buf[0]) | (uint16(buf[1]) << 8))
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "Word", int64(offset), "Ints")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Word", int64(offset), "Ints")
+	}
+	s.Ints = make([]int, size)
+	si := size
+	for i := 0; i < si; i++ {
+		if len(data)-offset < 8 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "Word", int64(offset), "Ints[%d]", i)
+		}
+		buf = data[offset : offset+8]
+		offset += 8
+		if x := int64(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)); int64(int(x)) != x {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: %d overflows int", x), "Word", int64(offset), "Ints[%d]", i)
+		} else {
+			s.Ints[i] = int(x)
+		}
+	}
+	if len(data)-offset < 9 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "Word", int64(offset), "Temp")
+	}
+	buf = data[offset : offset+8]
+	offset += 8
+	s.Temp = Celsius(math.Float64frombits(uint64(buf[0]) | (uint64(buf[1]) << 8) | (uint64(buf[2]) << 16) | (uint64(buf[3]) << 24) | (uint64(buf[4]) << 32) | (uint64(buf[5]) << 40) | (uint64(buf[6]) << 48) | (uint64(buf[7]) << 56)))
+	buf = data[offset : offset+1]
+	offset += 1
+	s.Color = Color(uint8(buf[0]))
+	return offset, nil
+}
+
+func (s *Word) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
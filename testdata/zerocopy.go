@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+//go:generate go-binenc-gen -zerocopy -lensize uvarint zerocopy.go
+type ZeroCopy struct {
+	Name    string
+	Payload []byte
+	Tags    []string
+	Child   *ZeroCopy
+}
+
+func main() {
+	s := &ZeroCopy{
+		Name:    "name",
+		Payload: []byte{1, 2, 3},
+		Tags:    []string{"a", "", "bc"},
+		Child:   &ZeroCopy{Name: "child", Payload: []byte{}, Tags: []string{}},
+	}
+
+	data, err := s.MarshalBinary()
+	if err != nil {
+		panic("zerocopy.go: " + err.Error())
+	}
+	o := new(ZeroCopy)
+	if err := o.UnmarshalBinary(data); err != nil {
+		panic("zerocopy.go: " + err.Error())
+	}
+	if diff := cmp.Diff(s, o); diff != "" {
+		panic("zerocopy.go: \n" + diff)
+	}
+
+	// decoded values share the memory of data
+	i := bytes.Index(data, []byte{1, 2, 3})
+	data[i] = 4
+	if o.Payload[0] != 4 {
+		panic("zerocopy.go: Payload does not alias data")
+	}
+	i = bytes.Index(data, []byte("child"))
+	data[i] = 'w'
+	if o.Child.Name != "whild" {
+		panic("zerocopy.go: Name does not alias data")
+	}
+
+	// appending to a decoded []byte never clobbers data
+	before := append([]byte(nil), data...)
+	_ = append(o.Payload, 9)
+	if !bytes.Equal(before, data) {
+		panic("zerocopy.go: append clobbered data")
+	}
+
+	// ReadFrom still copies
+	r := new(ZeroCopy)
+	if _, err := r.ReadFrom(bytes.NewReader(data)); err != nil {
+		panic("zerocopy.go: " + err.Error())
+	}
+	data[bytes.Index(data, []byte("name"))] = 'g'
+	if r.Name != "name" || o.Name != "game" {
+		panic("zerocopy.go: unexpected aliasing")
+	}
+}
@@ -0,0 +1,750 @@
+// Code generated by "gobinenc -zerocopy -lensize uvarint zerocopy.go"; DO NOT EDIT.
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"unsafe"
+
+	"github.com/cezarguimaraes/go-binenc-gen/binenc"
+)
+
+func (s *ZeroCopy) WriteTo(w io.Writer) (n int64, err error) {
+	size := 1
+	size += (bits.Len64(uint64(len(s.Name))|1)+6)/7 + len(s.Name) + (bits.Len64(uint64(len(s.Payload))|1)+6)/7 + len(s.Payload) + (bits.Len64(uint64(len(s.Tags))|1)+6)/7
+	for _, v := range s.Tags {
+		size += (bits.Len64(uint64(len(v))|1)+6)/7 + len(v)
+	}
+	if s.Child != nil {
+		size += (*s.Child).sizeBinenc()
+	}
+	buf := make([]byte, size)
+	offset := 0
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Name)))
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Payload)))
+	copy(buf[offset:], s.Payload)
+	offset += len(s.Payload)
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Tags)))
+	for _, v := range s.Tags {
+		offset += binary.PutUvarint(buf[offset:], uint64(len(v)))
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	if s.Child != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Child != nil {
+		offset, err = (*s.Child).writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	nw, err := w.Write(buf)
+	return int64(nw), err
+}
+
+func (s *ZeroCopy) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromOptions(r, binenc.DecodeOptions{})
+}
+
+func (s *ZeroCopy) ReadFromOptions(r io.Reader, opts binenc.DecodeOptions) (n int64, err error) {
+	return s.DecodeBinenc(binenc.NewDecoderOptions(r, opts))
+}
+
+func (s *ZeroCopy) DecodeBinenc(d *binenc.Decoder) (n int64, err error) {
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var usize uint64
+	var tmp []byte
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Name")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", n, "Name")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", n, "Name")
+	}
+	size = int(usize)
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "ZeroCopy", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "ZeroCopy", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "ZeroCopy", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Payload")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", n, "Payload")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", n, "Payload")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "ZeroCopy", n, "Payload")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "ZeroCopy", n, "Payload")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "ZeroCopy", n, "Payload")
+	}
+	s.Payload = make([]byte, size)
+	if nr, err := io.ReadFull(r, s.Payload); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Payload")
+	}
+	n += int64(size)
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Tags")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", n, "Tags")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", n, "Tags")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "ZeroCopy", n, "Tags")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "ZeroCopy", n, "Tags")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "ZeroCopy", n, "Tags")
+	}
+	s.Tags = make([]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Tags[%d]", i)
+			}
+			n += 1
+			if shift == 63 && buf[0] > 1 {
+				return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", n, "Tags[%d]", i)
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if usize > math.MaxInt {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", n, "Tags[%d]", i)
+		}
+		size = int(usize)
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "ZeroCopy", n, "Tags[%d]", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "ZeroCopy", n, "Tags[%d]", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "ZeroCopy", n, "Tags[%d]", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Tags[%d]", i)
+		}
+		n += int64(size)
+		s.Tags[i] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Child")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Child = new(ZeroCopy)
+		if n, err = (*s.Child).readBinenc(d, n, 1); err != nil {
+			return n, binenc.FieldError(err, "ZeroCopy", n, "Child")
+		}
+	} else {
+		s.Child = nil
+	}
+	return n, nil
+}
+
+func (s *ZeroCopy) AppendBinary(dst []byte) (_ []byte, err error) {
+	size := 1
+	size += (bits.Len64(uint64(len(s.Name))|1)+6)/7 + len(s.Name) + (bits.Len64(uint64(len(s.Payload))|1)+6)/7 + len(s.Payload) + (bits.Len64(uint64(len(s.Tags))|1)+6)/7
+	for _, v := range s.Tags {
+		size += (bits.Len64(uint64(len(v))|1)+6)/7 + len(v)
+	}
+	if s.Child != nil {
+		size += (*s.Child).sizeBinenc()
+	}
+	if cap(dst)-len(dst) < size {
+		dst = append(dst, make([]byte, size)...)[:len(dst)]
+	}
+	buf := dst[:len(dst)+size]
+	offset := len(dst)
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Name)))
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Payload)))
+	copy(buf[offset:], s.Payload)
+	offset += len(s.Payload)
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Tags)))
+	for _, v := range s.Tags {
+		offset += binary.PutUvarint(buf[offset:], uint64(len(v)))
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	if s.Child != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Child != nil {
+		offset, err = (*s.Child).writeBinenc(buf, offset)
+		if err != nil {
+			return dst, err
+		}
+	}
+	return buf, nil
+}
+
+func (s *ZeroCopy) EncodedSize() int {
+	size := 1
+	size += (bits.Len64(uint64(len(s.Name))|1)+6)/7 + len(s.Name) + (bits.Len64(uint64(len(s.Payload))|1)+6)/7 + len(s.Payload) + (bits.Len64(uint64(len(s.Tags))|1)+6)/7
+	for _, v := range s.Tags {
+		size += (bits.Len64(uint64(len(v))|1)+6)/7 + len(v)
+	}
+	if s.Child != nil {
+		size += (*s.Child).sizeBinenc()
+	}
+	return size
+}
+
+func (s *ZeroCopy) MarshalBinary() ([]byte, error) {
+	return s.AppendBinary(nil)
+}
+
+func (s *ZeroCopy) DecodeFrom(data []byte) (offset int, err error) {
+	return s.DecodeFromOptions(data, binenc.DecodeOptions{})
+}
+
+func (s *ZeroCopy) DecodeFromOptions(data []byte, opts binenc.DecodeOptions) (offset int, err error) {
+	if opts.MaxBytes > 0 && int64(len(data)) > opts.MaxBytes {
+		data = data[:opts.MaxBytes]
+	}
+	var buf []byte
+	var size int
+	var usize uint64
+	var tmp []byte
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Name")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", int64(offset), "Name")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", int64(offset), "Name")
+	}
+	size = int(usize)
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "ZeroCopy", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Name")
+	}
+	tmp = data[offset : offset+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	offset += size
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Payload")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", int64(offset), "Payload")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", int64(offset), "Payload")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "ZeroCopy", int64(offset), "Payload")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Payload")
+	}
+	s.Payload = data[offset : offset+size : offset+size]
+	offset += size
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Tags")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", int64(offset), "Tags")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", int64(offset), "Tags")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "ZeroCopy", int64(offset), "Tags")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Tags")
+	}
+	s.Tags = make([]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if len(data)-offset < 1 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Tags[%d]", i)
+			}
+			buf = data[offset : offset+1]
+			offset += 1
+			if shift == 63 && buf[0] > 1 {
+				return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", int64(offset), "Tags[%d]", i)
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if usize > math.MaxInt {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", int64(offset), "Tags[%d]", i)
+		}
+		size = int(usize)
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "ZeroCopy", int64(offset), "Tags[%d]", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Tags[%d]", i)
+		}
+		tmp = data[offset : offset+size]
+		s.Tags[i] = *(*string)(unsafe.Pointer(&tmp))
+		offset += size
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Child")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Child = new(ZeroCopy)
+		if offset, err = (*s.Child).decodeBinenc(data, offset, opts, 1); err != nil {
+			return offset, binenc.FieldError(err, "ZeroCopy", int64(offset), "Child")
+		}
+	} else {
+		s.Child = nil
+	}
+	return offset, nil
+}
+
+func (s *ZeroCopy) UnmarshalBinary(data []byte) error {
+	n, err := s.DecodeFrom(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("binenc: %d trailing bytes", len(data)-n)
+	}
+	return nil
+}
+
+func (s *ZeroCopy) sizeBinenc() int {
+	size := 1
+	size += (bits.Len64(uint64(len(s.Name))|1)+6)/7 + len(s.Name) + (bits.Len64(uint64(len(s.Payload))|1)+6)/7 + len(s.Payload) + (bits.Len64(uint64(len(s.Tags))|1)+6)/7
+	for _, v := range s.Tags {
+		size += (bits.Len64(uint64(len(v))|1)+6)/7 + len(v)
+	}
+	if s.Child != nil {
+		size += (*s.Child).sizeBinenc()
+	}
+	return size
+}
+
+func (s *ZeroCopy) writeBinenc(buf []byte, offset int) (_ int, err error) {
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Name)))
+	copy(buf[offset:], s.Name)
+	offset += len(s.Name)
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Payload)))
+	copy(buf[offset:], s.Payload)
+	offset += len(s.Payload)
+	offset += binary.PutUvarint(buf[offset:], uint64(len(s.Tags)))
+	for _, v := range s.Tags {
+		offset += binary.PutUvarint(buf[offset:], uint64(len(v)))
+		copy(buf[offset:], v)
+		offset += len(v)
+	}
+	if s.Child != nil {
+		buf[offset] = byte(0x01)
+	} else {
+		buf[offset] = byte(0x00)
+	}
+	offset += 1
+	if s.Child != nil {
+		offset, err = (*s.Child).writeBinenc(buf, offset)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return offset, nil
+}
+
+func (s *ZeroCopy) readBinenc(d *binenc.Decoder, n int64, depth int) (_ int64, err error) {
+	if limit := d.Options().MaxDepth; limit > 0 && depth > limit {
+		return n, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	r := d.Reader()
+	buf := d.Buffer()
+	opts := d.Options()
+	var size int
+	var usize uint64
+	var tmp []byte
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Name")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", n, "Name")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", n, "Name")
+	}
+	size = int(usize)
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "ZeroCopy", n, "Name")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "ZeroCopy", n, "Name")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "ZeroCopy", n, "Name")
+	}
+	tmp = d.Arena(size)
+	if nr, err := io.ReadFull(r, tmp); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Name")
+	}
+	n += int64(size)
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Payload")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", n, "Payload")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", n, "Payload")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "ZeroCopy", n, "Payload")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "ZeroCopy", n, "Payload")
+	}
+	if size > binenc.MaxAlloc {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "ZeroCopy", n, "Payload")
+	}
+	s.Payload = make([]byte, size)
+	if nr, err := io.ReadFull(r, s.Payload); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Payload")
+	}
+	n += int64(size)
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Tags")
+		}
+		n += 1
+		if shift == 63 && buf[0] > 1 {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", n, "Tags")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", n, "Tags")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "ZeroCopy", n, "Tags")
+	}
+	if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+		return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "ZeroCopy", n, "Tags")
+	}
+	if size > binenc.MaxAlloc/16 {
+		return n, binenc.FieldError(binenc.ErrTooLarge, "ZeroCopy", n, "Tags")
+	}
+	s.Tags = make([]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+				return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Tags[%d]", i)
+			}
+			n += 1
+			if shift == 63 && buf[0] > 1 {
+				return n, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", n, "Tags[%d]", i)
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if usize > math.MaxInt {
+			return n, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", n, "Tags[%d]", i)
+		}
+		size = int(usize)
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "ZeroCopy", n, "Tags[%d]", i)
+		}
+		if opts.MaxBytes > 0 && int64(size) > opts.MaxBytes-n {
+			return n, binenc.FieldError(&binenc.LimitError{Limit: "MaxBytes", Max: opts.MaxBytes}, "ZeroCopy", n, "Tags[%d]", i)
+		}
+		if size > binenc.MaxAlloc {
+			return n, binenc.FieldError(binenc.ErrTooLarge, "ZeroCopy", n, "Tags[%d]", i)
+		}
+		tmp = d.Arena(size)
+		if nr, err := io.ReadFull(r, tmp); err != nil {
+			return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Tags[%d]", i)
+		}
+		n += int64(size)
+		s.Tags[i] = *(*string)(unsafe.Pointer(&tmp))
+	}
+	if nr, err := io.ReadFull(r, buf[:1]); err != nil {
+		return n + int64(nr), binenc.FieldError(err, "ZeroCopy", n, "Child")
+	}
+	n += 1
+	if buf[0] == byte(0x01) {
+		s.Child = new(ZeroCopy)
+		if n, err = (*s.Child).readBinenc(d, n, depth+1); err != nil {
+			return n, binenc.FieldError(err, "ZeroCopy", n, "Child")
+		}
+	} else {
+		s.Child = nil
+	}
+	return n, nil
+}
+
+func (s *ZeroCopy) decodeBinenc(data []byte, offset int, opts binenc.DecodeOptions, depth int) (_ int, err error) {
+	if limit := opts.MaxDepth; limit > 0 && depth > limit {
+		return offset, &binenc.LimitError{Limit: "MaxDepth", Max: int64(limit)}
+	}
+	var buf []byte
+	var size int
+	var usize uint64
+	var tmp []byte
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Name")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", int64(offset), "Name")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", int64(offset), "Name")
+	}
+	size = int(usize)
+	if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "ZeroCopy", int64(offset), "Name")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Name")
+	}
+	tmp = data[offset : offset+size]
+	s.Name = *(*string)(unsafe.Pointer(&tmp))
+	offset += size
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Payload")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", int64(offset), "Payload")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", int64(offset), "Payload")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "ZeroCopy", int64(offset), "Payload")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Payload")
+	}
+	s.Payload = data[offset : offset+size : offset+size]
+	offset += size
+	usize = 0
+	for shift := 0; ; shift += 7 {
+		if len(data)-offset < 1 {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Tags")
+		}
+		buf = data[offset : offset+1]
+		offset += 1
+		if shift == 63 && buf[0] > 1 {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", int64(offset), "Tags")
+		}
+		usize |= uint64(buf[0]&0x7f) << shift
+		if buf[0] < 0x80 {
+			break
+		}
+	}
+	if usize > math.MaxInt {
+		return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", int64(offset), "Tags")
+	}
+	size = int(usize)
+	if opts.MaxSliceLen > 0 && size > opts.MaxSliceLen {
+		return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxSliceLen", Max: int64(opts.MaxSliceLen)}, "ZeroCopy", int64(offset), "Tags")
+	}
+	if len(data)-offset < size {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Tags")
+	}
+	s.Tags = make([]string, size)
+	si := size
+	for i := 0; i < si; i++ {
+		usize = 0
+		for shift := 0; ; shift += 7 {
+			if len(data)-offset < 1 {
+				return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Tags[%d]", i)
+			}
+			buf = data[offset : offset+1]
+			offset += 1
+			if shift == 63 && buf[0] > 1 {
+				return offset, binenc.FieldError(fmt.Errorf("binenc: length overflows 64 bits"), "ZeroCopy", int64(offset), "Tags[%d]", i)
+			}
+			usize |= uint64(buf[0]&0x7f) << shift
+			if buf[0] < 0x80 {
+				break
+			}
+		}
+		if usize > math.MaxInt {
+			return offset, binenc.FieldError(fmt.Errorf("binenc: length %d overflows int", usize), "ZeroCopy", int64(offset), "Tags[%d]", i)
+		}
+		size = int(usize)
+		if opts.MaxStringLen > 0 && size > opts.MaxStringLen {
+			return offset, binenc.FieldError(&binenc.LimitError{Limit: "MaxStringLen", Max: int64(opts.MaxStringLen)}, "ZeroCopy", int64(offset), "Tags[%d]", i)
+		}
+		if len(data)-offset < size {
+			return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Tags[%d]", i)
+		}
+		tmp = data[offset : offset+size]
+		s.Tags[i] = *(*string)(unsafe.Pointer(&tmp))
+		offset += size
+	}
+	if len(data)-offset < 1 {
+		return offset, binenc.FieldError(opts.ShortInput(len(data)), "ZeroCopy", int64(offset), "Child")
+	}
+	buf = data[offset : offset+1]
+	offset += 1
+	if buf[0] == byte(0x01) {
+		s.Child = new(ZeroCopy)
+		if offset, err = (*s.Child).decodeBinenc(data, offset, opts, depth+1); err != nil {
+			return offset, binenc.FieldError(err, "ZeroCopy", int64(offset), "Child")
+		}
+	} else {
+		s.Child = nil
+	}
+	return offset, nil
+}